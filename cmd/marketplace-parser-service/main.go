@@ -8,11 +8,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/artifacts"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/history"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/jobs"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/parsers"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/schedules"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sessions"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sinks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/watches"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
 	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
@@ -36,7 +44,7 @@ func run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("load config: %w", err)
 	}
-	
+
 	loggerCfg := logger.NewLoggerConfig(cfg.Server.Env, cfg.Options.LoggerTimeFormat)
 	logger := logger.LoadLogger(loggerCfg)
 
@@ -45,24 +53,146 @@ func run(ctx context.Context) error {
 
 	serverErr := make(chan error, 1)
 
-	time.Sleep(time.Second * 5)
+	sessionsRepo, err := sessions.NewFileSystemRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("new sessions repository: %w", err)
+	}
 
-	chromiumRepo := chromium.NewChromiumRepository(cfg)
+	// The background workers run until the shutdown. stopWorkers cancels them and waits for them to return,
+	// it's deferred after every resource the workers use, so the resources are closed once the workers are done.
+	workersCtx, cancelWorkers := context.WithCancel(ctx)
+	var workers sync.WaitGroup
+	goWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+	stopWorkers := func() {
+		cancelWorkers()
+		workers.Wait()
+	}
+
+	chromiumRepo := chromium.NewChromiumRepository(cfg, sessionsRepo)
+	defer chromiumRepo.Close()
+	defer stopWorkers()
+	goWorker(func(ctx context.Context) {
+		chromiumRepo.RunHealthCheck(ctx, func(err error) {
+			logger.Warn("browser health check", "err", err)
+		})
+	})
 	browser := chromium.NewBrowser(chromiumRepo)
 
-	wb := parsers.NewWildberriesParser(cfg, logger, browser.Chromium())
-	oz := parsers.NewOzonParser(cfg, logger, browser.Chromium())
+	artifactsRepo := artifacts.NewFileSystemRepository(cfg)
+
+	wb := parsers.NewWildberriesParser(cfg, logger, browser.Chromium(), artifactsRepo)
+	oz := parsers.NewOzonParser(cfg, logger, browser.Chromium(), artifactsRepo)
+
+	searchCfg := usecase.NewSearchConfig(cfg)
+	// The limits are inside the retries, so a source call doesn't hold its slot during the backoff
+	sources := usecase.WithLimits([]repository.SearchRepository{oz, wb}, searchCfg.Limits, logger)
+	sources = usecase.WithRetry(sources, searchCfg.Retries, logger)
+
+	var historyRepo repository.HistoryRepository
+	var historySvc usecase.HistoryService
+	if cfg.History.Enabled {
+		sqliteRepo, err := history.NewSQLiteRepository(cfg)
+		if err != nil {
+			return fmt.Errorf("new history repository: %w", err)
+		}
+		defer sqliteRepo.Close()
+
+		historyRepo = sqliteRepo
+
+		// The recorder is closed before the repository, so the queued observations are recorded
+		recorder := usecase.NewHistoryRecorder(historyRepo, usecase.NewHistoryConfig(cfg), logger)
+		go recorder.Run(ctx)
+		defer recorder.Close()
+		defer stopWorkers()
+
+		sources = usecase.WithHistory(sources, recorder)
+		historySvc = usecase.NewHistoryService(historyRepo)
+	}
+
+	var searchSvc usecase.ParserService = usecase.NewSearchService(sources, searchCfg)
+	searchSvc = usecase.NewLimitedParserService(searchSvc, usecase.NewAdmissionPolicy(cfg), logger)
+	if cfg.Cache.Enabled {
+		searchSvc = usecase.NewCachedParserService(searchSvc, usecase.NewCacheConfig(cfg))
+	}
+
+	browserSvc := usecase.NewBrowserService(browser.Chromium(), sessionsRepo)
+
+	jobSvc := usecase.NewJobService(searchSvc, jobs.NewMemoryRepository(cfg), usecase.NewJobConfig(cfg), logger)
+	goWorker(jobSvc.Run)
+
+	batchSvc := usecase.NewBatchService(searchSvc, usecase.NewBatchConfig(cfg))
+
+	var watchSvc usecase.WatchService
+	if cfg.Watches.Enabled {
+		watchesRepo, err := watches.NewSQLiteRepository(cfg)
+		if err != nil {
+			return fmt.Errorf("new watches repository: %w", err)
+		}
+		defer watchesRepo.Close()
+		defer stopWorkers()
+
+		svc := usecase.NewWatchService(searchSvc, watchesRepo, watches.NewHTTPSender(cfg), usecase.NewWatchConfig(cfg), logger)
+		goWorker(svc.Run)
+		watchSvc = svc
+	}
 
-	searchSvc := usecase.NewSearchService([]repository.SearchRepository{oz, wb})
+	var schedulerSvc usecase.SchedulerService
+	if cfg.Scheduler.Enabled {
+		schedulesRepo, err := schedules.NewSQLiteRepository(cfg)
+		if err != nil {
+			return fmt.Errorf("new schedules repository: %w", err)
+		}
+		defer schedulesRepo.Close()
+		defer stopWorkers()
+
+		var sink repository.ResultSink
+		switch cfg.Scheduler.Sink.Type {
+		case config.SinkFile:
+			if sink, err = sinks.NewFileSink(cfg); err != nil {
+				return fmt.Errorf("new file sink: %w", err)
+			}
+		case config.SinkDatabase:
+			sqliteSink, err := sinks.NewSQLiteSink(cfg)
+			if err != nil {
+				return fmt.Errorf("new database sink: %w", err)
+			}
+			defer sqliteSink.Close()
+			defer stopWorkers()
+			sink = sqliteSink
+		default:
+			sink = sinks.NewLogSink(logger)
+		}
+
+		svc := usecase.NewSchedulerService(searchSvc, schedulesRepo, sink, usecase.NewSchedulerConfig(cfg), logger)
+		if err := svc.LoadSchedules(ctx); err != nil {
+			return fmt.Errorf("load schedules: %w", err)
+		}
+		goWorker(svc.Run)
+		schedulerSvc = svc
+	}
 
-	handler := ht.NewHandler(logger, searchSvc, cfg.Server.RequestTimeout)
+	handler := ht.NewHandler(logger, ht.Services{
+		Parser:    searchSvc,
+		Browser:   browserSvc,
+		Jobs:      jobSvc,
+		Batch:     batchSvc,
+		History:   historySvc,
+		Watches:   watchSvc,
+		Scheduler: schedulerSvc,
+	}, cfg.Server.RequestTimeout)
 
 	srv, err := httpgen.NewServer(handler)
 	if err != nil {
 		return fmt.Errorf("new server: %w", err)
 	}
 
-	withMiddlewares := handler.RequestTimeoutMiddleware(handler.LoggerMiddleware(srv))
+	withMiddlewares := handler.RequestTimeoutMiddleware(handler.LoggerMiddleware(handler.Routes(srv)))
 
 	httpServer := &http.Server{
 		Addr:    cfg.Server.HTTPAddr,
@@ -0,0 +1,21 @@
+package artifacts
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+)
+
+type Config struct {
+	dir      string
+	maxAge   time.Duration
+	maxCount int
+}
+
+func NewArtifactsConfig(cfg *config.Config) *Config {
+	return &Config{
+		dir:      cfg.Artifacts.Dir,
+		maxAge:   cfg.Artifacts.MaxAge,
+		maxCount: cfg.Artifacts.MaxCount,
+	}
+}
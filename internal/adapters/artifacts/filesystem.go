@@ -0,0 +1,145 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+const (
+	screenshotFile = "screenshot.png"
+	htmlFile       = "page.html"
+	metaFile       = "meta.json"
+)
+
+// FileSystemRepository keeps every artifact in its own directory named by the artifact id.
+// The ids start with the creation time, so the directories sort from the oldest to the newest.
+type FileSystemRepository struct {
+	cfg *Config
+
+	// cleanupMu lets a single save clean up at a time, the artifacts themselves are written to their own directories.
+	cleanupMu sync.Mutex
+}
+
+type meta struct {
+	ID          string             `json:"id"`
+	Marketplace domain.Marketplace `json:"marketplace"`
+	Operation   string             `json:"operation"`
+	URL         string             `json:"url"`
+	Error       string             `json:"error,omitempty"`
+	HARPath     string             `json:"har_path,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// Create a new file system artifact repository.
+func NewFileSystemRepository(cfg *config.Config) *FileSystemRepository {
+	return &FileSystemRepository{cfg: NewArtifactsConfig(cfg)}
+}
+
+// Save writes the screenshot, the HTML and the metadata of the artifact, then removes the artifacts out of retention.
+func (r *FileSystemRepository) Save(ctx context.Context, artifact repository.Artifact) (string, error) {
+	id, err := newID(artifact.CreatedAt)
+	if err != nil {
+		return "", fmt.Errorf("new artifact id: %w", err)
+	}
+
+	dir := filepath.Join(r.cfg.dir, id)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create artifact dir: %w", err)
+	}
+
+	if len(artifact.Snapshot.Screenshot) > 0 {
+		if err := os.WriteFile(filepath.Join(dir, screenshotFile), artifact.Snapshot.Screenshot, 0o644); err != nil {
+			return "", fmt.Errorf("write screenshot: %w", err)
+		}
+	}
+	if artifact.Snapshot.HTML != "" {
+		if err := os.WriteFile(filepath.Join(dir, htmlFile), []byte(artifact.Snapshot.HTML), 0o644); err != nil {
+			return "", fmt.Errorf("write html: %w", err)
+		}
+	}
+
+	metaJSON, err := json.MarshalIndent(meta{
+		ID:          id,
+		Marketplace: artifact.Marketplace,
+		Operation:   artifact.Operation,
+		URL:         artifact.Snapshot.URL,
+		Error:       artifact.Error,
+		HARPath:     artifact.Snapshot.HARPath,
+		CreatedAt:   artifact.CreatedAt,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal meta: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, metaFile), metaJSON, 0o644); err != nil {
+		return "", fmt.Errorf("write meta: %w", err)
+	}
+
+	// The save doesn't wait for the running cleanup, it removes this artifact too if it's out of retention
+	if r.cleanupMu.TryLock() {
+		defer r.cleanupMu.Unlock()
+
+		if err := r.cleanup(time.Now()); err != nil {
+			return "", fmt.Errorf("cleanup artifacts: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+// cleanup removes the artifacts older than maxAge and the oldest ones above maxCount.
+func (r *FileSystemRepository) cleanup(now time.Time) error {
+	entries, err := os.ReadDir(r.cfg.dir)
+	if err != nil {
+		return err
+	}
+
+	dirs := make([]os.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			dirs = append(dirs, e)
+		}
+	}
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].Name() < dirs[j].Name() })
+
+	for i, d := range dirs {
+		expired := false
+		if r.cfg.maxCount > 0 && len(dirs)-i > r.cfg.maxCount {
+			expired = true
+		} else if r.cfg.maxAge > 0 {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			expired = now.Sub(info.ModTime()) > r.cfg.maxAge
+		}
+
+		if expired {
+			if err := os.RemoveAll(filepath.Join(r.cfg.dir, d.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func newID(createdAt time.Time) (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", createdAt.UTC().Format("20060102T150405.000"), hex.EncodeToString(b)), nil
+}
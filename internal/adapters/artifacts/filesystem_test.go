@@ -0,0 +1,90 @@
+package artifacts_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/artifacts"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func TestArtifacts_FileSystemRepositorySave(t *testing.T) {
+	dir := t.TempDir()
+	repo := artifacts.NewFileSystemRepository(&config.Config{
+		Artifacts: config.ArtifactsConfig{Dir: dir, MaxAge: time.Hour, MaxCount: 10},
+	})
+
+	id, err := repo.Save(context.Background(), repository.Artifact{
+		Marketplace: domain.MarketplaceOzon,
+		Operation:   "search",
+		Snapshot: repository.Snapshot{
+			URL:        "https://www.ozon.ru",
+			HTML:       "<html></html>",
+			Screenshot: []byte("png"),
+			HARPath:    "har/page.har",
+		},
+		Error:     "element search bar: timeout",
+		CreatedAt: time.Now(),
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	html, err := os.ReadFile(filepath.Join(dir, id, "page.html"))
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", string(html))
+	assert.FileExists(t, filepath.Join(dir, id, "screenshot.png"))
+
+	metaJSON, err := os.ReadFile(filepath.Join(dir, id, "meta.json"))
+	assert.NoError(t, err)
+	meta := map[string]any{}
+	assert.NoError(t, json.Unmarshal(metaJSON, &meta))
+	assert.Equal(t, id, meta["id"])
+	assert.Equal(t, "https://www.ozon.ru", meta["url"])
+	assert.Equal(t, "element search bar: timeout", meta["error"])
+	assert.Equal(t, "har/page.har", meta["har_path"])
+}
+
+func TestArtifacts_FileSystemRepositoryRetention(t *testing.T) {
+	t.Run("max count", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := artifacts.NewFileSystemRepository(&config.Config{
+			Artifacts: config.ArtifactsConfig{Dir: dir, MaxCount: 2},
+		})
+
+		now := time.Now()
+		ids := make([]string, 0, 3)
+		for i := range 3 {
+			id, err := repo.Save(context.Background(), repository.Artifact{CreatedAt: now.Add(time.Duration(i) * time.Second)})
+			assert.NoError(t, err)
+			ids = append(ids, id)
+		}
+
+		assert.NoDirExists(t, filepath.Join(dir, ids[0]))
+		assert.DirExists(t, filepath.Join(dir, ids[1]))
+		assert.DirExists(t, filepath.Join(dir, ids[2]))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := artifacts.NewFileSystemRepository(&config.Config{
+			Artifacts: config.ArtifactsConfig{Dir: dir, MaxAge: time.Hour},
+		})
+
+		old := filepath.Join(dir, "20000101T000000.000-00000000")
+		assert.NoError(t, os.MkdirAll(old, 0o755))
+		assert.NoError(t, os.Chtimes(old, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour)))
+
+		id, err := repo.Save(context.Background(), repository.Artifact{CreatedAt: time.Now()})
+		assert.NoError(t, err)
+
+		assert.NoDirExists(t, old)
+		assert.DirExists(t, filepath.Join(dir, id))
+	})
+}
@@ -11,7 +11,7 @@ import (
 func TestChromium_NewBrowser(t *testing.T) {
 	cfg := fakeConfig(t)
 
-	ch := chromium.NewChromiumRepository(cfg)
+	ch := chromium.NewChromiumRepository(cfg, nil)
 	assert.NotNil(t, ch)
 	browser := chromium.NewBrowser(ch)
 	assert.NotNil(t, browser)
@@ -20,7 +20,7 @@ func TestChromium_NewBrowser(t *testing.T) {
 func TestChromium_Chromium(t *testing.T) {
 	cfg := fakeConfig(t)
 
-	ch := chromium.NewChromiumRepository(cfg)
+	ch := chromium.NewChromiumRepository(cfg, nil)
 	assert.NotNil(t, ch)
 	browser := chromium.NewBrowser(ch)
 	assert.NotNil(t, browser)
@@ -28,11 +28,40 @@ func TestChromium_Chromium(t *testing.T) {
 	assert.NotNil(t, rep)
 }
 
+func TestChromium_Nodes(t *testing.T) {
+	cfg := fakeConfig(t)
+	cfg.Browser.WsURLs = []string{"ws://user:secret@5.6.7.8:3000/chromium", cfg.Browser.WsURL}
+	cfg.Browser.Pool.Size = 2
+
+	ch := chromium.NewChromiumRepository(cfg, nil)
+	nodes := ch.Nodes()
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "ws://1.2.3.4:3000/chromium", nodes[0].URL)
+	assert.Equal(t, "ws://user:xxxxx@5.6.7.8:3000/chromium", nodes[1].URL)
+	for _, n := range nodes {
+		assert.True(t, n.Healthy)
+		assert.Zero(t, n.Connections)
+		assert.Zero(t, n.Pages)
+	}
+}
+
+func TestChromium_NodesLocalMode(t *testing.T) {
+	cfg := fakeConfig(t)
+	cfg.Browser.Mode = config.BrowserModeLocal
+	cfg.Browser.Pool.Size = 3
+
+	ch := chromium.NewChromiumRepository(cfg, nil)
+	nodes := ch.Nodes()
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, "local", nodes[0].URL)
+}
+
 func fakeConfig(t *testing.T) *config.Config {
 	t.Helper()
 
 	return &config.Config{
 		Browser: config.BrowserConfig{
+			Mode:  config.BrowserModeRemote,
 			WsURL: "ws://1.2.3.4:3000/chromium",
 		},
 		Options: config.OptionsConfig{
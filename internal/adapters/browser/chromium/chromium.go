@@ -4,12 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/cdp"
 	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/launcher/flags"
 	"github.com/go-rod/rod/lib/proto"
 
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/pool"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
 )
@@ -25,108 +31,340 @@ const (
 	// platoformMacOS = "MacIntel"
 )
 
+// localNode is the name of the node of the browser launched in the local mode.
+const localNode = "local"
+
 type ChromiumRepository struct {
 	cfg *Config
+	// nodes are the WebSocket URLs of the remote browsers or the local node in the local mode.
+	nodes []string
+	pool  *pool.Pool[*pooledBrowser]
+	// sessions restores the marketplace sessions into the pages, it's used only if the sessions are enabled.
+	sessions repository.SessionRepository
+
+	// mu guards direct, the connections opened by Connect that are released on Close.
+	mu     sync.Mutex
+	direct []*pooledBrowser
+}
+
+// pooledBrowser is a long-lived browser connection, cancel stops its event loop and websocket.
+type pooledBrowser struct {
+	browser *rod.Browser
+	cancel  context.CancelFunc
+	// launcher is the launcher of the local browser process, nil in the remote mode.
+	launcher *launcher.Launcher
+	// cleanup removes the temporary data of the local browser after it exits, nil if there is nothing to remove.
+	cleanup func()
 }
 
 // Create a new chromium repository struct.
-func NewChromiumRepository(cfg *config.Config) *ChromiumRepository {
-	return &ChromiumRepository{cfg: NewChromiumConfig(cfg)}
+func NewChromiumRepository(cfg *config.Config, sessions repository.SessionRepository) *ChromiumRepository {
+	chCfg := NewChromiumConfig(cfg)
+	r := &ChromiumRepository{cfg: chCfg, nodes: chCfg.wsURLs, sessions: sessions}
+	if r.cfg.poolSize < 1 {
+		r.cfg.poolSize = 1
+	}
+	if r.cfg.mode == config.BrowserModeLocal {
+		// A single local browser is enough for debugging, and several ones can't share the user data dir
+		r.nodes = []string{localNode}
+		r.cfg.poolSize = 1
+	}
+	// Every node has poolSize connections, the connection i belongs to the node i / poolSize.
+	// The least busy connection is leased, so every node gets about poolMaxPages pages.
+	r.pool = pool.New(len(r.nodes)*r.cfg.poolSize, len(r.nodes)*r.cfg.poolMaxPages, r.dialPooled, checkPooled, closePooled)
+
+	return r
 }
 
-// Pings browser
+// Pings the pooled browser connections, the broken ones are reconnected.
 func (r *ChromiumRepository) Ping(ctx context.Context) error {
-	ctxBrowser, cancel := context.WithTimeout(ctx, time.Second*5)
+	ctxPing, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
 
-	browser, err := r.Connect(ctxBrowser)
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return repository.ErrGatewayTimeout
-		} else if errors.Is(err, context.Canceled) {
-			return repository.ErrClientClosedRequest
-		} else {
-			return err
-		}
+	if err := r.pool.HealthCheck(ctxPing); err != nil {
+		return mapContextError(ctxPing, err)
 	}
-	defer browser.Close()
 
 	return nil
 }
 
-// Connects to browser.
+// RunHealthCheck pings the browser connections every health check interval until ctx is done,
+// so the connections are restored after the remote chromium restarts.
+func (r *ChromiumRepository) RunHealthCheck(ctx context.Context, onError func(err error)) {
+	r.pool.Run(ctx, r.cfg.healthCheckInterval, onError)
+}
+
+// Close closes all pooled browser connections and releases the ones opened by Connect.
+func (r *ChromiumRepository) Close() error {
+	r.mu.Lock()
+	direct := r.direct
+	r.direct = nil
+	r.mu.Unlock()
+
+	// The browsers of Connect may be already closed by the caller, only their event loops are left to stop
+	for _, b := range direct {
+		_ = closePooled(b)
+	}
+
+	return r.pool.Close()
+}
+
+// Nodes returns the state of the chromium nodes.
+func (r *ChromiumRepository) Nodes() []repository.BrowserNode {
+	res := make([]repository.BrowserNode, 0, len(r.nodes))
+	for _, node := range r.nodes {
+		res = append(res, repository.BrowserNode{URL: redactURL(node)})
+	}
+
+	for _, s := range r.pool.Stats() {
+		node := &res[s.Index/r.cfg.poolSize]
+		if !s.Ejected {
+			node.Healthy = true
+		}
+		if s.Connected {
+			node.Connections++
+		}
+		node.Pages += s.InUse
+		node.Failures = max(node.Failures, s.Failures)
+		if s.CheckedAt.After(node.CheckedAt) {
+			node.CheckedAt = s.CheckedAt
+		}
+		if s.LastError != nil {
+			node.LastError = s.LastError.Error()
+		}
+	}
+
+	return res
+}
+
+// Connects to the first available browser node. The ctx only bounds the connecting,
+// the connection lives until the browser is closed, its event loop is stopped when the repository is closed.
 func (r *ChromiumRepository) Connect(ctx context.Context) (*rod.Browser, error) {
+	var errs []error
+	for _, node := range r.nodes {
+		res, err := r.connect(ctx, node)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		r.mu.Lock()
+		r.direct = append(r.direct, res)
+		r.mu.Unlock()
 
-	// ====== docker container (headless=true) ======
-	l, err := launcher.NewManaged(r.cfg.wsURL)
+		return res.browser, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (r *ChromiumRepository) dialPooled(ctx context.Context, i int) (*pooledBrowser, error) {
+	return r.connect(ctx, r.nodes[i/r.cfg.poolSize])
+}
+
+func checkPooled(ctx context.Context, b *pooledBrowser) error {
+	_, err := proto.BrowserGetVersion{}.Call(b.browser.Context(ctx))
+	return err
+}
+
+// closePooled closes the browser and stops its connection. The local browser process is killed
+// if it can't be closed gracefully, its temporary data is removed after it exits.
+func closePooled(b *pooledBrowser) error {
+	err := b.browser.Close()
+	b.cancel()
+	if b.launcher != nil && err != nil {
+		b.launcher.Kill()
+	}
+	if b.cleanup != nil {
+		b.cleanup()
+	}
+
+	return err
+}
+
+func (r *ChromiumRepository) connect(ctx context.Context, node string) (*pooledBrowser, error) {
+	// The event loop of the connection is bound to the context of the browser,
+	// so the connection must not be canceled with the ctx of the request once it's connected.
+	connCtx, cancel := context.WithCancel(context.Background())
+	stop := context.AfterFunc(ctx, cancel)
+
+	res, err := r.dial(connCtx, node)
+	if !stop() {
+		cancel()
+		return nil, mapContextError(ctx, ctx.Err())
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.cancel = cancel
+
+	return res, nil
+}
+
+// dial connects to the remote browser node or launches the local browser in the local mode.
+func (r *ChromiumRepository) dial(ctx context.Context, node string) (*pooledBrowser, error) {
+	if r.cfg.mode == config.BrowserModeLocal {
+		return r.launchLocal(ctx)
+	}
+
+	// ====== docker container ======
+	l, err := launcher.NewManaged(node)
 	if err != nil {
 		return nil, fmt.Errorf("new manager: %w", err)
 	}
+	r.setFlags(l.Context(ctx))
+
+	c, err := l.Client()
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+
+	browser := rod.New().Client(c).Context(ctx)
+	if err := browser.Connect(); err != nil {
+		return nil, fmt.Errorf("connect chromium: %w", err)
+	}
+
+	return &pooledBrowser{browser: browser}, nil
+}
+
+// launchLocal starts the chromium binary on this machine, the browser window is visible if the headless mode is off.
+func (r *ChromiumRepository) launchLocal(ctx context.Context) (*pooledBrowser, error) {
+	// ctx bounds the launching only, the process is stopped by closePooled
+	l := launcher.New().Context(ctx)
+	if r.cfg.bin != "" {
+		l.Bin(r.cfg.bin)
+	}
+	if r.cfg.userDataDir != "" {
+		l.UserDataDir(r.cfg.userDataDir)
+	}
+	r.setFlags(l)
+
+	u, err := l.Launch()
+	if err != nil {
+		return nil, fmt.Errorf("launch chromium: %w", err)
+	}
+
+	res := &pooledBrowser{launcher: l}
+	// The temporary user data dir is removed after the browser exits, the configured one is kept
+	if r.cfg.userDataDir == "" {
+		res.cleanup = l.Cleanup
+	}
+
+	res.browser = rod.New().ControlURL(u).Context(ctx)
+	if err := res.browser.Connect(); err != nil {
+		l.Kill()
+		if res.cleanup != nil {
+			res.cleanup()
+		}
+		return nil, fmt.Errorf("connect chromium: %w", err)
+	}
+
+	return res, nil
+}
+
+// setFlags sets the launch flags of the browser.
+// Launcher configuration to bypass anti-fraud systems (Ozon/WB) and optimize for Docker:
+// - user-agent: emulate a real user.
+// - headless=new: use a modern engine with full support for graphics APIs, in the local mode only.
+// - AutomationControlled: hide the navigator.webdriver flag.
+// - disable-dev-shm-usage: use RAM instead of /dev/shm to avoid crashes in Docker.
+// The extra flags from config are set last, so they can override the default ones.
+func (r *ChromiumRepository) setFlags(l *launcher.Launcher) {
+	if r.cfg.mode == config.BrowserModeLocal {
+		l.HeadlessNew(r.cfg.headless)
+	}
 
-	// Launcher configuration to bypass anti-fraud systems (Ozon/WB) and optimize for Docker:
-	// - user-agent: emulate a real user.
-	// - headless=new: use a modern engine with full support for graphics APIs.
-	// - AutomationControlled: hide the navigator.webdriver flag.
-	// - disable-dev-shm-usage: use RAM instead of /dev/shm to avoid crashes in Docker.
 	l.Set("user-agent", userAgentWindows).
-		Set("headless", "new").
 		Set("disable-blink-features", "AutomationControlled").
 		Set("disable-infobars").
 		Set("disable-dev-shm-usage").
 		Set("lang", "ru-RU").
 		Set("disable-features", "IsolateOrigins,site-per-process")
 
-	c, err := l.Client()
-	if err != nil {
-		return nil, fmt.Errorf("client: %w", err)
+	for _, f := range r.cfg.flags {
+		name, value, ok := strings.Cut(strings.TrimLeft(f, "-"), "=")
+		if !ok {
+			l.Set(flags.Flag(name))
+			continue
+		}
+		l.Set(flags.Flag(name), value)
 	}
+}
 
-	browser := rod.New().Timeout(time.Second * 30).Client(c).Context(ctx)
-	if err := browser.Connect(); err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, repository.ErrGatewayTimeout
-		} else if errors.Is(err, context.Canceled) {
-			return nil, repository.ErrClientClosedRequest
-		} else {
-			return nil, fmt.Errorf("connect chromium: %w", err)
-		}
+// redactURL hides the credentials of the URL, so it can be shown in stats.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
 	}
 
-	// ===== debug browser (headless=false) ======
-	/*
-		u := launcher.
-			New().Headless(false).
-			Set("disable-blink-features", "AutomationControlled").
-			Set("disable-infobars").
-			Set("disable-dev-shm-usage").
-			Set("lang", "ru-RU").
-			Set("disable-features", "IsolateOrigins,site-per-process").
-			MustLaunch()
+	return u.Redacted()
+}
 
-		// Trace enables/disables the visual tracing of the input actions on the page
-		browser := rod.New().ControlURL(u).Trace(false).MustConnect()
+// mapContextError maps the error to the repository error if ctx is done.
+func mapContextError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return repository.ErrGatewayTimeout
+	case errors.Is(ctx.Err(), context.Canceled):
+		return repository.ErrClientClosedRequest
+	default:
+		return err
+	}
+}
 
-	*/
+// connectionError reports the failure of a CDP call as repository.ErrConnectionLost if it isn't answered by the browser,
+// the protocol errors and the failures after ctx is done are returned as is.
+func connectionError(ctx context.Context, err error) error {
+	var cdpErr *cdp.Error
+	if ctx.Err() != nil || errors.As(err, &cdpErr) {
+		return err
+	}
 
-	return browser, nil
+	return fmt.Errorf("%w: %w", repository.ErrConnectionLost, err)
 }
 
-// Creates new page with flags and user-agent
-func (r *ChromiumRepository) NewPage(ctx context.Context) (repository.Page, error) {
-	browser, err := r.Connect(ctx)
+// Creates new page with flags and user-agent in an isolated incognito context of a pooled browser connection.
+// The number of opened pages is limited, so it waits for a free slot until ctx is done.
+// The stored session of the options is restored into the page before it's navigated anywhere.
+// The page connects through the proxy of the options or the browser one, the proxy auth challenges are answered by the page.
+func (r *ChromiumRepository) NewPage(ctx context.Context, opts repository.PageOptions) (_ repository.Page, err error) {
+	lease, err := r.pool.Acquire(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("connect browser: %w", err)
+		return nil, fmt.Errorf("acquire browser: %w", mapContextError(ctx, err))
 	}
+	defer func() {
+		if err != nil {
+			lease.Release()
+		}
+	}()
 
-	page, err := browser.Page(proto.TargetCreateTarget{})
+	proxy := &r.cfg.proxy
+	if opts.Proxy != nil {
+		proxy = opts.Proxy
+	}
+
+	browser, err := newBrowserContext(lease.Value.browser.Context(ctx), proxy)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, repository.ErrGatewayTimeout
-		} else if errors.Is(err, context.Canceled) {
-			return nil, repository.ErrClientClosedRequest
-		} else {
-			return nil, fmt.Errorf("page: %w", err)
+		if ctx.Err() == nil {
+			// The remote chromium is probably restarted, the connection is reopened on the next use
+			lease.Broken()
 		}
+		return nil, fmt.Errorf("incognito: %w", connectionError(ctx, mapContextError(ctx, err)))
+	}
+	defer func() {
+		if err != nil {
+			_ = browser.Context(context.WithoutCancel(ctx)).Close()
+		}
+	}()
+
+	page, err := browser.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return nil, fmt.Errorf("page: %w", mapContextError(ctx, err))
 	}
 
 	if err := page.SetUserAgent(&proto.NetworkSetUserAgentOverride{
@@ -154,9 +392,50 @@ func (r *ChromiumRepository) NewPage(ctx context.Context) (repository.Page, erro
 	// ua, err := page.Evaluate(&rod.EvalOptions{JS: `() => navigator.userAgent`})
 	// fmt.Printf("navigator.userAgent=%v\n", ua)
 
-	return &rodPage{
+	res := &rodPage{
 		page:    page,
-		browser: browser,
+		browser: browser.Context(context.Background()),
+		release: lease.Release,
 		cfg:     r.cfg,
-	}, nil
+	}
+	res.intercept = newInterceptor(page)
+	if proxy.Server != "" && proxy.Username != "" {
+		if err := res.intercept.SetProxyAuth(ctx, proxy); err != nil {
+			res.intercept.Stop()
+			return nil, fmt.Errorf("proxy auth: %w", mapContextError(ctx, err))
+		}
+	}
+	if r.cfg.sessionsEnabled && opts.Session != nil {
+		res.sessions = r.sessions
+		res.session = opts.Session
+		if err := res.restoreSession(ctx); err != nil {
+			res.intercept.Stop()
+			return nil, fmt.Errorf("restore session: %w", mapContextError(ctx, err))
+		}
+	}
+	if r.cfg.harEnabled {
+		res.har = newHARSession(page)
+	}
+
+	return res, nil
+}
+
+// newBrowserContext creates an isolated incognito context of the browser like rod.Browser.Incognito,
+// the pages of the context connect through the proxy if its server is set.
+func newBrowserContext(browser *rod.Browser, proxy *repository.Proxy) (*rod.Browser, error) {
+	req := proto.TargetCreateBrowserContext{}
+	if proxy.Server != "" {
+		req.ProxyServer = proxy.Server
+		req.ProxyBypassList = strings.Join(proxy.Bypass, ",")
+	}
+
+	res, err := req.Call(browser)
+	if err != nil {
+		return nil, err
+	}
+
+	incognito := *browser
+	incognito.BrowserContextID = res.BrowserContextID
+
+	return &incognito, nil
 }
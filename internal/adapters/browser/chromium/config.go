@@ -4,23 +4,66 @@ import (
 	"time"
 
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
 )
 
 type Config struct {
-	wsURL          string
+	mode string
+	// wsURLs are the endpoints of the chromium nodes.
+	wsURLs         []string
 	referer        string
 	acceptLanguage string
 	// domStableDuration in milliseconds
 	domStableDuration time.Duration
 	domStableDiff     float64
+	// domStableTimeout bounds the wait for the stable DOM, zero means ctx bounds it.
+	domStableTimeout time.Duration
+
+	headless bool
+	// bin, userDataDir and flags are used by the local mode, flags are used by the remote mode too.
+	bin         string
+	userDataDir string
+	flags       []string
+
+	poolSize            int
+	poolMaxPages        int
+	healthCheckInterval time.Duration
+
+	// proxy is the default proxy of the pages, its server is empty if the pages connect directly.
+	proxy repository.Proxy
+
+	sessionsEnabled bool
+
+	harEnabled        bool
+	harDir            string
+	harIncludeContent bool
 }
 
 func NewChromiumConfig(cfg *config.Config) *Config {
 	return &Config{
-		wsURL:             cfg.Browser.WsURL,
-		referer:           cfg.Browser.Referer,
-		acceptLanguage:    cfg.Browser.AcceptLanguage,
-		domStableDuration: cfg.Browser.DomStableDuration,
-		domStableDiff:     cfg.Browser.DomStableDiff,
+		mode:                cfg.Browser.Mode,
+		headless:            cfg.Browser.HeadlessMode,
+		bin:                 cfg.Browser.Local.Bin,
+		userDataDir:         cfg.Browser.Local.UserDataDir,
+		flags:               cfg.Browser.Flags,
+		wsURLs:              cfg.Browser.Endpoints(),
+		referer:             cfg.Browser.Referer,
+		acceptLanguage:      cfg.Browser.AcceptLanguage,
+		domStableDuration:   cfg.Browser.DomStableDuration,
+		domStableDiff:       cfg.Browser.DomStableDiff,
+		domStableTimeout:    cfg.Browser.DomStableTimeout,
+		poolSize:            cfg.Browser.Pool.Size,
+		poolMaxPages:        cfg.Browser.Pool.MaxPages,
+		healthCheckInterval: cfg.Browser.Pool.HealthCheckInterval,
+		proxy: repository.Proxy{
+			Server:   cfg.Browser.Proxy.URL,
+			Username: cfg.Browser.Proxy.Username,
+			Password: cfg.Browser.Proxy.Password,
+			Bypass:   cfg.Browser.Proxy.Bypass,
+		},
+		sessionsEnabled:   cfg.Sessions.Enabled,
+		harEnabled:        cfg.Browser.HAR.Enabled,
+		harDir:            cfg.Browser.HAR.Dir,
+		harIncludeContent: cfg.Browser.HAR.IncludeContent,
 	}
 }
@@ -0,0 +1,98 @@
+package chromium
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/har"
+)
+
+const harSaveTimeout = time.Second * 10
+
+// harSession records the network events of a page until it's stopped.
+type harSession struct {
+	recorder *har.Recorder
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func newHARSession(page *rod.Page) *harSession {
+	ctx, cancel := context.WithCancel(page.GetContext())
+	s := &harSession{recorder: har.NewRecorder(), cancel: cancel, done: make(chan struct{})}
+
+	// The Network domain is enabled by EachEvent while the events are listened to
+	wait := page.Context(ctx).EachEvent(
+		s.recorder.OnRequestWillBeSent,
+		s.recorder.OnResponseReceived,
+		s.recorder.OnLoadingFinished,
+		s.recorder.OnLoadingFailed,
+	)
+	go func() {
+		defer close(s.done)
+		wait()
+	}()
+
+	return s
+}
+
+// Save stops the recording and writes the HAR file to dir, it must be called before the page is closed.
+func (s *harSession) Save(page *rod.Page, dir string, includeContent bool) (string, error) {
+	s.cancel()
+	<-s.done
+
+	// The page context may be already done with the request, the HAR is saved anyway
+	ctx, cancel := context.WithTimeout(context.Background(), harSaveTimeout)
+	defer cancel()
+	page = page.Context(ctx)
+
+	if includeContent {
+		// The bodies may be already evicted from the browser cache, such responses are saved without them
+		for _, id := range s.recorder.Loaded() {
+			body, err := proto.NetworkGetResponseBody{RequestID: id}.Call(page)
+			if err != nil {
+				continue
+			}
+			s.recorder.SetContent(id, body.Body, body.Base64Encoded)
+		}
+	}
+
+	harJSON, err := json.Marshal(s.recorder.HAR())
+	if err != nil {
+		return "", fmt.Errorf("marshal har: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create har dir: %w", err)
+	}
+
+	path := filepath.Join(dir, harFileName(page))
+	if err := os.WriteFile(path, harJSON, 0o644); err != nil {
+		return "", fmt.Errorf("write har: %w", err)
+	}
+
+	return path, nil
+}
+
+// harFileName names the file by the time and the host of the page, the target id makes it unique.
+func harFileName(page *rod.Page) string {
+	host := "blank"
+	if info, err := page.Info(); err == nil {
+		if u, err := url.Parse(info.URL); err == nil && u.Host != "" {
+			host = u.Host
+		}
+	}
+
+	id := string(page.TargetID)
+	if len(id) > 8 {
+		id = id[:8]
+	}
+
+	return fmt.Sprintf("%s-%s-%s.har", time.Now().UTC().Format("20060102T150405.000"), host, id)
+}
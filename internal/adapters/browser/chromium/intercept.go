@@ -0,0 +1,173 @@
+package chromium
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+// interceptor pauses the requests of a page to fail the blocked ones and to answer the proxy auth challenges.
+// Both use the Fetch domain of the page, so they must be handled by the same event loop.
+type interceptor struct {
+	page  *rod.Page
+	rules interceptRules
+
+	stop context.CancelFunc
+}
+
+// interceptRules are replaced as a whole, so the handlers of the paused requests never see them half updated.
+type interceptRules struct {
+	resourceTypes map[proto.NetworkResourceType]bool
+	urlPatterns   []string
+	urlRegexps    []*regexp.Regexp
+	// proxyAuth is the proxy the auth challenges are answered for, nil if the proxy doesn't need auth.
+	proxyAuth *repository.Proxy
+}
+
+func newInterceptor(page *rod.Page) *interceptor {
+	return &interceptor{page: page}
+}
+
+// SetBlocking replaces the rules of the blocked requests and restarts the interception.
+func (i *interceptor) SetBlocking(ctx context.Context, blocking repository.RequestBlocking) error {
+	resourceTypes := make(map[proto.NetworkResourceType]bool, len(blocking.ResourceTypes))
+	for _, t := range blocking.ResourceTypes {
+		resourceType, err := parseResourceType(t)
+		if err != nil {
+			return err
+		}
+		resourceTypes[resourceType] = true
+	}
+
+	urlRegexps := make([]*regexp.Regexp, 0, len(blocking.URLPatterns))
+	for _, pattern := range blocking.URLPatterns {
+		re, err := regexp.Compile(proto.PatternToReg(pattern))
+		if err != nil {
+			return fmt.Errorf("url pattern %q: %w", pattern, err)
+		}
+		urlRegexps = append(urlRegexps, re)
+	}
+
+	rules := i.rules
+	rules.resourceTypes = resourceTypes
+	rules.urlPatterns = blocking.URLPatterns
+	rules.urlRegexps = urlRegexps
+
+	return i.start(ctx, rules)
+}
+
+// SetProxyAuth sets the proxy the auth challenges are answered for and restarts the interception.
+func (i *interceptor) SetProxyAuth(ctx context.Context, proxy *repository.Proxy) error {
+	rules := i.rules
+	rules.proxyAuth = proxy
+
+	return i.start(ctx, rules)
+}
+
+// start enables the Fetch domain with the patterns of the rules and handles the paused requests until Stop is called.
+func (i *interceptor) start(ctx context.Context, rules interceptRules) error {
+	i.Stop()
+	i.rules = rules
+
+	var patterns []*proto.FetchRequestPattern
+	if rules.proxyAuth != nil {
+		// The auth challenges are reported only for the intercepted requests
+		patterns = []*proto.FetchRequestPattern{{URLPattern: "*"}}
+	} else {
+		for t := range rules.resourceTypes {
+			patterns = append(patterns, &proto.FetchRequestPattern{URLPattern: "*", ResourceType: t})
+		}
+		for _, pattern := range rules.urlPatterns {
+			patterns = append(patterns, &proto.FetchRequestPattern{URLPattern: pattern})
+		}
+	}
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	if err := (proto.FetchEnable{Patterns: patterns, HandleAuthRequests: rules.proxyAuth != nil}).Call(i.page.Context(ctx)); err != nil {
+		return fmt.Errorf("enable fetch: %w", err)
+	}
+
+	// The page outlives the ctx of the call, so the events are handled until Stop
+	page := i.page.Context(context.WithoutCancel(ctx))
+	eventCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	i.stop = func() {
+		cancel()
+		_ = proto.FetchDisable{}.Call(page)
+	}
+
+	// The Fetch domain is already enabled, so EachEvent doesn't change it
+	wait := page.Context(eventCtx).EachEvent(
+		func(e *proto.FetchRequestPaused) {
+			go rules.handleRequest(page, e)
+		},
+		func(e *proto.FetchAuthRequired) {
+			go rules.handleAuth(page, e)
+		},
+	)
+	go wait()
+
+	return nil
+}
+
+// Stop stops the interception, the following requests aren't paused.
+func (i *interceptor) Stop() {
+	if i.stop != nil {
+		i.stop()
+		i.stop = nil
+	}
+}
+
+func (r interceptRules) handleRequest(page *rod.Page, e *proto.FetchRequestPaused) {
+	if r.blocked(e) {
+		_ = proto.FetchFailRequest{RequestID: e.RequestID, ErrorReason: proto.NetworkErrorReasonBlockedByClient}.Call(page)
+		return
+	}
+
+	_ = proto.FetchContinueRequest{RequestID: e.RequestID}.Call(page)
+}
+
+func (r interceptRules) blocked(e *proto.FetchRequestPaused) bool {
+	if r.resourceTypes[e.ResourceType] {
+		return true
+	}
+	for _, re := range r.urlRegexps {
+		if re.MatchString(e.Request.URL) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleAuth answers the proxy challenges with the proxy credentials, the challenges of the sites are left to the browser.
+func (r interceptRules) handleAuth(page *rod.Page, e *proto.FetchAuthRequired) {
+	res := &proto.FetchAuthChallengeResponse{Response: proto.FetchAuthChallengeResponseResponseDefault}
+	if r.proxyAuth != nil && e.AuthChallenge.Source == proto.FetchAuthChallengeSourceProxy {
+		res = &proto.FetchAuthChallengeResponse{
+			Response: proto.FetchAuthChallengeResponseResponseProvideCredentials,
+			Username: r.proxyAuth.Username,
+			Password: r.proxyAuth.Password,
+		}
+	}
+
+	_ = proto.FetchContinueWithAuth{RequestID: e.RequestID, AuthChallengeResponse: res}.Call(page)
+}
+
+// parseResourceType returns the CDP resource type of the config one, the config validates them on load.
+func parseResourceType(s string) (proto.NetworkResourceType, error) {
+	for _, t := range config.ResourceTypes {
+		if strings.EqualFold(t, s) {
+			return proto.NetworkResourceType(t), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown resource type %q", s)
+}
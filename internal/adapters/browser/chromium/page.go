@@ -2,6 +2,9 @@ package chromium
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -12,28 +15,57 @@ import (
 )
 
 type rodPage struct {
-	page    *rod.Page
+	page *rod.Page
+	// browser is the incognito context of the page, closing it doesn't close the pooled connection.
 	browser *rod.Browser
+	// release returns the pooled connection, nil if the page isn't pooled.
+	release func()
 	cfg     *Config
+	// har is the network traffic recording, nil if the recording is disabled.
+	har *harSession
+	// harPath is the file the recording is saved to, empty until it's saved.
+	harPath string
+	// intercept fails the blocked requests and answers the proxy auth challenges.
+	intercept *interceptor
+	// session is the key of the stored session of the page, nil if the page has no session.
+	session  *repository.SessionKey
+	sessions repository.SessionRepository
+	// generation is the sessions generation the page was opened in, the session isn't saved if it has been reset since.
+	generation uint64
 }
 
 // NavigatePageWithReferer navigates current page to the given baseURL.
+// The network failures of the navigation are reported as repository.ErrNavigation.
 func (p *rodPage) NavigateWithReferer(ctx context.Context, baseURL string) error {
-	_, err := proto.PageNavigate{
+	res, err := proto.PageNavigate{
 		URL:      baseURL,
 		Referrer: p.cfg.referer,
 	}.Call(p.page)
 	if err != nil {
-		return err
+		return connectionError(ctx, err)
+	}
+	if res.ErrorText != "" {
+		return fmt.Errorf("%w: %s", repository.ErrNavigation, res.ErrorText)
 	}
 
 	return nil
 }
 
 // WaitDOMStable waits until the change of the DOM tree is less or equal than domStableDiff percent for domStableDuration.
+// It fails with repository.ErrDOMUnstable if the DOM isn't stable in domStableTimeout.
 func (p *rodPage) WaitDOMStable(ctx context.Context) error {
-	if err := p.page.Context(ctx).WaitDOMStable(p.cfg.domStableDuration, p.cfg.domStableDiff); err != nil {
-		return err
+	waitCtx := ctx
+	if p.cfg.domStableTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, p.cfg.domStableTimeout)
+		defer cancel()
+	}
+
+	if err := p.page.Context(waitCtx).WaitDOMStable(p.cfg.domStableDuration, p.cfg.domStableDiff); err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return fmt.Errorf("%w in %s", repository.ErrDOMUnstable, p.cfg.domStableTimeout)
+		}
+		return connectionError(ctx, err)
 	}
 
 	return nil
@@ -120,10 +152,26 @@ func (p *rodPage) MoveCursorToElement(ctx context.Context, elemName string) erro
 	return nil
 }
 
-// Close closes active page and browser
+// Close closes active page and its incognito context, the network traffic of the page is saved before if it's recorded.
+// The pooled browser connection stays opened and is returned to the pool.
 func (p *rodPage) Close() error {
+	if p.release != nil {
+		defer p.release()
+	}
+
+	var harErr error
+	if p.har != nil && p.page != nil && p.harPath == "" {
+		harErr = p.saveHAR()
+	}
+
+	if p.intercept != nil {
+		p.intercept.Stop()
+	}
+
+	// The ctx of the request may be already done, but the page must be closed anyway
+	// to not leak it in the long-lived browser.
 	if p.page != nil {
-		if err := p.page.Close(); err != nil {
+		if err := p.page.Context(context.Background()).Close(); err != nil {
 			return err
 		}
 	}
@@ -133,5 +181,233 @@ func (p *rodPage) Close() error {
 		}
 	}
 
+	return harErr
+}
+
+// BlockRequests fails the requests of the given resource types and URL patterns, so they aren't loaded.
+// The rules replace the previously set ones.
+func (p *rodPage) BlockRequests(ctx context.Context, rules repository.RequestBlocking) error {
+	return p.intercept.SetBlocking(ctx, rules)
+}
+
+// Cookies returns cookies of the current page URL.
+func (p *rodPage) Cookies(ctx context.Context) ([]repository.Cookie, error) {
+	cookies, err := p.page.Context(ctx).Cookies(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]repository.Cookie, 0, len(cookies))
+	for _, c := range cookies {
+		cookie := repository.Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			HTTPOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+		}
+		// Session cookies have no expiration time
+		if !c.Session {
+			cookie.Expires = c.Expires.Time()
+		}
+		res = append(res, cookie)
+	}
+
+	return res, nil
+}
+
+// SetCookies sets cookies in the browser, they are sent with the requests of the following navigations.
+func (p *rodPage) SetCookies(ctx context.Context, cookies []repository.Cookie) error {
+	params := make([]*proto.NetworkCookieParam, 0, len(cookies))
+	for _, c := range cookies {
+		param := &proto.NetworkCookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			HTTPOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+		}
+		if !c.Expires.IsZero() {
+			param.Expires = proto.TimeSinceEpoch(c.Expires.Unix())
+		}
+		params = append(params, param)
+	}
+
+	return p.page.Context(ctx).SetCookies(params)
+}
+
+// LocalStorage returns all localStorage items of the current page origin.
+func (p *rodPage) LocalStorage(ctx context.Context) (map[string]string, error) {
+	res, err := p.page.Context(ctx).Eval(`() => JSON.stringify(Object.assign({}, window.localStorage))`)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]string)
+	if err := json.Unmarshal([]byte(res.Value.Str()), &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// SetLocalStorage sets localStorage items of the first document of every origin the page loads from now on,
+// so the items are available to the site scripts before they run. The items are set once per origin,
+// so the later navigations keep the changes the site makes to them.
+func (p *rodPage) SetLocalStorage(ctx context.Context, items map[string]string) error {
+	itemsJSON, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	// sessionStorage outlives the navigations of the page within the origin, it marks the origins the items are set in
+	marker := fmt.Sprintf("mps-local-storage-%d", time.Now().UnixNano())
+	js := fmt.Sprintf(`(() => {
+		if (window.top !== window) return;
+		try {
+			if (window.sessionStorage.getItem(%q)) return;
+			for (const [k, v] of Object.entries(%s)) window.localStorage.setItem(k, v);
+			window.sessionStorage.setItem(%q, "1");
+		} catch (e) {}
+	})()`, marker, itemsJSON, marker)
+
+	if _, err := p.page.Context(ctx).EvalOnNewDocument(js); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// restoreSession sets the stored cookies and localStorage of the page session, the expired cookies are skipped.
+func (p *rodPage) restoreSession(ctx context.Context) error {
+	// The generation is taken before the load, so a reset during the restore drops the page's session too
+	p.generation = p.sessions.Generation(ctx, p.session.Marketplace)
+
+	session, err := p.sessions.Load(ctx, *p.session)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	cookies := make([]repository.Cookie, 0, len(session.Cookies))
+	for _, c := range session.Cookies {
+		if c.Expires.IsZero() || c.Expires.After(now) {
+			cookies = append(cookies, c)
+		}
+	}
+
+	if len(cookies) > 0 {
+		if err := p.SetCookies(ctx, cookies); err != nil {
+			return fmt.Errorf("set cookies: %w", err)
+		}
+	}
+	if len(session.LocalStorage) > 0 {
+		if err := p.SetLocalStorage(ctx, session.LocalStorage); err != nil {
+			return fmt.Errorf("set local storage: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SaveSession stores the cookies and localStorage of the current page as the page session.
+func (p *rodPage) SaveSession(ctx context.Context) error {
+	if p.session == nil {
+		return nil
+	}
+
+	cookies, err := p.Cookies(ctx)
+	if err != nil {
+		return fmt.Errorf("cookies: %w", err)
+	}
+	localStorage, err := p.LocalStorage(ctx)
+	if err != nil {
+		return fmt.Errorf("local storage: %w", err)
+	}
+
+	if err := p.sessions.Save(ctx, *p.session, repository.Session{
+		Cookies:      cookies,
+		LocalStorage: localStorage,
+		Generation:   p.generation,
+	}); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+
+	return nil
+}
+
+// URL returns the url of the current page.
+func (p *rodPage) URL(ctx context.Context) (string, error) {
+	info, err := p.page.Context(ctx).Info()
+	if err != nil {
+		return "", err
+	}
+
+	return info.URL, nil
+}
+
+// HTML returns the HTML of the current page document.
+func (p *rodPage) HTML(ctx context.Context) (string, error) {
+	return p.page.Context(ctx).HTML()
+}
+
+// Text returns the document title and the innerText of the body of the current page.
+func (p *rodPage) Text(ctx context.Context) (string, error) {
+	res, err := p.page.Context(ctx).Eval(`() => document.title + "\n" + (document.body ? document.body.innerText : "")`)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Value.Str(), nil
+}
+
+// Snapshot captures the url, the HTML and a full-page PNG screenshot of the page.
+// The recorded network traffic is saved with the snapshot, so the path of the HAR file is known to the caller.
+// The parts captured before a failure are returned along with the error.
+func (p *rodPage) Snapshot(ctx context.Context) (repository.Snapshot, error) {
+	var res repository.Snapshot
+	var errs []error
+
+	url, err := p.URL(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("url: %w", err))
+	}
+	res.URL = url
+
+	html, err := p.HTML(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("html: %w", err))
+	}
+	res.HTML = html
+
+	img, err := p.page.Context(ctx).Screenshot(true, &proto.PageCaptureScreenshot{Format: proto.PageCaptureScreenshotFormatPng})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("screenshot: %w", err))
+	}
+	res.Screenshot = img
+
+	if p.har != nil && p.harPath == "" {
+		if err := p.saveHAR(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	res.HARPath = p.harPath
+
+	return res, errors.Join(errs...)
+}
+
+// saveHAR stops the network traffic recording and saves it, the page records nothing after.
+func (p *rodPage) saveHAR() error {
+	path, err := p.har.Save(p.page, p.cfg.harDir, p.cfg.harIncludeContent)
+	if err != nil {
+		return fmt.Errorf("save har: %w", err)
+	}
+	p.harPath = path
+
 	return nil
 }
@@ -0,0 +1,215 @@
+package har
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// Recorder collects the network events of a page into HAR entries.
+// Redirects are recorded as separate entries, like browsers do.
+type Recorder struct {
+	mu      sync.Mutex
+	entries []*entry
+	// current maps the request id to its latest entry, the id is reused by redirects.
+	current map[proto.NetworkRequestID]*entry
+}
+
+type entry struct {
+	Entry
+	requestID proto.NetworkRequestID
+	// startedAt is the monotonic time of the request in seconds.
+	startedAt float64
+	finished  bool
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{current: make(map[proto.NetworkRequestID]*entry)}
+}
+
+// OnRequestWillBeSent starts a new entry, the entry of a redirected request is completed with the redirect response.
+func (r *Recorder) OnRequestWillBeSent(e *proto.NetworkRequestWillBeSent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if prev, ok := r.current[e.RequestID]; ok && e.RedirectResponse != nil {
+		prev.setResponse(e.RedirectResponse)
+		prev.finish(float64(e.Timestamp))
+	}
+
+	ent := &entry{requestID: e.RequestID, startedAt: float64(e.Timestamp)}
+	ent.StartedDateTime = e.WallTime.Time()
+	ent.ResourceType = string(e.Type)
+	ent.Request = Request{
+		Method:      e.Request.Method,
+		URL:         e.Request.URL + e.Request.URLFragment,
+		Cookies:     []NameValue{},
+		Headers:     headers(e.Request.Headers),
+		QueryString: queryString(e.Request.URL),
+		HeadersSize: -1,
+		BodySize:    len(e.Request.PostData),
+	}
+	if e.Request.PostData != "" {
+		ent.Request.PostData = &PostData{MimeType: headerValue(e.Request.Headers, "Content-Type"), Text: e.Request.PostData}
+	}
+	ent.Response = Response{Cookies: []NameValue{}, Headers: []NameValue{}, HeadersSize: -1, BodySize: -1}
+	ent.Timings = Timings{Send: -1, Wait: -1, Receive: -1}
+
+	r.entries = append(r.entries, ent)
+	r.current[e.RequestID] = ent
+}
+
+// OnResponseReceived sets the response of the request.
+func (r *Recorder) OnResponseReceived(e *proto.NetworkResponseReceived) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ent, ok := r.current[e.RequestID]; ok {
+		ent.setResponse(e.Response)
+	}
+}
+
+// OnLoadingFinished completes the entry of the request.
+func (r *Recorder) OnLoadingFinished(e *proto.NetworkLoadingFinished) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ent, ok := r.current[e.RequestID]; ok {
+		ent.Response.BodySize = int(e.EncodedDataLength)
+		ent.finish(float64(e.Timestamp))
+	}
+}
+
+// OnLoadingFailed completes the entry of the request with the error.
+func (r *Recorder) OnLoadingFailed(e *proto.NetworkLoadingFailed) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ent, ok := r.current[e.RequestID]; ok {
+		ent.Error = e.ErrorText
+		ent.finish(float64(e.Timestamp))
+	}
+}
+
+// Loaded returns the ids of the requests whose response bodies were loaded.
+func (r *Recorder) Loaded() []proto.NetworkRequestID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]proto.NetworkRequestID, 0, len(r.current))
+	for id, ent := range r.current {
+		if ent.finished && ent.Error == "" {
+			res = append(res, id)
+		}
+	}
+
+	return res
+}
+
+// SetContent sets the response body of the request.
+func (r *Recorder) SetContent(id proto.NetworkRequestID, body string, base64Encoded bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ent, ok := r.current[id]; ok {
+		ent.Response.Content.Text = body
+		if base64Encoded {
+			ent.Response.Content.Encoding = "base64"
+		}
+	}
+}
+
+// HAR returns the log of the recorded entries in the order the requests were sent.
+func (r *Recorder) HAR() *HAR {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]Entry, 0, len(r.entries))
+	for _, ent := range r.entries {
+		entries = append(entries, ent.Entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].StartedDateTime.Before(entries[j].StartedDateTime) })
+
+	return &HAR{Log: Log{
+		Version: version,
+		Creator: Creator{Name: creatorName, Version: version},
+		Entries: entries,
+	}}
+}
+
+func (ent *entry) setResponse(resp *proto.NetworkResponse) {
+	ent.Request.HTTPVersion = resp.Protocol
+	if len(resp.RequestHeaders) > 0 {
+		ent.Request.Headers = headers(resp.RequestHeaders)
+	}
+
+	ent.ServerIPAddress = resp.RemoteIPAddress
+	ent.Response.Status = resp.Status
+	ent.Response.StatusText = resp.StatusText
+	ent.Response.HTTPVersion = resp.Protocol
+	ent.Response.Headers = headers(resp.Headers)
+	ent.Response.RedirectURL = headerValue(resp.Headers, "Location")
+	ent.Response.Content.MimeType = resp.MIMEType
+	ent.Response.Content.Size = int(resp.EncodedDataLength)
+
+	if t := resp.Timing; t != nil {
+		ent.Timings.Send = nonNegative(t.SendEnd - t.SendStart)
+		ent.Timings.Wait = nonNegative(t.ReceiveHeadersEnd - t.SendEnd)
+	}
+}
+
+func (ent *entry) finish(timestamp float64) {
+	ent.finished = true
+	ent.Time = nonNegative((timestamp - ent.startedAt) * 1000)
+	if ent.Timings.Send >= 0 && ent.Timings.Wait >= 0 {
+		ent.Timings.Receive = nonNegative(ent.Time - ent.Timings.Send - ent.Timings.Wait)
+	}
+}
+
+func headers(h proto.NetworkHeaders) []NameValue {
+	res := make([]NameValue, 0, len(h))
+	for name, value := range h {
+		res = append(res, NameValue{Name: name, Value: value.Str()})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res
+}
+
+func headerValue(h proto.NetworkHeaders, name string) string {
+	for k, v := range h {
+		if strings.EqualFold(k, name) {
+			return v.Str()
+		}
+	}
+
+	return ""
+}
+
+func queryString(rawURL string) []NameValue {
+	res := []NameValue{}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return res
+	}
+
+	for name, values := range u.Query() {
+		for _, v := range values {
+			res = append(res, NameValue{Name: name, Value: v})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res
+}
+
+func nonNegative(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,91 @@
+package har_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/har"
+	"github.com/ysmood/gson"
+)
+
+func TestHAR_Recorder(t *testing.T) {
+	rec := har.NewRecorder()
+
+	rec.OnRequestWillBeSent(&proto.NetworkRequestWillBeSent{
+		RequestID: "1",
+		Request: &proto.NetworkRequest{
+			URL:     "http://wildberries.ru/?q=1",
+			Method:  "GET",
+			Headers: proto.NetworkHeaders{"Accept": gson.New("text/html")},
+		},
+		Timestamp: 10,
+		WallTime:  1700000000,
+		Type:      proto.NetworkResourceTypeDocument,
+	})
+	// Redirect to https reuses the request id
+	rec.OnRequestWillBeSent(&proto.NetworkRequestWillBeSent{
+		RequestID: "1",
+		Request:   &proto.NetworkRequest{URL: "https://www.wildberries.ru/", Method: "GET"},
+		RedirectResponse: &proto.NetworkResponse{
+			Status:  301,
+			Headers: proto.NetworkHeaders{"Location": gson.New("https://www.wildberries.ru/")},
+		},
+		Timestamp: 10.1,
+		WallTime:  1700000000.1,
+		Type:      proto.NetworkResourceTypeDocument,
+	})
+	rec.OnResponseReceived(&proto.NetworkResponseReceived{
+		RequestID: "1",
+		Response: &proto.NetworkResponse{
+			Status:          200,
+			StatusText:      "OK",
+			Protocol:        "h2",
+			MIMEType:        "text/html",
+			RemoteIPAddress: "1.2.3.4",
+			Timing:          &proto.NetworkResourceTiming{SendStart: 1, SendEnd: 2, ReceiveHeadersEnd: 52},
+		},
+	})
+	rec.OnLoadingFinished(&proto.NetworkLoadingFinished{RequestID: "1", Timestamp: 10.2, EncodedDataLength: 1024})
+	rec.SetContent("1", "<html></html>", false)
+
+	rec.OnRequestWillBeSent(&proto.NetworkRequestWillBeSent{
+		RequestID: "2",
+		Request:   &proto.NetworkRequest{URL: "https://www.wildberries.ru/api", Method: "POST", PostData: "{}"},
+		Timestamp: 10.3,
+		WallTime:  1700000000.3,
+		Type:      proto.NetworkResourceTypeXHR,
+	})
+	rec.OnLoadingFailed(&proto.NetworkLoadingFailed{RequestID: "2", Timestamp: 10.4, ErrorText: "net::ERR_FAILED"})
+
+	assert.Equal(t, []proto.NetworkRequestID{"1"}, rec.Loaded())
+
+	res := rec.HAR()
+	assert.Equal(t, "1.2", res.Log.Version)
+	assert.Len(t, res.Log.Entries, 3)
+
+	redirect := res.Log.Entries[0]
+	assert.Equal(t, 301, redirect.Response.Status)
+	assert.Equal(t, "https://www.wildberries.ru/", redirect.Response.RedirectURL)
+	assert.Equal(t, []har.NameValue{{Name: "q", Value: "1"}}, redirect.Request.QueryString)
+	assert.Equal(t, []har.NameValue{{Name: "Accept", Value: "text/html"}}, redirect.Request.Headers)
+
+	document := res.Log.Entries[1]
+	assert.Equal(t, 200, document.Response.Status)
+	assert.Equal(t, "h2", document.Response.HTTPVersion)
+	assert.Equal(t, "1.2.3.4", document.ServerIPAddress)
+	assert.Equal(t, "<html></html>", document.Response.Content.Text)
+	assert.Equal(t, 1024, document.Response.BodySize)
+	assert.InDelta(t, 100.0, document.Time, 0.001)
+	assert.InDelta(t, 1.0, document.Timings.Send, 0.001)
+	assert.InDelta(t, 50.0, document.Timings.Wait, 0.001)
+	assert.InDelta(t, 49.0, document.Timings.Receive, 0.001)
+
+	failed := res.Log.Entries[2]
+	assert.Equal(t, "net::ERR_FAILED", failed.Error)
+	assert.Equal(t, "{}", failed.Request.PostData.Text)
+
+	_, err := json.Marshal(res)
+	assert.NoError(t, err)
+}
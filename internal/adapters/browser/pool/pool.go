@@ -0,0 +1,403 @@
+// Package pool keeps long-lived browser connections and limits the number of pages opened on them.
+package pool
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNoConnections = errors.New("no healthy connections")
+	// ErrBroken is the last error of the connection ejected by Lease.Broken.
+	ErrBroken = errors.New("connection is broken")
+)
+
+type (
+	// DialFunc opens the connection with the index i.
+	DialFunc[T any] func(ctx context.Context, i int) (T, error)
+	// CheckFunc returns an error if the connection is broken.
+	CheckFunc[T any] func(ctx context.Context, conn T) error
+	// CloseFunc closes the connection.
+	CloseFunc[T any] func(conn T) error
+)
+
+// Pool keeps a fixed number of connections. A connection is opened on the first use and
+// reopened after it's found broken, so the pool recovers when the remote browser restarts.
+// A connection that fails to open or to pass the health check is ejected: it's not used
+// until the next successful HealthCheck, unless all connections are ejected.
+// The ejected connection is closed after the leases that share it are released.
+type Pool[T any] struct {
+	dial  DialFunc[T]
+	check CheckFunc[T]
+	close CloseFunc[T]
+
+	// slots limits the number of acquired leases over all connections.
+	slots chan struct{}
+
+	mu    sync.Mutex
+	conns []*conn[T]
+}
+
+type conn[T any] struct {
+	index int
+
+	// dialMu serializes dialing of the connection.
+	dialMu sync.Mutex
+	// current is the opened connection, nil if it's not connected.
+	current *link[T]
+	inUse   int
+
+	ejected bool
+	// failures is the number of failures in a row.
+	failures  int
+	lastErr   error
+	checkedAt time.Time
+}
+
+// link is an opened connection shared by the leases.
+type link[T any] struct {
+	value T
+	// leases is the number of the unreleased leases of the link.
+	leases int
+	// detached is true after the link is replaced or closed, it's closed when the last lease is released.
+	detached bool
+}
+
+// Lease is a connection acquired from the pool, it must be released after use.
+type Lease[T any] struct {
+	Value T
+	// Index is the index of the leased connection.
+	Index int
+
+	pool *Pool[T]
+	conn *conn[T]
+	link *link[T]
+	once sync.Once
+}
+
+type Stats struct {
+	Index     int
+	Connected bool
+	InUse     int
+	Ejected   bool
+	Failures  int
+	// LastError is the last dial or health check error, nil after a success.
+	LastError error
+	// CheckedAt is the time of the last dial or health check, zero if there were none.
+	CheckedAt time.Time
+}
+
+func New[T any](size int, maxInUse int, dial DialFunc[T], check CheckFunc[T], close CloseFunc[T]) *Pool[T] {
+	if size < 1 {
+		size = 1
+	}
+	if maxInUse < 1 {
+		maxInUse = 1
+	}
+
+	conns := make([]*conn[T], 0, size)
+	for i := range size {
+		conns = append(conns, &conn[T]{index: i})
+	}
+
+	return &Pool[T]{
+		dial:  dial,
+		check: check,
+		close: close,
+		slots: make(chan struct{}, maxInUse),
+		conns: conns,
+	}
+}
+
+// Acquire waits for a free slot and returns the least busy connection, the connection is opened if needed.
+func (p *Pool[T]) Acquire(ctx context.Context) (*Lease[T], error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var errs []error
+	for _, c := range p.candidates() {
+		if err := p.connect(ctx, c); err != nil {
+			errs = append(errs, fmt.Errorf("connection %d: %w", c.index, err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		p.mu.Lock()
+		// The connection may be dropped by a concurrent health check after it's connected
+		l := c.current
+		if l == nil {
+			p.mu.Unlock()
+			continue
+		}
+		c.inUse++
+		l.leases++
+		p.mu.Unlock()
+
+		return &Lease[T]{Value: l.value, Index: c.index, pool: p, conn: c, link: l}, nil
+	}
+
+	<-p.slots
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return nil, errors.Join(append([]error{ErrNoConnections}, errs...)...)
+}
+
+// candidates returns the not ejected connections from the least to the most busy, the connected ones go first
+// among equally busy. All connections are returned if all of them are ejected, so the pool tries to recover.
+func (p *Pool[T]) candidates() []*conn[T] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]*conn[T], 0, len(p.conns))
+	for _, c := range p.conns {
+		if !c.ejected {
+			res = append(res, c)
+		}
+	}
+	if len(res) == 0 {
+		res = slices.Clone(p.conns)
+	}
+
+	slices.SortStableFunc(res, func(a, b *conn[T]) int {
+		if a.inUse != b.inUse {
+			return cmp.Compare(a.inUse, b.inUse)
+		}
+		switch {
+		case a.current != nil && b.current == nil:
+			return -1
+		case a.current == nil && b.current != nil:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return res
+}
+
+func (p *Pool[T]) connect(ctx context.Context, c *conn[T]) error {
+	c.dialMu.Lock()
+	defer c.dialMu.Unlock()
+
+	p.mu.Lock()
+	connected := c.current != nil
+	p.mu.Unlock()
+	if connected {
+		return nil
+	}
+
+	value, err := p.dial(ctx, c.index)
+	if err != nil {
+		// The connection isn't ejected if the dialing is canceled by the caller
+		if ctx.Err() == nil {
+			p.eject(c, err)
+		}
+		return err
+	}
+
+	p.mu.Lock()
+	c.current = &link[T]{value: value}
+	p.restore(c)
+	p.mu.Unlock()
+
+	return nil
+}
+
+// eject closes the connection and excludes it from the candidates until it's restored.
+func (p *Pool[T]) eject(c *conn[T], err error) {
+	p.disconnect(c)
+
+	p.mu.Lock()
+	p.markEjected(c, err)
+	p.mu.Unlock()
+}
+
+// markEjected excludes the connection from the candidates, p.mu must be held.
+func (p *Pool[T]) markEjected(c *conn[T], err error) {
+	c.ejected = true
+	c.failures++
+	c.lastErr = err
+	c.checkedAt = time.Now()
+}
+
+// restore marks the connection healthy, p.mu must be held.
+func (p *Pool[T]) restore(c *conn[T]) {
+	c.ejected = false
+	c.failures = 0
+	c.lastErr = nil
+	c.checkedAt = time.Now()
+}
+
+// disconnect detaches the connection, it will be reopened by the next Acquire or HealthCheck.
+// The detached connection is closed at once if it isn't leased, otherwise after the last lease is released.
+func (p *Pool[T]) disconnect(c *conn[T]) {
+	p.mu.Lock()
+	closing, ok := p.detach(c)
+	p.mu.Unlock()
+
+	if ok {
+		_ = p.close(closing)
+	}
+}
+
+// detach detaches the current link of the connection and returns its value if it must be closed now, p.mu must be held.
+func (p *Pool[T]) detach(c *conn[T]) (T, bool) {
+	var zero T
+	l := c.current
+	if l == nil {
+		return zero, false
+	}
+	c.current = nil
+	l.detached = true
+
+	if l.leases > 0 {
+		return zero, false
+	}
+
+	return l.value, true
+}
+
+// Release returns the connection to the pool, the detached connection is closed after its last lease is released.
+func (l *Lease[T]) Release() {
+	l.once.Do(func() {
+		l.pool.mu.Lock()
+		l.conn.inUse--
+		l.link.leases--
+		closing := l.link.detached && l.link.leases == 0
+		l.pool.mu.Unlock()
+
+		if closing {
+			_ = l.pool.close(l.link.value)
+		}
+
+		<-l.pool.slots
+	})
+}
+
+// Broken ejects the leased connection, so it's reopened on the next use. The connection isn't closed
+// while the other leases use it, and the lease still must be released.
+func (l *Lease[T]) Broken() {
+	l.pool.mu.Lock()
+	// The connection may be already reopened after a health check
+	if l.conn.current != l.link {
+		l.pool.mu.Unlock()
+		return
+	}
+	closing, ok := l.pool.detach(l.conn)
+	l.pool.markEjected(l.conn, ErrBroken)
+	l.pool.mu.Unlock()
+
+	if ok {
+		_ = l.pool.close(closing)
+	}
+}
+
+// HealthCheck checks the opened connections, ejects the broken ones and restores the ones that can be reopened.
+// It returns an error if there are no healthy connections.
+func (p *Pool[T]) HealthCheck(ctx context.Context) error {
+	var errs []error
+	healthy := 0
+
+	for _, c := range p.snapshot() {
+		p.mu.Lock()
+		current := c.current
+		p.mu.Unlock()
+
+		if current != nil {
+			err := p.check(ctx, current.value)
+			if err == nil {
+				p.mu.Lock()
+				p.restore(c)
+				p.mu.Unlock()
+				healthy++
+				continue
+			}
+			errs = append(errs, fmt.Errorf("check connection %d: %w", c.index, err))
+			p.eject(c, err)
+		}
+
+		if err := p.connect(ctx, c); err != nil {
+			errs = append(errs, fmt.Errorf("connect connection %d: %w", c.index, err))
+			continue
+		}
+		healthy++
+	}
+
+	if healthy == 0 {
+		return errors.Join(append([]error{ErrNoConnections}, errs...)...)
+	}
+
+	return nil
+}
+
+// Run checks the connections every interval until ctx is done, it does nothing if the interval isn't positive.
+func (p *Pool[T]) Run(ctx context.Context, interval time.Duration, onError func(err error)) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, interval)
+			if err := p.HealthCheck(checkCtx); err != nil && onError != nil {
+				onError(err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Stats returns the state of every connection.
+func (p *Pool[T]) Stats() []Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]Stats, 0, len(p.conns))
+	for _, c := range p.conns {
+		res = append(res, Stats{
+			Index:     c.index,
+			Connected: c.current != nil,
+			InUse:     c.inUse,
+			Ejected:   c.ejected,
+			Failures:  c.failures,
+			LastError: c.lastErr,
+			CheckedAt: c.checkedAt,
+		})
+	}
+
+	return res
+}
+
+// Close closes all opened connections, the leased ones are closed after they're released.
+func (p *Pool[T]) Close() error {
+	for _, c := range p.snapshot() {
+		p.disconnect(c)
+	}
+
+	return nil
+}
+
+func (p *Pool[T]) snapshot() []*conn[T] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.conns)
+}
@@ -0,0 +1,252 @@
+package pool_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/pool"
+)
+
+type fakeConn struct {
+	id     int
+	broken atomic.Bool
+	closed atomic.Bool
+}
+
+type fakeDialer struct {
+	dials atomic.Int32
+	err   atomic.Pointer[error]
+	// down is the index of the connection that fails to dial, -1 if there is none.
+	down atomic.Int32
+}
+
+func newFakeDialer() *fakeDialer {
+	d := &fakeDialer{}
+	d.down.Store(-1)
+
+	return d
+}
+
+func (d *fakeDialer) dial(ctx context.Context, i int) (*fakeConn, error) {
+	if err := d.err.Load(); err != nil {
+		return nil, *err
+	}
+	if int(d.down.Load()) == i {
+		return nil, errors.New("connection refused")
+	}
+	d.dials.Add(1)
+
+	return &fakeConn{id: i}, nil
+}
+
+func check(ctx context.Context, c *fakeConn) error {
+	if c.broken.Load() {
+		return errors.New("broken")
+	}
+
+	return nil
+}
+
+func closeConn(c *fakeConn) error {
+	c.closed.Store(true)
+	return nil
+}
+
+func TestPool_AcquireReusesConnection(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(1, 2, d.dial, check, closeConn)
+
+	first, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	first.Release()
+	// Second release must not free another slot
+	first.Release()
+
+	second, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, first.Value, second.Value)
+	assert.Equal(t, int32(1), d.dials.Load())
+	second.Release()
+}
+
+func TestPool_AcquirePicksLeastBusy(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(2, 4, d.dial, check, closeConn)
+
+	first, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	second, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Value.id, second.Value.id)
+
+	for _, s := range p.Stats() {
+		assert.True(t, s.Connected)
+		assert.Equal(t, 1, s.InUse)
+	}
+}
+
+func TestPool_AcquireWaitsForSlot(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(1, 1, d.dial, check, closeConn)
+
+	lease, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = p.Acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		lease.Release()
+	}()
+	lease, err = p.Acquire(context.Background())
+	assert.NoError(t, err)
+	lease.Release()
+}
+
+func TestPool_AcquireDialError(t *testing.T) {
+	d := newFakeDialer()
+	dialErr := errors.New("connection refused")
+	d.err.Store(&dialErr)
+	p := pool.New(1, 1, d.dial, check, closeConn)
+
+	_, err := p.Acquire(context.Background())
+	assert.ErrorIs(t, err, pool.ErrNoConnections)
+	assert.ErrorIs(t, err, dialErr)
+
+	// The slot is freed on the failure
+	d.err.Store(nil)
+	lease, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	lease.Release()
+}
+
+func TestPool_BrokenReconnects(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(1, 1, d.dial, check, closeConn)
+
+	lease, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	old := lease.Value
+	lease.Broken()
+	lease.Release()
+	assert.True(t, old.closed.Load())
+
+	lease, err = p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, old, lease.Value)
+	assert.Equal(t, int32(2), d.dials.Load())
+	lease.Release()
+}
+
+func TestPool_BrokenSharedConnection(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(1, 3, d.dial, check, closeConn)
+
+	first, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	second, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	old := first.Value
+	assert.Same(t, old, second.Value)
+
+	// The connection is still used by the second lease
+	first.Broken()
+	assert.False(t, old.closed.Load())
+	stats := p.Stats()
+	assert.True(t, stats[0].Ejected)
+	assert.ErrorIs(t, stats[0].LastError, pool.ErrBroken)
+
+	// The next lease gets a new connection
+	third, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, old, third.Value)
+
+	// Broken of a stale lease doesn't touch the new connection
+	second.Broken()
+	first.Release()
+	assert.False(t, old.closed.Load())
+	second.Release()
+	assert.True(t, old.closed.Load())
+	assert.False(t, third.Value.closed.Load())
+	assert.True(t, p.Stats()[0].Connected)
+	third.Release()
+}
+
+func TestPool_HealthCheck(t *testing.T) {
+	d := newFakeDialer()
+	p := pool.New(1, 1, d.dial, check, closeConn)
+
+	// Opens the connection
+	assert.NoError(t, p.HealthCheck(context.Background()))
+	lease, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	old := lease.Value
+	lease.Release()
+
+	// Remote browser restarted
+	old.broken.Store(true)
+	assert.NoError(t, p.HealthCheck(context.Background()))
+	assert.True(t, old.closed.Load())
+	assert.Equal(t, int32(2), d.dials.Load())
+
+	// Remote browser is down
+	dialErr := errors.New("connection refused")
+	d.err.Store(&dialErr)
+	p.Close()
+	err = p.HealthCheck(context.Background())
+	assert.ErrorIs(t, err, pool.ErrNoConnections)
+	stats := p.Stats()
+	assert.False(t, stats[0].Connected)
+	assert.True(t, stats[0].Ejected)
+	assert.ErrorIs(t, stats[0].LastError, dialErr)
+}
+
+func TestPool_EjectAndRestore(t *testing.T) {
+	d := newFakeDialer()
+	d.down.Store(0)
+	p := pool.New(2, 4, d.dial, check, closeConn)
+
+	// The dead connection is ejected and the other one is used
+	lease, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, lease.Index)
+	lease.Release()
+
+	stats := p.Stats()
+	assert.True(t, stats[0].Ejected)
+	assert.Equal(t, 1, stats[0].Failures)
+	assert.False(t, stats[1].Ejected)
+
+	// The ejected connection isn't dialed by Acquire
+	for range 3 {
+		lease, err := p.Acquire(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, lease.Index)
+		lease.Release()
+	}
+	assert.Equal(t, 1, p.Stats()[0].Failures)
+
+	// The health check restores the connection
+	assert.NoError(t, p.HealthCheck(context.Background()))
+	assert.Equal(t, 2, p.Stats()[0].Failures)
+	d.down.Store(-1)
+	assert.NoError(t, p.HealthCheck(context.Background()))
+	stats = p.Stats()
+	assert.False(t, stats[0].Ejected)
+	assert.True(t, stats[0].Connected)
+	assert.Equal(t, 0, stats[0].Failures)
+	assert.NoError(t, stats[0].LastError)
+
+	first, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	second, err := p.Acquire(context.Background())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{0, 1}, []int{first.Index, second.Index})
+}
@@ -0,0 +1,15 @@
+package history
+
+import (
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+)
+
+type Config struct {
+	path string
+}
+
+func NewHistoryConfig(cfg *config.Config) *Config {
+	return &Config{
+		path: cfg.History.Path,
+	}
+}
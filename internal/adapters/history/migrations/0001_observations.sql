@@ -0,0 +1,14 @@
+CREATE TABLE observations (
+    id            INTEGER PRIMARY KEY,
+    marketplace   TEXT    NOT NULL,
+    product_id    TEXT    NOT NULL,
+    name          TEXT    NOT NULL,
+    price         REAL    NOT NULL,
+    rating        REAL    NOT NULL,
+    reviews_count INTEGER NOT NULL,
+    in_stock      INTEGER NOT NULL,
+    -- unix time in milliseconds
+    observed_at   INTEGER NOT NULL
+);
+
+CREATE INDEX observations_product ON observations (marketplace, product_id, observed_at);
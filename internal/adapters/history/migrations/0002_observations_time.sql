@@ -0,0 +1,2 @@
+-- the observations older than the retention are pruned by time
+CREATE INDEX observations_time ON observations (observed_at);
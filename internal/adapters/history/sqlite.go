@@ -0,0 +1,146 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sqlite"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteRepository keeps the price observations in an SQLite database file, its schema is migrated on open.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// Create a new SQLite history repository, the database file and its dir are created if they don't exist.
+func NewSQLiteRepository(cfg *config.Config) (*SQLiteRepository, error) {
+	c := NewHistoryConfig(cfg)
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("history migrations: %w", err)
+	}
+	db, err := sqlite.Open(c.path, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("open history database: %w", err)
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+// Record inserts the observations in a single transaction.
+func (r *SQLiteRepository) Record(ctx context.Context, observations []domain.PriceObservation) error {
+	if len(observations) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO observations
+		(marketplace, product_id, name, price, rating, reviews_count, in_stock, observed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, o := range observations {
+		_, err := stmt.ExecContext(ctx, string(o.Marketplace), o.ProductID, o.Name, o.Price, o.Rating, o.ReviewsCount, o.InStock, o.ObservedAt.UnixMilli())
+		if err != nil {
+			return fmt.Errorf("insert observation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) Observations(ctx context.Context, marketplace domain.Marketplace, productID string, from, to time.Time) ([]domain.PriceObservation, error) {
+	fromMs, toMs := int64(math.MinInt64), int64(math.MaxInt64)
+	if !from.IsZero() {
+		fromMs = from.UnixMilli()
+	}
+	if !to.IsZero() {
+		toMs = to.UnixMilli()
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT name, price, rating, reviews_count, in_stock, observed_at
+		FROM observations
+		WHERE marketplace = ? AND product_id = ? AND observed_at >= ? AND observed_at <= ?
+		ORDER BY observed_at, id`, string(marketplace), productID, fromMs, toMs)
+	if err != nil {
+		return nil, fmt.Errorf("query observations: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.PriceObservation{}
+	for rows.Next() {
+		o := domain.PriceObservation{Marketplace: marketplace, ProductID: productID}
+		var observedAt int64
+		if err := rows.Scan(&o.Name, &o.Price, &o.Rating, &o.ReviewsCount, &o.InStock, &observedAt); err != nil {
+			return nil, fmt.Errorf("scan observation: %w", err)
+		}
+		o.ObservedAt = time.UnixMilli(observedAt).UTC()
+		res = append(res, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read observations: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *SQLiteRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM observations WHERE observed_at < ?`, before.UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("delete observations: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("deleted observations: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *SQLiteRepository) Latest(ctx context.Context, marketplace domain.Marketplace, productID string) (domain.PriceObservation, error) {
+	o := domain.PriceObservation{Marketplace: marketplace, ProductID: productID}
+	var observedAt int64
+	err := r.db.QueryRowContext(ctx, `SELECT name, price, rating, reviews_count, in_stock, observed_at
+		FROM observations
+		WHERE marketplace = ? AND product_id = ?
+		ORDER BY observed_at DESC, id DESC
+		LIMIT 1`, string(marketplace), productID).Scan(&o.Name, &o.Price, &o.Rating, &o.ReviewsCount, &o.InStock, &observedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PriceObservation{}, repository.ErrNoObservations
+	}
+	if err != nil {
+		return domain.PriceObservation{}, fmt.Errorf("query latest observation: %w", err)
+	}
+	o.ObservedAt = time.UnixMilli(observedAt).UTC()
+
+	return o, nil
+}
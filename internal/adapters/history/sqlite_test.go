@@ -0,0 +1,97 @@
+package history_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/history"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func TestHistory_SQLiteRepositoryRecordObservations(t *testing.T) {
+	cfg := &config.Config{History: config.HistoryConfig{Path: filepath.Join(t.TempDir(), "db", "history.db")}}
+	repo, err := history.NewSQLiteRepository(cfg)
+	assert.NoError(t, err)
+	defer repo.Close()
+
+	start := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	observations := []domain.PriceObservation{
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Name: "prod", Price: 110, Rating: 4.5, ReviewsCount: 10, InStock: true, ObservedAt: start.Add(time.Hour)},
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Name: "prod", Price: 100, Rating: 4.6, ReviewsCount: 12, InStock: false, ObservedAt: start},
+		{Marketplace: domain.MarketplaceOzon, ProductID: "2", Name: "other", Price: 50, ObservedAt: start},
+		{Marketplace: domain.MarketplaceWildberries, ProductID: "1", Name: "wb prod", Price: 70, ObservedAt: start},
+	}
+	assert.NoError(t, repo.Record(context.Background(), observations))
+
+	res, err := repo.Observations(context.Background(), domain.MarketplaceOzon, "1", time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceObservation{observations[1], observations[0]}, res)
+
+	res, err = repo.Observations(context.Background(), domain.MarketplaceOzon, "1", start.Add(time.Minute), time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceObservation{observations[0]}, res)
+
+	res, err = repo.Observations(context.Background(), domain.MarketplaceOzon, "1", time.Time{}, start)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceObservation{observations[1]}, res)
+
+	res, err = repo.Observations(context.Background(), domain.MarketplaceOzon, "3", time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	latest, err := repo.Latest(context.Background(), domain.MarketplaceOzon, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, observations[0], latest)
+
+	_, err = repo.Latest(context.Background(), domain.MarketplaceOzon, "3")
+	assert.ErrorIs(t, err, repository.ErrNoObservations)
+}
+
+func TestHistory_SQLiteRepositoryReopen(t *testing.T) {
+	cfg := &config.Config{History: config.HistoryConfig{Path: filepath.Join(t.TempDir(), "history.db")}}
+	repo, err := history.NewSQLiteRepository(cfg)
+	assert.NoError(t, err)
+
+	observation := domain.PriceObservation{Marketplace: domain.MarketplaceOzon, ProductID: "1", Name: "prod", Price: 100, ObservedAt: time.UnixMilli(1000).UTC()}
+	assert.NoError(t, repo.Record(context.Background(), []domain.PriceObservation{observation}))
+	assert.NoError(t, repo.Close())
+
+	// The applied migrations aren't applied again and the observations are kept
+	repo, err = history.NewSQLiteRepository(cfg)
+	assert.NoError(t, err)
+	defer repo.Close()
+
+	res, err := repo.Observations(context.Background(), domain.MarketplaceOzon, "1", time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceObservation{observation}, res)
+}
+
+func TestHistory_SQLiteRepositoryPrune(t *testing.T) {
+	cfg := &config.Config{History: config.HistoryConfig{Path: filepath.Join(t.TempDir(), "history.db")}}
+	repo, err := history.NewSQLiteRepository(cfg)
+	assert.NoError(t, err)
+	defer repo.Close()
+
+	start := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	observations := []domain.PriceObservation{
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Name: "prod", Price: 100, ObservedAt: start},
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Name: "prod", Price: 110, ObservedAt: start.Add(time.Hour)},
+		{Marketplace: domain.MarketplaceWildberries, ProductID: "1", Name: "wb prod", Price: 70, ObservedAt: start.Add(time.Minute)},
+	}
+	assert.NoError(t, repo.Record(context.Background(), observations))
+
+	n, err := repo.Prune(context.Background(), start.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	res, err := repo.Observations(context.Background(), domain.MarketplaceOzon, "1", time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceObservation{observations[1]}, res)
+	_, err = repo.Latest(context.Background(), domain.MarketplaceWildberries, "1")
+	assert.ErrorIs(t, err, repository.ErrNoObservations)
+}
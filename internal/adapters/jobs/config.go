@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+)
+
+type Config struct {
+	ttl time.Duration
+}
+
+func NewJobsConfig(cfg *config.Config) *Config {
+	return &Config{
+		ttl: cfg.Jobs.TTL,
+	}
+}
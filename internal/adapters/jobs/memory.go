@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+// MemoryRepository keeps the jobs in memory, they are lost on restart.
+// The finished jobs are removed TTL after they are finished.
+type MemoryRepository struct {
+	cfg *Config
+
+	mu   sync.Mutex
+	jobs map[string]domain.SearchJob
+}
+
+func NewMemoryRepository(cfg *config.Config) *MemoryRepository {
+	return &MemoryRepository{cfg: NewJobsConfig(cfg), jobs: make(map[string]domain.SearchJob)}
+}
+
+// Save stores a copy of the job and removes the expired jobs.
+func (r *MemoryRepository) Save(ctx context.Context, job domain.SearchJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, j := range r.jobs {
+		if r.expired(j, now) {
+			delete(r.jobs, id)
+		}
+	}
+	r.jobs[job.ID] = clone(job)
+
+	return nil
+}
+
+func (r *MemoryRepository) Get(ctx context.Context, id string) (domain.SearchJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok || r.expired(job, time.Now()) {
+		return domain.SearchJob{}, repository.ErrJobNotFound
+	}
+
+	return clone(job), nil
+}
+
+func (r *MemoryRepository) expired(job domain.SearchJob, now time.Time) bool {
+	return job.Status.Finished() && now.Sub(job.FinishedAt) > r.cfg.ttl
+}
+
+// clone copies the slices of the job, so the stored job isn't changed by the caller.
+func clone(job domain.SearchJob) domain.SearchJob {
+	job.Sources = slices.Clone(job.Sources)
+	job.Result.Products = slices.Clone(job.Result.Products)
+	job.Result.TimedOut = slices.Clone(job.Result.TimedOut)
+
+	return job
+}
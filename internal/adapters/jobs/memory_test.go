@@ -0,0 +1,55 @@
+package jobs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/jobs"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func TestJobs_MemoryRepositorySaveGet(t *testing.T) {
+	repo := jobs.NewMemoryRepository(&config.Config{Jobs: config.JobsConfig{TTL: time.Hour}})
+
+	_, err := repo.Get(context.Background(), "id")
+	assert.ErrorIs(t, err, repository.ErrJobNotFound)
+
+	job := domain.SearchJob{
+		ID:      "id",
+		Status:  domain.JobRunning,
+		Query:   domain.SearchQuery{Name: "prod"},
+		Sources: []domain.SourceProgress{{Marketplace: domain.MarketplaceOzon, State: domain.SourceRunning}},
+	}
+	assert.NoError(t, repo.Save(context.Background(), job))
+
+	res, err := repo.Get(context.Background(), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, job, res)
+
+	// The stored job isn't changed by the caller
+	res.Sources[0].State = domain.SourceDone
+	res, err = repo.Get(context.Background(), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SourceRunning, res.Sources[0].State)
+}
+
+func TestJobs_MemoryRepositoryExpired(t *testing.T) {
+	repo := jobs.NewMemoryRepository(&config.Config{Jobs: config.JobsConfig{TTL: time.Minute}})
+
+	assert.NoError(t, repo.Save(context.Background(), domain.SearchJob{ID: "old", Status: domain.JobSucceeded, FinishedAt: time.Now().Add(-2 * time.Minute)}))
+	assert.NoError(t, repo.Save(context.Background(), domain.SearchJob{ID: "new", Status: domain.JobSucceeded, FinishedAt: time.Now()}))
+	assert.NoError(t, repo.Save(context.Background(), domain.SearchJob{ID: "running", Status: domain.JobRunning, CreatedAt: time.Now().Add(-2 * time.Minute)}))
+
+	_, err := repo.Get(context.Background(), "old")
+	assert.ErrorIs(t, err, repository.ErrJobNotFound)
+
+	_, err = repo.Get(context.Background(), "new")
+	assert.NoError(t, err)
+
+	_, err = repo.Get(context.Background(), "running")
+	assert.NoError(t, err)
+}
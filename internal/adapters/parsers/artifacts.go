@@ -0,0 +1,84 @@
+package parsers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// artifactCollector captures debug artifacts of parser pages.
+type artifactCollector struct {
+	cfg         *ArtifactsConfig
+	marketplace domain.Marketplace
+	artifacts   repository.ArtifactRepository
+	logger      logger.Logger
+}
+
+func newArtifactCollector(cfg *ArtifactsConfig, marketplace domain.Marketplace, artifacts repository.ArtifactRepository, logger logger.Logger) *artifactCollector {
+	return &artifactCollector{cfg: cfg, marketplace: marketplace, artifacts: artifacts, logger: logger}
+}
+
+// Collect captures the page snapshot if the operation failed with err or debug is requested.
+// It returns err with the artifact id attached, so it can be reported to the client.
+// Blocked pages are always captured, other failures only if capturing on failure is enabled.
+func (ac *artifactCollector) Collect(ctx context.Context, page repository.Page, operation string, debug bool, err error) error {
+	if !ac.shouldCollect(debug, err) {
+		return err
+	}
+
+	// The request context may be already done, so the page is captured with its own timeout
+	captureCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ac.cfg.CaptureTimeout)
+	defer cancel()
+
+	// The snapshot is saved even if some of its parts couldn't be captured
+	snapshot, snapshotErr := page.Snapshot(captureCtx)
+	if snapshotErr != nil {
+		ac.logger.Warn("capture page snapshot", "marketplace", ac.marketplace, "operation", operation, "error", snapshotErr)
+	}
+
+	artifact := repository.Artifact{
+		Marketplace: ac.marketplace,
+		Operation:   operation,
+		Snapshot:    snapshot,
+		CreatedAt:   time.Now(),
+	}
+	if err != nil {
+		artifact.Error = err.Error()
+	}
+
+	id, saveErr := ac.artifacts.Save(captureCtx, artifact)
+	if saveErr != nil {
+		ac.logger.Error("save debug artifact", "marketplace", ac.marketplace, "operation", operation, "error", saveErr)
+		return err
+	}
+	ac.logger.Info("debug artifact saved", "marketplace", ac.marketplace, "operation", operation, "artifact_id", id)
+
+	if err == nil {
+		return nil
+	}
+
+	return &domain.ArtifactError{ArtifactID: id, HARPath: snapshot.HARPath, Err: err}
+}
+
+func (ac *artifactCollector) shouldCollect(debug bool, err error) bool {
+	if ac.artifacts == nil {
+		return false
+	}
+	if err == nil {
+		return debug
+	}
+	// Nobody is waiting for the result of a closed request
+	if errors.Is(err, repository.ErrClientClosedRequest) {
+		return false
+	}
+	// The page of a missing product isn't a failure of the page
+	if errors.Is(err, repository.ErrProductNotFound) {
+		return debug
+	}
+
+	return debug || ac.cfg.OnFailure || errors.Is(err, repository.ErrSourceBlocked)
+}
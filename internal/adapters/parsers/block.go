@@ -0,0 +1,49 @@
+package parsers
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+// blockDetector recognizes captcha and "access denied" pages by the configured markers.
+// Captchas are not solved, the block is only reported.
+type blockDetector struct {
+	cfg         *BlockConfig
+	marketplace domain.Marketplace
+	logger      logger.Logger
+}
+
+func newBlockDetector(cfg *BlockConfig, marketplace domain.Marketplace, logger logger.Logger) *blockDetector {
+	return &blockDetector{cfg: cfg, marketplace: marketplace, logger: logger}
+}
+
+// Check returns a *repository.BlockedError if the opened page is a block page.
+func (bd *blockDetector) Check(ctx context.Context, page repository.Page) error {
+	if bd.cfg == nil || len(bd.cfg.Markers) == 0 {
+		return nil
+	}
+
+	// Only the visible text is matched, the markers can be in the scripts and the hidden templates of any page
+	text, err := page.Text(ctx)
+	if err != nil {
+		return utils.WrapError("text", err, ctx)
+	}
+
+	marker, ok := markerIn(text, bd.cfg.Markers)
+	if !ok {
+		return nil
+	}
+
+	url, err := page.URL(ctx)
+	if err != nil {
+		return utils.WrapError("url", err, ctx)
+	}
+
+	bd.logger.Warn("source blocked", "marketplace", bd.marketplace, "url", url, "marker", marker)
+
+	return &repository.BlockedError{URL: url, Marker: marker, RetryAfter: bd.cfg.RetryAfter}
+}
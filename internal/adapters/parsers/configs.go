@@ -1,6 +1,11 @@
 package parsers
 
-import "github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
 
 type WildberriesConfig struct {
 	BaseURL             string
@@ -11,6 +16,17 @@ type WildberriesConfig struct {
 	PriceSelector       string
 	RatingSelector      string
 	ReviewsSelector     string
+	OutOfStockSelector  string
+	StockSelector       string
+	DeliverySelector    string
+	SuggestionsSelector string
+	ProductPage         *ProductPageConfig
+	Region              *RegionConfig
+	Block               *BlockConfig
+	Artifacts           *ArtifactsConfig
+	Blocking            *BlockingConfig
+	// Proxy is the proxy of the marketplace pages, nil to use the browser one.
+	Proxy *repository.Proxy
 }
 
 func NewWildberriesConfig(cfg *config.Config) *WildberriesConfig {
@@ -23,6 +39,16 @@ func NewWildberriesConfig(cfg *config.Config) *WildberriesConfig {
 		PriceSelector:       cfg.Server.WbCfg.PriceSelector,
 		RatingSelector:      cfg.Server.WbCfg.RatingSelector,
 		ReviewsSelector:     cfg.Server.WbCfg.ReviewsSelector,
+		OutOfStockSelector:  cfg.Server.WbCfg.OutOfStockSelector,
+		StockSelector:       cfg.Server.WbCfg.StockSelector,
+		DeliverySelector:    cfg.Server.WbCfg.DeliverySelector,
+		SuggestionsSelector: cfg.Server.WbCfg.SuggestionsSelector,
+		ProductPage:         NewProductPageConfig(cfg.Server.WbCfg.ProductPage),
+		Region:              NewRegionConfig(cfg, cfg.Server.WbCfg.Region),
+		Block:               NewBlockConfig(cfg.Server.WbCfg.Block),
+		Artifacts:           NewArtifactsConfig(cfg),
+		Blocking:            NewBlockingConfig(cfg, cfg.Server.WbCfg.Blocking),
+		Proxy:               NewProxy(cfg.Server.WbCfg.Proxy),
 	}
 }
 
@@ -35,6 +61,17 @@ type OzonConfig struct {
 	PriceSelector       string
 	RatingSelector      string
 	ReviewsSelector     string
+	OutOfStockSelector  string
+	StockSelector       string
+	DeliverySelector    string
+	SuggestionsSelector string
+	ProductPage         *ProductPageConfig
+	Region              *RegionConfig
+	Block               *BlockConfig
+	Artifacts           *ArtifactsConfig
+	Blocking            *BlockingConfig
+	// Proxy is the proxy of the marketplace pages, nil to use the browser one.
+	Proxy *repository.Proxy
 }
 
 func NewOzonConfig(cfg *config.Config) *OzonConfig {
@@ -47,5 +84,116 @@ func NewOzonConfig(cfg *config.Config) *OzonConfig {
 		ProductNameSelector: cfg.Server.OzonCfg.ProductNameSelector,
 		RatingSelector:      cfg.Server.OzonCfg.RatingSelector,
 		ReviewsSelector:     cfg.Server.OzonCfg.ReviewsSelector,
+		OutOfStockSelector:  cfg.Server.OzonCfg.OutOfStockSelector,
+		StockSelector:       cfg.Server.OzonCfg.StockSelector,
+		DeliverySelector:    cfg.Server.OzonCfg.DeliverySelector,
+		SuggestionsSelector: cfg.Server.OzonCfg.SuggestionsSelector,
+		ProductPage:         NewProductPageConfig(cfg.Server.OzonCfg.ProductPage),
+		Region:              NewRegionConfig(cfg, cfg.Server.OzonCfg.Region),
+		Block:               NewBlockConfig(cfg.Server.OzonCfg.Block),
+		Artifacts:           NewArtifactsConfig(cfg),
+		Blocking:            NewBlockingConfig(cfg, cfg.Server.OzonCfg.Blocking),
+		Proxy:               NewProxy(cfg.Server.OzonCfg.Proxy),
+	}
+}
+
+type ProductPageConfig struct {
+	NameSelector      string
+	PriceSelector     string
+	RatingSelector    string
+	ReviewsSelector   string
+	DeliverySelector  string
+	OutOfStockMarkers []string
+	NotFoundMarkers   []string
+}
+
+func NewProductPageConfig(page config.ProductPageConfig) *ProductPageConfig {
+	return &ProductPageConfig{
+		NameSelector:      page.NameSelector,
+		PriceSelector:     page.PriceSelector,
+		RatingSelector:    page.RatingSelector,
+		ReviewsSelector:   page.ReviewsSelector,
+		DeliverySelector:  page.DeliverySelector,
+		OutOfStockMarkers: page.OutOfStockMarkers,
+		NotFoundMarkers:   page.NotFoundMarkers,
+	}
+}
+
+type RegionConfig struct {
+	AddressButtonSelector     string
+	AddressInputSelector      string
+	AddressSuggestionSelector string
+	AddressConfirmSelector    string
+	Presets                   map[string]string
+	CacheTTL                  time.Duration
+}
+
+func NewRegionConfig(cfg *config.Config, picker config.RegionPickerConfig) *RegionConfig {
+	return &RegionConfig{
+		AddressButtonSelector:     picker.AddressButtonSelector,
+		AddressInputSelector:      picker.AddressInputSelector,
+		AddressSuggestionSelector: picker.AddressSuggestionSelector,
+		AddressConfirmSelector:    picker.AddressConfirmSelector,
+		Presets:                   cfg.Server.Regions,
+		CacheTTL:                  cfg.Server.RegionCacheTTL,
+	}
+}
+
+type BlockConfig struct {
+	Markers    []string
+	RetryAfter time.Duration
+}
+
+func NewBlockConfig(block config.BlockConfig) *BlockConfig {
+	return &BlockConfig{
+		Markers:    block.Markers,
+		RetryAfter: block.RetryAfter,
+	}
+}
+
+type ArtifactsConfig struct {
+	OnFailure      bool
+	CaptureTimeout time.Duration
+}
+
+func NewArtifactsConfig(cfg *config.Config) *ArtifactsConfig {
+	return &ArtifactsConfig{
+		OnFailure:      cfg.Artifacts.OnFailure,
+		CaptureTimeout: cfg.Artifacts.CaptureTimeout,
+	}
+}
+
+type BlockingConfig struct {
+	Enabled bool
+	Rules   repository.RequestBlocking
+}
+
+// NewBlockingConfig returns the request blocking of the marketplace, the browser one is used if there is no override.
+func NewBlockingConfig(cfg *config.Config, override *config.RequestBlockingConfig) *BlockingConfig {
+	blocking := cfg.Browser.Blocking
+	if override != nil {
+		blocking = *override
+	}
+
+	return &BlockingConfig{
+		Enabled: blocking.Enabled,
+		Rules: repository.RequestBlocking{
+			ResourceTypes: blocking.ResourceTypes,
+			URLPatterns:   blocking.URLPatterns,
+		},
+	}
+}
+
+// NewProxy returns the proxy override of the marketplace, nil if there is no override.
+func NewProxy(override *config.ProxyConfig) *repository.Proxy {
+	if override == nil {
+		return nil
+	}
+
+	return &repository.Proxy{
+		Server:   override.URL,
+		Username: override.Username,
+		Password: override.Password,
+		Bypass:   override.Bypass,
 	}
 }
@@ -0,0 +1,9 @@
+package parsers
+
+import "time"
+
+// SetNow replaces the clock the delivery dates are parsed relative to.
+func (op *ozonParser) SetNow(now func() time.Time) { op.now = now }
+
+// SetNow replaces the clock the delivery dates are parsed relative to.
+func (wp *wildberriesParser) SetNow(now func() time.Time) { wp.now = now }
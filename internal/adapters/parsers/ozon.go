@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net/url"
+	"strings"
 
 	"time"
 
@@ -16,28 +18,67 @@ import (
 )
 
 type OzonParser interface {
-	GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)
+	GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error)
 }
 
 type ozonParser struct {
-	cfg     *OzonConfig
-	logger  logger.Logger
-	browser repository.BrowserRepository
+	cfg       *OzonConfig
+	logger    logger.Logger
+	browser   repository.BrowserRepository
+	region    *regionSelector
+	block     *blockDetector
+	artifacts *artifactCollector
+	now       func() time.Time
 }
 
-func NewOzonParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository) *ozonParser {
-	return &ozonParser{cfg: NewOzonConfig(cfg), logger: logger, browser: browser}
+func NewOzonParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository, artifacts repository.ArtifactRepository) *ozonParser {
+	ozonCfg := NewOzonConfig(cfg)
+	return &ozonParser{
+		cfg:       ozonCfg,
+		logger:    logger,
+		browser:   browser,
+		region:    newRegionSelector(ozonCfg.Region),
+		block:     newBlockDetector(ozonCfg.Block, domain.MarketplaceOzon, logger),
+		artifacts: newArtifactCollector(ozonCfg.Artifacts, domain.MarketplaceOzon, artifacts, logger),
+		now:       time.Now,
+	}
+}
+
+func (op *ozonParser) Marketplace() domain.Marketplace {
+	return domain.MarketplaceOzon
 }
 
-func (op *ozonParser) GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	page, err := op.browser.NewPage(ctx)
+func (op *ozonParser) GetAllProducts(ctx context.Context, query domain.SearchQuery) (_ []domain.Product, err error) {
+	page, err := op.openPage(ctx, op.cfg.BaseURL, query.Region, query.Debug)
 	if err != nil {
-		return nil, utils.WrapError("page", err, ctx)
+		return nil, err
 	}
 	defer page.Close()
+	defer func() { err = op.artifacts.Collect(ctx, page, "search", query.Debug, err) }()
+	// Keep the cookies of the successful search for the next pages
+	defer func() { saveSession(ctx, page, domain.MarketplaceOzon, op.logger, err) }()
+
+	searchBar, err := page.Element(ctx, op.cfg.SearchBarSelector)
+	if err != nil {
+		return nil, utils.WrapError("element search bar", err, ctx)
+	}
+
+	if err := page.MoveCursorToElement(ctx, op.cfg.SearchBarSelector); err != nil {
+		return nil, utils.WrapError("move cursor to element search bar", err, ctx)
+	}
+
+	if err := searchBar.Click(ctx); err != nil {
+		return nil, utils.WrapError("click search bar", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
 
-	if err := page.NavigateWithReferer(ctx, op.cfg.BaseURL); err != nil {
-		return nil, utils.WrapError("navigate page with referer", err, ctx)
+	if err := searchBar.Input(ctx, query.Name); err != nil {
+		return nil, utils.WrapError("input search bar", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+
+	if err := page.KeyboardType(ctx, input.Enter); err != nil {
+		return nil, utils.WrapError("type enter", err, ctx)
 	}
 
 	// Wait for the DOM to load to find the closing button.
@@ -45,6 +86,60 @@ func (op *ozonParser) GetAllProducts(ctx context.Context, name string, priceFrom
 		return nil, utils.WrapError("wait dom stable", err, ctx)
 	}
 
+	if err := op.block.Check(ctx, page); err != nil {
+		return nil, utils.WrapError("check block page", err, ctx)
+	}
+
+	return op.parseItems(ctx, page)
+}
+
+func (op *ozonParser) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) (_ []domain.Product, err error) {
+	categoryURL, err := CategoryURL(op.cfg.BaseURL, query.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := op.openPage(ctx, categoryURL, query.Region, query.Debug)
+	if err != nil {
+		return nil, err
+	}
+	defer page.Close()
+	defer func() { err = op.artifacts.Collect(ctx, page, "category", query.Debug, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceOzon, op.logger, err) }()
+
+	return op.parseItems(ctx, page)
+}
+
+// GetProduct parses the product from its product page, the marketplace redirects the page of the id to the product one.
+func (op *ozonParser) GetProduct(ctx context.Context, query domain.ProductQuery) (_ domain.Product, err error) {
+	link := fmt.Sprintf("%s/product/%s/", strings.TrimSuffix(op.cfg.BaseURL, "/"), url.PathEscape(query.ID))
+
+	page, err := op.openPage(ctx, link, query.Region, query.Debug)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	defer page.Close()
+	defer func() { err = op.artifacts.Collect(ctx, page, "product", query.Debug, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceOzon, op.logger, err) }()
+
+	product, err := parseProductPage(ctx, page, op.cfg.ProductPage, link, op.now(), op.logger)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	product.ID = query.ID
+
+	return product, nil
+}
+
+func (op *ozonParser) GetSuggestions(ctx context.Context, prefix string) (_ []string, err error) {
+	page, err := op.openPage(ctx, op.cfg.BaseURL, "", false)
+	if err != nil {
+		return nil, err
+	}
+	defer page.Close()
+	defer func() { err = op.artifacts.Collect(ctx, page, "suggestions", false, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceOzon, op.logger, err) }()
+
 	searchBar, err := page.Element(ctx, op.cfg.SearchBarSelector)
 	if err != nil {
 		return nil, utils.WrapError("element search bar", err, ctx)
@@ -59,20 +154,77 @@ func (op *ozonParser) GetAllProducts(ctx context.Context, name string, priceFrom
 	}
 	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
 
-	if err := searchBar.Input(ctx, name); err != nil {
+	if err := searchBar.Input(ctx, prefix); err != nil {
 		return nil, utils.WrapError("input search bar", err, ctx)
 	}
-	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
 
-	if err := page.KeyboardType(ctx, input.Enter); err != nil {
-		return nil, utils.WrapError("type enter", err, ctx)
+	// Wait for the suggestion list to be rendered, Elements doesn't wait for the selector
+	if _, err := page.Element(ctx, op.cfg.SuggestionsSelector); err != nil {
+		return nil, utils.WrapError("element suggestions", err, ctx)
+	}
+
+	items, err := page.Elements(ctx, op.cfg.SuggestionsSelector)
+	if err != nil {
+		return nil, utils.WrapError("elements suggestions", err, ctx)
+	}
+
+	return ParseSuggestions(ctx, items)
+}
+
+func (op *ozonParser) openPage(ctx context.Context, url string, region string, debug bool) (repository.Page, error) {
+	page, err := op.browser.NewPage(ctx, pageOptions(domain.MarketplaceOzon, op.region.Address(region), op.cfg.Proxy))
+	if err != nil {
+		return nil, utils.WrapError("page", err, ctx)
+	}
+
+	if err := op.preparePage(ctx, page, url, region); err != nil {
+		err = op.artifacts.Collect(ctx, page, "open page", debug, err)
+		page.Close()
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (op *ozonParser) preparePage(ctx context.Context, page repository.Page, url string, region string) error {
+	if op.cfg.Blocking.Enabled {
+		if err := page.BlockRequests(ctx, op.cfg.Blocking.Rules); err != nil {
+			return utils.WrapError("block requests", err, ctx)
+		}
+	}
+
+	regionRestored := false
+	if region != "" {
+		var err error
+		regionRestored, err = op.region.Restore(ctx, page, region)
+		if err != nil {
+			return utils.WrapError("restore region", err, ctx)
+		}
+	}
+
+	if err := page.NavigateWithReferer(ctx, url); err != nil {
+		return utils.WrapError("navigate page with referer", err, ctx)
 	}
 
 	// Wait for the DOM to load to find the closing button.
 	if err := page.WaitDOMStable(ctx); err != nil {
-		return nil, utils.WrapError("wait dom stable", err, ctx)
+		return utils.WrapError("wait dom stable", err, ctx)
+	}
+
+	if err := op.block.Check(ctx, page); err != nil {
+		return utils.WrapError("check block page", err, ctx)
+	}
+
+	if region != "" && !regionRestored {
+		if err := op.region.Select(ctx, page, region); err != nil {
+			return utils.WrapError("select region", err, ctx)
+		}
 	}
 
+	return nil
+}
+
+func (op *ozonParser) parseItems(ctx context.Context, page repository.Page) ([]domain.Product, error) {
 	items, err := page.Elements(ctx, op.cfg.ItemsSelector)
 	if err != nil {
 		return nil, utils.WrapError("elemets", err, ctx)
@@ -141,12 +293,46 @@ func (op *ozonParser) GetAllProducts(ctx context.Context, name string, priceFrom
 			}
 		}
 
+		itmOutOfStock, _ := itm.ElementX(ctx, op.cfg.OutOfStockSelector)
+		inStock := itmOutOfStock == nil
+
+		var stock int
+		itmStock, _ := itm.ElementX(ctx, op.cfg.StockSelector)
+		if itmStock != nil {
+			stockStr, err := itmStock.Text(ctx)
+			if err != nil {
+				return nil, utils.WrapError("text stock", err, ctx)
+			}
+			stock, err = ParseStringToInteger(stockStr)
+			if err != nil {
+				op.logger.Error("parser string to integer stock", err)
+				stock = 0
+			}
+		}
+
+		var deliveryText string
+		var deliveryDate time.Time
+		itmDelivery, _ := itm.Element(ctx, op.cfg.DeliverySelector)
+		if itmDelivery != nil {
+			deliveryStr, err := itmDelivery.Text(ctx)
+			if err != nil {
+				return nil, utils.WrapError("text delivery", err, ctx)
+			}
+			deliveryText = strings.TrimSpace(deliveryStr)
+			deliveryDate, _ = ParseDeliveryDate(deliveryText, op.now())
+		}
+
 		res = append(res, domain.Product{
-			Name:         name,
-			Link:         href,
-			Price:        price,
-			Rating:       rating,
-			ReviewsCount: reviews,
+			ID:            op.Marketplace().ProductID(href),
+			Name:          name,
+			Link:          href,
+			Price:         price,
+			Rating:        rating,
+			ReviewsCount:  reviews,
+			InStock:       inStock,
+			StockQuantity: stock,
+			DeliveryText:  deliveryText,
+			DeliveryDate:  deliveryDate,
 		})
 	}
 
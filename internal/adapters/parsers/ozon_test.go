@@ -2,7 +2,9 @@ package parsers_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,7 +32,7 @@ func TestParsers_NewOzonParser(t *testing.T) {
 
 	browserRepoMock := &mocks.BrowserRepositoryMock{}
 
-	ozonParser := parsers.NewOzonParser(cfg, nil, browserRepoMock)
+	ozonParser := parsers.NewOzonParser(cfg, nil, browserRepoMock, nil)
 	assert.NotNil(t, ozonParser)
 }
 
@@ -46,6 +48,9 @@ func TestParsers_OzonParser(t *testing.T) {
 	prodNameElMock := &mocks.ElementMock{}
 	ratingElMock := &mocks.ElementMock{}
 	reviewsElMock := &mocks.ElementMock{}
+	outOfStockElMock := &mocks.ElementMock{}
+	stockElMock := &mocks.ElementMock{}
+	deliveryElMock := &mocks.ElementMock{}
 
 	cfg := &config.Config{
 		Server: config.ServerConfig{
@@ -58,18 +63,26 @@ func TestParsers_OzonParser(t *testing.T) {
 				ProductNameSelector: "productnameselector",
 				RatingSelector:      "ratingselector",
 				ReviewsSelector:     "reviewsselector",
+				OutOfStockSelector:  "outofstockselector",
+				StockSelector:       "stockselector",
+				DeliverySelector:    "deliveryselector",
 			},
 		},
 	}
-	oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock)
+	oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+	oz.SetNow(func() time.Time { return time.Date(2025, time.March, 10, 15, 4, 5, 0, time.UTC) })
 
 	t.Run("success", func(t *testing.T) {
 		p := domain.Product{
-			Name:         "product",
-			Link:         cfg.Server.OzonCfg.BaseURL+"link",
-			Price:        100.0,
-			Rating:       5.0,
-			ReviewsCount: 253,
+			Name:          "product",
+			Link:          cfg.Server.OzonCfg.BaseURL + "link",
+			Price:         100.0,
+			Rating:        5.0,
+			ReviewsCount:  253,
+			InStock:       true,
+			StockQuantity: 3,
+			DeliveryText:  "завтра",
+			DeliveryDate:  time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
 		}
 
 		prods := make([]domain.Product, 0, 1)
@@ -81,8 +94,9 @@ func TestParsers_OzonParser(t *testing.T) {
 
 		linkPtr := "link"
 
-		browserRepoMock.On("NewPage", mock.Anything).Return(pageMock, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
 		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
 		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.OzonCfg.BaseURL).Return(nil).Once()
 		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
 
@@ -109,7 +123,15 @@ func TestParsers_OzonParser(t *testing.T) {
 		itemMock.On("ElementX", mock.Anything, cfg.Server.OzonCfg.ReviewsSelector).Return(reviewsElMock, nil).Once()
 		reviewsElMock.On("Text", mock.Anything).Return("253", nil).Once()
 
-		res, err := oz.GetAllProducts(context.Background(), prodName, priceFrom, priceTo)
+		itemMock.On("ElementX", mock.Anything, cfg.Server.OzonCfg.OutOfStockSelector).Return(nil, errors.New("not found")).Once()
+
+		itemMock.On("ElementX", mock.Anything, cfg.Server.OzonCfg.StockSelector).Return(stockElMock, nil).Once()
+		stockElMock.On("Text", mock.Anything).Return("Осталось 3 шт", nil).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.OzonCfg.DeliverySelector).Return(deliveryElMock, nil).Once()
+		deliveryElMock.On("Text", mock.Anything).Return(" завтра ", nil).Once()
+
+		res, err := oz.GetAllProducts(context.Background(), domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.ElementsMatch(t, prods, res)
@@ -122,15 +144,18 @@ func TestParsers_OzonParser(t *testing.T) {
 		priceElMock.AssertExpectations(t)
 		ratingElMock.AssertExpectations(t)
 		reviewsElMock.AssertExpectations(t)
+		stockElMock.AssertExpectations(t)
+		deliveryElMock.AssertExpectations(t)
 	})
 
 	t.Run("zero rating/price/reviews", func(t *testing.T) {
 		p := domain.Product{
 			Name:         "product",
-			Link:         cfg.Server.OzonCfg.BaseURL+"link",
+			Link:         cfg.Server.OzonCfg.BaseURL + "link",
 			Price:        0.0,
 			Rating:       0.0,
 			ReviewsCount: 0,
+			InStock:      false,
 		}
 
 		prods := make([]domain.Product, 0, 1)
@@ -142,8 +167,9 @@ func TestParsers_OzonParser(t *testing.T) {
 
 		linkPtr := "link"
 
-		browserRepoMock.On("NewPage", mock.Anything).Return(pageMock, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
 		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
 		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.OzonCfg.BaseURL).Return(nil).Once()
 		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
 
@@ -173,7 +199,15 @@ func TestParsers_OzonParser(t *testing.T) {
 		loggerMock.On("Error", "parser string to integer reviews", mock.Anything).Once()
 		reviewsElMock.On("Text", mock.Anything).Return("invalid", nil).Once()
 
-		res, err := oz.GetAllProducts(context.Background(), prodName, priceFrom, priceTo)
+		itemMock.On("ElementX", mock.Anything, cfg.Server.OzonCfg.OutOfStockSelector).Return(outOfStockElMock, nil).Once()
+
+		itemMock.On("ElementX", mock.Anything, cfg.Server.OzonCfg.StockSelector).Return(stockElMock, nil).Once()
+		loggerMock.On("Error", "parser string to integer stock", mock.Anything).Once()
+		stockElMock.On("Text", mock.Anything).Return("invalid", nil).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.OzonCfg.DeliverySelector).Return(nil, errors.New("not found")).Once()
+
+		res, err := oz.GetAllProducts(context.Background(), domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.ElementsMatch(t, prods, res)
@@ -186,6 +220,305 @@ func TestParsers_OzonParser(t *testing.T) {
 		priceElMock.AssertExpectations(t)
 		ratingElMock.AssertExpectations(t)
 		reviewsElMock.AssertExpectations(t)
+		stockElMock.AssertExpectations(t)
 	})
 
 }
+
+func TestParsers_OzonParserRegion(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Regions:        map[string]string{"spb": "Санкт-Петербург, Невский проспект, 1"},
+			RegionCacheTTL: time.Hour,
+			OzonCfg: &config.OzonConfig{
+				BaseURL:       "https://www.ozon.ru",
+				ItemsSelector: "itemsselector",
+				Region: config.RegionPickerConfig{
+					AddressButtonSelector:     "addressbuttonselector",
+					AddressInputSelector:      "addressinputselector",
+					AddressSuggestionSelector: "addresssuggestionselector",
+					AddressConfirmSelector:    "addressconfirmselector",
+				},
+			},
+		},
+	}
+
+	categoryURL := "https://www.ozon.ru/category/noutbuki"
+	query := domain.CategoryQuery{Marketplace: domain.MarketplaceOzon, Category: "category/noutbuki", Region: "SPB"}
+	cookies := []repository.Cookie{{Name: "address", Value: "spb", Domain: ".ozon.ru", Path: "/"}}
+	localStorage := map[string]string{"address": "spb"}
+
+	expectPicker := func(pageMock *mocks.PageMock, picking chan struct{}, confirmed <-chan time.Time) {
+		addressButtonMock := &mocks.ElementMock{}
+		addressInputMock := &mocks.ElementMock{}
+		suggestionMock := &mocks.ElementMock{}
+		confirmButtonMock := &mocks.ElementMock{}
+
+		move := pageMock.On("MoveCursorToElement", mock.Anything, "addressbuttonselector").Return(nil).Once()
+		if picking != nil {
+			move.Run(func(mock.Arguments) { close(picking) })
+		}
+		pageMock.On("Element", mock.Anything, "addressbuttonselector").Return(addressButtonMock, nil).Once()
+		addressButtonMock.On("Click", mock.Anything).Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addressinputselector").Return(addressInputMock, nil).Once()
+		addressInputMock.On("Input", mock.Anything, "Санкт-Петербург, Невский проспект, 1").Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addresssuggestionselector").Return(suggestionMock, nil).Once()
+		suggestionMock.On("Click", mock.Anything).Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addressconfirmselector").Return(confirmButtonMock, nil).Once()
+		call := confirmButtonMock.On("Click", mock.Anything).Return(nil).Once()
+		if confirmed != nil {
+			call.WaitFor = confirmed
+		}
+
+		pageMock.On("Cookies", mock.Anything).Return(cookies, nil).Once()
+		pageMock.On("LocalStorage", mock.Anything).Return(localStorage, nil).Once()
+	}
+
+	expectPage := func(pageMock *mocks.PageMock) {
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, "itemsselector").Return([]repository.Element{}, nil).Once()
+	}
+
+	t.Run("concurrent selections share the picker", func(t *testing.T) {
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+		first := &mocks.PageMock{}
+		second := &mocks.PageMock{}
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(first, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(second, nil).Once()
+
+		// The first page runs the picker until the address is confirmed
+		picking := make(chan struct{})
+		confirmed := make(chan time.Time)
+		expectPage(first)
+		first.On("NavigateWithReferer", mock.Anything, categoryURL).Return(nil).Once()
+		first.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
+		expectPicker(first, picking, confirmed)
+
+		// The second page waits for the picker and reloads with its session
+		opened := make(chan struct{})
+		expectPage(second)
+		second.On("NavigateWithReferer", mock.Anything, categoryURL).Return(nil).Twice()
+		second.On("WaitDOMStable", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { close(opened) })
+		second.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+		second.On("URL", mock.Anything).Return(categoryURL, nil).Once()
+		second.On("SetCookies", mock.Anything, cookies).Return(nil).Once()
+		second.On("SetLocalStorage", mock.Anything, localStorage).Return(nil).Once()
+
+		errs := make(chan error, 2)
+		go func() {
+			_, err := oz.GetCategoryProducts(context.Background(), query)
+			errs <- err
+		}()
+		<-picking
+		go func() {
+			_, err := oz.GetCategoryProducts(context.Background(), query)
+			errs <- err
+		}()
+
+		<-opened
+		close(confirmed)
+		assert.NoError(t, <-errs)
+		assert.NoError(t, <-errs)
+
+		browserRepoMock.AssertExpectations(t)
+		first.AssertExpectations(t)
+		second.AssertExpectations(t)
+		second.AssertNotCalled(t, "MoveCursorToElement", mock.Anything, "addressbuttonselector")
+	})
+
+	t.Run("restore cached region", func(t *testing.T) {
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+		first := &mocks.PageMock{}
+		second := &mocks.PageMock{}
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(first, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(second, nil).Once()
+
+		expectPage(first)
+		first.On("NavigateWithReferer", mock.Anything, categoryURL).Return(nil).Once()
+		first.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
+		expectPicker(first, nil, nil)
+
+		_, err := oz.GetCategoryProducts(context.Background(), query)
+		assert.NoError(t, err)
+
+		expectPage(second)
+		second.On("SetCookies", mock.Anything, cookies).Return(nil).Once()
+		second.On("SetLocalStorage", mock.Anything, localStorage).Return(nil).Once()
+		second.On("NavigateWithReferer", mock.Anything, categoryURL).Return(nil).Once()
+		second.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+
+		_, err = oz.GetCategoryProducts(context.Background(), query)
+		assert.NoError(t, err)
+
+		browserRepoMock.AssertExpectations(t)
+		first.AssertExpectations(t)
+		second.AssertExpectations(t)
+	})
+}
+
+func TestParsers_OzonParserSuggestions(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			OzonCfg: &config.OzonConfig{
+				BaseURL:             "baseurl",
+				SearchBarSelector:   "searchbarselector",
+				SuggestionsSelector: "suggestionsselector",
+			},
+		},
+	}
+
+	expectSearchBar := func(pageMock *mocks.PageMock) {
+		searchBarMock := &mocks.ElementMock{}
+
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.OzonCfg.BaseURL).Return(nil).Once()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+
+		pageMock.On("Element", mock.Anything, cfg.Server.OzonCfg.SearchBarSelector).Return(searchBarMock, nil).Once()
+		pageMock.On("MoveCursorToElement", mock.Anything, cfg.Server.OzonCfg.SearchBarSelector).Return(nil).Once()
+		searchBarMock.On("Click", mock.Anything).Return(nil).Once()
+		searchBarMock.On("Input", mock.Anything, "соков").Return(nil).Once()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		pageMock := &mocks.PageMock{}
+		firstMock := &mocks.ElementMock{}
+		secondMock := &mocks.ElementMock{}
+		duplicateMock := &mocks.ElementMock{}
+
+		oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+		// Suggestions reuse the session of the default region
+		browserRepoMock.On("NewPage", mock.Anything, repository.PageOptions{
+			Session: &repository.SessionKey{Marketplace: domain.MarketplaceOzon},
+		}).Return(pageMock, nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		expectSearchBar(pageMock)
+
+		pageMock.On("Element", mock.Anything, cfg.Server.OzonCfg.SuggestionsSelector).Return(firstMock, nil).Once()
+		pageMock.On("Elements", mock.Anything, cfg.Server.OzonCfg.SuggestionsSelector).
+			Return([]repository.Element{firstMock, secondMock, duplicateMock}, nil).Once()
+		firstMock.On("Text", mock.Anything).Return("соковыжималка", nil).Once()
+		secondMock.On("Text", mock.Anything).Return(" соковарка ", nil).Once()
+		duplicateMock.On("Text", mock.Anything).Return("СОКОВАРКА", nil).Once()
+
+		res, err := oz.GetSuggestions(context.Background(), "соков")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"соковыжималка", "соковарка"}, res)
+
+		browserRepoMock.AssertExpectations(t)
+		pageMock.AssertExpectations(t)
+	})
+
+	t.Run("suggestions are not rendered", func(t *testing.T) {
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		pageMock := &mocks.PageMock{}
+
+		oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		expectSearchBar(pageMock)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		pageMock.On("Element", mock.Anything, cfg.Server.OzonCfg.SuggestionsSelector).
+			Return(nil, context.DeadlineExceeded).Once()
+
+		_, err := oz.GetSuggestions(ctx, "соков")
+		assert.ErrorIs(t, err, repository.ErrGatewayTimeout)
+
+		browserRepoMock.AssertExpectations(t)
+		pageMock.AssertExpectations(t)
+		pageMock.AssertNotCalled(t, "Elements", mock.Anything, cfg.Server.OzonCfg.SuggestionsSelector)
+	})
+}
+
+func TestParsers_OzonParserRequestBlocking(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	cfg := &config.Config{
+		Browser: config.BrowserConfig{
+			Blocking: config.RequestBlockingConfig{Enabled: true, ResourceTypes: []string{"image"}},
+		},
+		Server: config.ServerConfig{
+			OzonCfg: &config.OzonConfig{
+				BaseURL: "baseurl",
+				Blocking: &config.RequestBlockingConfig{
+					Enabled:       true,
+					ResourceTypes: []string{"media", "font"},
+					URLPatterns:   []string{"*top-fwz1.mail.ru*"},
+				},
+			},
+		},
+	}
+
+	oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+	errNavigate := errors.New("navigate")
+	browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	// The marketplace rules override the browser ones
+	pageMock.On("BlockRequests", mock.Anything, repository.RequestBlocking{
+		ResourceTypes: []string{"media", "font"},
+		URLPatterns:   []string{"*top-fwz1.mail.ru*"},
+	}).Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.OzonCfg.BaseURL).Return(errNavigate).Once()
+
+	_, err := oz.GetAllProducts(context.Background(), domain.SearchQuery{Name: "prod"})
+	assert.ErrorIs(t, err, errNavigate)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+}
+
+func TestParsers_OzonParserProduct(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+	nameMock := &mocks.ElementMock{}
+	priceMock := &mocks.ElementMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			OzonCfg: &config.OzonConfig{
+				BaseURL: "https://www.ozon.ru/",
+				ProductPage: config.ProductPageConfig{
+					NameSelector:  "nameselector",
+					PriceSelector: "priceselector",
+				},
+			},
+		},
+	}
+
+	oz := parsers.NewOzonParser(cfg, loggerMock, browserRepoMock, nil)
+
+	browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, "https://www.ozon.ru/product/123/").Return(nil).Once()
+	pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+	pageMock.On("Elements", mock.Anything, "nameselector").Return([]repository.Element{nameMock}, nil).Once()
+	pageMock.On("Elements", mock.Anything, "priceselector").Return([]repository.Element{priceMock}, nil).Once()
+	nameMock.On("Text", mock.Anything).Return("Смартфон", nil).Once()
+	priceMock.On("Text", mock.Anything).Return("1 990 ₽", nil).Once()
+
+	res, err := oz.GetProduct(context.Background(), domain.ProductQuery{Marketplace: domain.MarketplaceOzon, ID: "123"})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Product{ID: "123", Name: "Смартфон", Price: 1990.0, InStock: true, Link: "https://www.ozon.ru/product/123/"}, res)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+	pageMock.AssertNotCalled(t, "Text", mock.Anything)
+}
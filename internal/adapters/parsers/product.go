@@ -0,0 +1,122 @@
+package parsers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+// parseProductPage parses the product of the opened product page. The page without the product name is
+// a missing product if it has a not found marker, repository.ErrProductNotFound is returned then.
+func parseProductPage(ctx context.Context, page repository.Page, cfg *ProductPageConfig, link string, now time.Time, logger logger.Logger) (domain.Product, error) {
+	// Only the visible text is matched like the block markers
+	var text string
+	if len(cfg.OutOfStockMarkers) > 0 || len(cfg.NotFoundMarkers) > 0 {
+		var err error
+		if text, err = page.Text(ctx); err != nil {
+			return domain.Product{}, utils.WrapError("text", err, ctx)
+		}
+	}
+
+	name, ok, err := elementText(ctx, page, cfg.NameSelector)
+	if err != nil {
+		return domain.Product{}, utils.WrapError("text name", err, ctx)
+	}
+	if !ok || name == "" {
+		if _, notFound := markerIn(text, cfg.NotFoundMarkers); notFound {
+			return domain.Product{}, repository.ErrProductNotFound
+		}
+		return domain.Product{}, fmt.Errorf("product page %s has no product name", link)
+	}
+
+	product := domain.Product{Name: name, Link: link}
+	_, outOfStock := markerIn(text, cfg.OutOfStockMarkers)
+	product.InStock = !outOfStock
+
+	priceStr, ok, err := elementText(ctx, page, cfg.PriceSelector)
+	if err != nil {
+		return domain.Product{}, utils.WrapError("text price", err, ctx)
+	}
+	// The product that can't be ordered may have no price
+	if ok {
+		if product.Price, err = ParseStringToFloat64(priceStr); err != nil {
+			logger.Error("parser string to float64 price", err)
+			product.Price = 0.0
+		}
+	}
+
+	ratingStr, ok, err := elementText(ctx, page, cfg.RatingSelector)
+	if err != nil {
+		return domain.Product{}, utils.WrapError("text rating", err, ctx)
+	}
+	if ok {
+		if product.Rating, err = ParseStringToFloat64(ratingStr); err != nil {
+			logger.Error("parser string to float64 rating", err)
+			product.Rating = 0.0
+		}
+	}
+
+	reviewsStr, ok, err := elementText(ctx, page, cfg.ReviewsSelector)
+	if err != nil {
+		return domain.Product{}, utils.WrapError("text reviews", err, ctx)
+	}
+	if ok {
+		if product.ReviewsCount, err = ParseStringToInteger(reviewsStr); err != nil {
+			logger.Error("parser string to integer reviews", err)
+			product.ReviewsCount = 0
+		}
+	}
+
+	deliveryStr, ok, err := elementText(ctx, page, cfg.DeliverySelector)
+	if err != nil {
+		return domain.Product{}, utils.WrapError("text delivery", err, ctx)
+	}
+	if ok {
+		product.DeliveryText = deliveryStr
+		// The delivery text is kept even if the date can't be parsed from it
+		product.DeliveryDate, _ = ParseDeliveryDate(deliveryStr, now)
+	}
+
+	return product, nil
+}
+
+// elementText returns the trimmed text of the first element of the page matching the selector.
+// It doesn't wait for the element, false is returned if there is none or the selector is empty.
+func elementText(ctx context.Context, page repository.Page, selector string) (string, bool, error) {
+	if selector == "" {
+		return "", false, nil
+	}
+
+	elements, err := page.Elements(ctx, selector)
+	if err != nil {
+		return "", false, err
+	}
+	if len(elements) == 0 {
+		return "", false, nil
+	}
+
+	text, err := elements[0].Text(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimSpace(text), true, nil
+}
+
+// markerIn returns the first of the markers the text contains, the markers are matched case-insensitively.
+func markerIn(text string, markers []string) (string, bool) {
+	text = strings.ToLower(text)
+	for _, marker := range markers {
+		if marker != "" && strings.Contains(text, strings.ToLower(marker)) {
+			return marker, true
+		}
+	}
+
+	return "", false
+}
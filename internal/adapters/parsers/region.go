@@ -0,0 +1,219 @@
+package parsers
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+// regionSession is the browser state the marketplace keeps the selected delivery address in.
+type regionSession struct {
+	cookies      []repository.Cookie
+	localStorage map[string]string
+	expiresAt    time.Time
+}
+
+// regionCall is a running address picker, the pages selecting the same region wait for its session.
+type regionCall struct {
+	done    chan struct{}
+	session *regionSession
+	err     error
+}
+
+// regionSelector sets the delivery region on marketplace pages.
+// The region is selected via the site's address picker once, then the resulting
+// cookies and localStorage are cached and restored into the following pages.
+// The concurrent selections of the same region share a single picker run.
+type regionSelector struct {
+	cfg *RegionConfig
+
+	mu       sync.RWMutex
+	sessions map[string]*regionSession
+	calls    map[string]*regionCall
+}
+
+func newRegionSelector(cfg *RegionConfig) *regionSelector {
+	return &regionSelector{
+		cfg:      cfg,
+		sessions: make(map[string]*regionSession),
+		calls:    make(map[string]*regionCall),
+	}
+}
+
+// Address returns the delivery address for the region preset name or the region itself if there is no such preset.
+func (rs *regionSelector) Address(region string) string {
+	for name, address := range rs.cfg.Presets {
+		if strings.EqualFold(name, region) {
+			return address
+		}
+	}
+
+	return region
+}
+
+// Restore applies the cached region session to the page, it must be called before navigation.
+// It returns false if there is no cached session for the region.
+func (rs *regionSelector) Restore(ctx context.Context, page repository.Page, region string) (bool, error) {
+	key := strings.ToLower(rs.Address(region))
+
+	rs.mu.RLock()
+	session, ok := rs.sessions[key]
+	rs.mu.RUnlock()
+
+	if !ok || time.Now().After(session.expiresAt) {
+		return false, nil
+	}
+
+	if err := rs.apply(ctx, page, session); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Select sets the delivery address via the address picker of the opened page and caches the region session.
+// If the picker is already running on another page for the same region, Select waits for its session
+// and reloads the page with it instead.
+func (rs *regionSelector) Select(ctx context.Context, page repository.Page, region string) error {
+	address := rs.Address(region)
+	key := strings.ToLower(address)
+
+	rs.mu.Lock()
+	// The region could be selected by another page after this one was opened
+	if session, ok := rs.sessions[key]; ok && time.Now().Before(session.expiresAt) {
+		rs.mu.Unlock()
+		return rs.reload(ctx, page, session)
+	}
+	call, running := rs.calls[key]
+	if !running {
+		call = &regionCall{done: make(chan struct{})}
+		rs.calls[key] = call
+	}
+	rs.mu.Unlock()
+
+	if running {
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return utils.WrapError("wait region selection", ctx.Err(), ctx)
+		}
+		if call.err != nil {
+			return call.err
+		}
+		return rs.reload(ctx, page, call.session)
+	}
+
+	call.session, call.err = rs.pick(ctx, page, address)
+
+	rs.mu.Lock()
+	if call.err == nil {
+		rs.sessions[key] = call.session
+	}
+	delete(rs.calls, key)
+	rs.mu.Unlock()
+	close(call.done)
+
+	return call.err
+}
+
+// apply sets the cookies and localStorage of the region session to the page.
+func (rs *regionSelector) apply(ctx context.Context, page repository.Page, session *regionSession) error {
+	if err := page.SetCookies(ctx, session.cookies); err != nil {
+		return utils.WrapError("set cookies", err, ctx)
+	}
+	if len(session.localStorage) > 0 {
+		if err := page.SetLocalStorage(ctx, session.localStorage); err != nil {
+			return utils.WrapError("set local storage", err, ctx)
+		}
+	}
+
+	return nil
+}
+
+// reload applies the region session to the opened page and navigates to its url again.
+func (rs *regionSelector) reload(ctx context.Context, page repository.Page, session *regionSession) error {
+	if err := rs.apply(ctx, page, session); err != nil {
+		return err
+	}
+
+	url, err := page.URL(ctx)
+	if err != nil {
+		return utils.WrapError("url", err, ctx)
+	}
+	if err := page.NavigateWithReferer(ctx, url); err != nil {
+		return utils.WrapError("navigate page with referer", err, ctx)
+	}
+	if err := page.WaitDOMStable(ctx); err != nil {
+		return utils.WrapError("wait dom stable", err, ctx)
+	}
+
+	return nil
+}
+
+// pick sets the delivery address via the address picker of the opened page and returns the resulting session.
+func (rs *regionSelector) pick(ctx context.Context, page repository.Page, address string) (*regionSession, error) {
+	// Open the address picker
+	if err := page.MoveCursorToElement(ctx, rs.cfg.AddressButtonSelector); err != nil {
+		return nil, utils.WrapError("move cursor to element address button", err, ctx)
+	}
+	addressButton, err := page.Element(ctx, rs.cfg.AddressButtonSelector)
+	if err != nil {
+		return nil, utils.WrapError("element address button", err, ctx)
+	}
+	if err := addressButton.Click(ctx); err != nil {
+		return nil, utils.WrapError("click address button", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+
+	// Type the address and pick the first suggestion
+	addressInput, err := page.Element(ctx, rs.cfg.AddressInputSelector)
+	if err != nil {
+		return nil, utils.WrapError("element address input", err, ctx)
+	}
+	if err := addressInput.Input(ctx, address); err != nil {
+		return nil, utils.WrapError("input address", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+
+	suggestion, err := page.Element(ctx, rs.cfg.AddressSuggestionSelector)
+	if err != nil {
+		return nil, utils.WrapError("element address suggestion", err, ctx)
+	}
+	if err := suggestion.Click(ctx); err != nil {
+		return nil, utils.WrapError("click address suggestion", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+
+	confirmButton, err := page.Element(ctx, rs.cfg.AddressConfirmSelector)
+	if err != nil {
+		return nil, utils.WrapError("element address confirm button", err, ctx)
+	}
+	if err := confirmButton.Click(ctx); err != nil {
+		return nil, utils.WrapError("click address confirm button", err, ctx)
+	}
+
+	// Wait for the page to reload with the new delivery address
+	if err := page.WaitDOMStable(ctx); err != nil {
+		return nil, utils.WrapError("wait dom stable", err, ctx)
+	}
+
+	cookies, err := page.Cookies(ctx)
+	if err != nil {
+		return nil, utils.WrapError("cookies", err, ctx)
+	}
+	localStorage, err := page.LocalStorage(ctx)
+	if err != nil {
+		return nil, utils.WrapError("local storage", err, ctx)
+	}
+
+	return &regionSession{
+		cookies:      cookies,
+		localStorage: localStorage,
+		expiresAt:    time.Now().Add(rs.cfg.CacheTTL),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package parsers
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// pageOptions returns the options of a page that reuses the marketplace session of the region
+// and connects through the marketplace proxy.
+func pageOptions(marketplace domain.Marketplace, address string, proxy *repository.Proxy) repository.PageOptions {
+	return repository.PageOptions{
+		Session: &repository.SessionKey{Marketplace: marketplace, Region: address},
+		Proxy:   proxy,
+	}
+}
+
+// saveSession stores the session of the page after a successful run, so the next pages skip the first-visit flows.
+// A failure to save doesn't fail the run.
+func saveSession(ctx context.Context, page repository.Page, marketplace domain.Marketplace, logger logger.Logger, err error) {
+	if err != nil {
+		return
+	}
+
+	if err := page.SaveSession(ctx); err != nil {
+		logger.Warn("save session", "marketplace", marketplace, "err", err)
+	}
+}
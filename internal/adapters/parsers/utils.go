@@ -1,9 +1,16 @@
 package parsers
 
 import (
+	"context"
+	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
 )
 
 func ParseStringToFloat64(s string) (float64, error) {
@@ -45,4 +52,129 @@ func ParseStringToInteger(s string) (int, error) {
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+
+var monthsGenitive = map[string]time.Month{
+	"января":   time.January,
+	"февраля":  time.February,
+	"марта":    time.March,
+	"апреля":   time.April,
+	"мая":      time.May,
+	"июня":     time.June,
+	"июля":     time.July,
+	"августа":  time.August,
+	"сентября": time.September,
+	"октября":  time.October,
+	"ноября":   time.November,
+	"декабря":  time.December,
+}
+
+// ParseDeliveryDate parses the nearest delivery date from a product card text
+// like "завтра", "послезавтра", "через 3 дня", "25 октября" or "5–7 ноября" relative to now.
+func ParseDeliveryDate(s string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	text := strings.ToLower(s)
+
+	switch {
+	case strings.Contains(text, "послезавтра"):
+		return today.AddDate(0, 0, 2), nil
+	case strings.Contains(text, "завтра"):
+		return today.AddDate(0, 0, 1), nil
+	case strings.Contains(text, "сегодня"):
+		return today, nil
+	}
+
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '-' || r == '–' || r == '—'
+	})
+
+	for i, w := range words {
+		if w == "через" && i+1 < len(words) {
+			days, err := strconv.Atoi(words[i+1])
+			if err != nil {
+				break
+			}
+			return today.AddDate(0, 0, days), nil
+		}
+	}
+
+	// The first number followed (possibly after a range end) by a month name is the nearest date.
+	day := 0
+	for _, w := range words {
+		if n, err := strconv.Atoi(w); err == nil {
+			if day == 0 {
+				day = n
+			}
+			continue
+		}
+		if month, ok := monthsGenitive[w]; ok && day > 0 {
+			date := time.Date(today.Year(), month, day, 0, 0, 0, 0, today.Location())
+			// Dates in the past refer to the next year, e.g. "3 января" seen in December.
+			if date.Before(today) {
+				date = date.AddDate(1, 0, 0)
+			}
+			return date, nil
+		}
+		day = 0
+	}
+
+	return time.Time{}, fmt.Errorf("parse delivery date %q", s)
+}
+
+// CategoryURL returns the url of the catalog category page. The category is either a full url
+// on the marketplace site or a catalog path relative to baseURL like "catalog/elektronika/noutbuki".
+func CategoryURL(baseURL string, category string) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse base url: %w", err)
+	}
+
+	category = strings.TrimSpace(category)
+	if strings.HasPrefix(category, "http://") || strings.HasPrefix(category, "https://") {
+		u, err := url.Parse(category)
+		if err != nil {
+			return "", fmt.Errorf("parse category url: %w", repository.ErrInvalidCategory)
+		}
+		// Don't let the browser go anywhere outside of the marketplace
+		host := strings.TrimPrefix(base.Hostname(), "www.")
+		if u.Hostname() != host && !strings.HasSuffix(u.Hostname(), "."+host) {
+			return "", fmt.Errorf("category host %q: %w", u.Hostname(), repository.ErrInvalidCategory)
+		}
+		return u.String(), nil
+	}
+
+	path := strings.TrimLeft(category, "/")
+	if path == "" {
+		return "", fmt.Errorf("empty category path: %w", repository.ErrInvalidCategory)
+	}
+	ref, err := url.Parse("/" + path)
+	if err != nil {
+		return "", fmt.Errorf("parse category path: %w", repository.ErrInvalidCategory)
+	}
+
+	return base.ResolveReference(ref).String(), nil
+}
+
+// ParseSuggestions returns the trimmed texts of the suggestion list items without empty and duplicate ones.
+func ParseSuggestions(ctx context.Context, items []repository.Element) ([]string, error) {
+	res := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, itm := range items {
+		text, err := itm.Text(ctx)
+		if err != nil {
+			return nil, utils.WrapError("text suggestion", err, ctx)
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		key := strings.ToLower(text)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		res = append(res, text)
+	}
+
+	return res, nil
+}
@@ -2,9 +2,11 @@ package parsers_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/parsers"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
 )
 
 func TestParsers_ParseStringToFloat64(t *testing.T) {
@@ -84,4 +86,135 @@ func TestParsers_ParseStringToInteger(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestParsers_ParseDeliveryDate(t *testing.T) {
+	now := time.Date(2025, time.December, 30, 15, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		text    string
+		expDate time.Time
+		expErr  bool
+	}{
+		{
+			name:    "today",
+			text:    "Сегодня",
+			expDate: time.Date(2025, time.December, 30, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "tomorrow",
+			text:    "Завтра",
+			expDate: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "day after tomorrow",
+			text:    "Послезавтра",
+			expDate: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "in days",
+			text:    "через 3 дня",
+			expDate: time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "day and month",
+			text:    "31 декабря",
+			expDate: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "next year",
+			text:    "Доставка 5 января, пн",
+			expDate: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:    "range",
+			text:    "5–7 января",
+			expDate: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+			expErr:  false,
+		},
+		{
+			name:   "invalid",
+			text:   "invalid",
+			expErr: true,
+		},
+		{
+			name:   "empty",
+			text:   "",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.expErr {
+				res, err := parsers.ParseDeliveryDate(tc.text, now)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expDate, res)
+			} else {
+				_, err := parsers.ParseDeliveryDate(tc.text, now)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParsers_CategoryURL(t *testing.T) {
+	baseURL := "https://www.wildberries.ru"
+
+	testCases := []struct {
+		name     string
+		category string
+		expURL   string
+		expErr   bool
+	}{
+		{
+			name:     "path",
+			category: "catalog/elektronika/noutbuki",
+			expURL:   "https://www.wildberries.ru/catalog/elektronika/noutbuki",
+			expErr:   false,
+		},
+		{
+			name:     "path with leading slash and query",
+			category: "/catalog/elektronika/noutbuki?sort=popular",
+			expURL:   "https://www.wildberries.ru/catalog/elektronika/noutbuki?sort=popular",
+			expErr:   false,
+		},
+		{
+			name:     "url",
+			category: "https://www.wildberries.ru/catalog/elektronika/noutbuki",
+			expURL:   "https://www.wildberries.ru/catalog/elektronika/noutbuki",
+			expErr:   false,
+		},
+		{
+			name:     "url on another site",
+			category: "https://example.com/catalog/elektronika/noutbuki",
+			expErr:   true,
+		},
+		{
+			name:     "empty",
+			category: "/",
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.expErr {
+				res, err := parsers.CategoryURL(baseURL, tc.category)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expURL, res)
+			} else {
+				_, err := parsers.CategoryURL(baseURL, tc.category)
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, repository.ErrInvalidCategory)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package parsers
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/go-rod/rod/lib/input"
@@ -14,42 +17,50 @@ import (
 )
 
 type WildberriesParser interface {
-	GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)
+	GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error)
 }
 
 type wildberriesParser struct {
-	cfg     *WildberriesConfig
-	logger  logger.Logger
-	browser repository.BrowserRepository
+	cfg       *WildberriesConfig
+	logger    logger.Logger
+	browser   repository.BrowserRepository
+	region    *regionSelector
+	block     *blockDetector
+	artifacts *artifactCollector
+	now       func() time.Time
 }
 
 // NewWildberriesParser сreate a new empty object that implements the WildberriesParser interface.
-func NewWildberriesParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository) *wildberriesParser {
-	return &wildberriesParser{cfg: NewWildberriesConfig(cfg), logger: logger, browser: browser}
+func NewWildberriesParser(cfg *config.Config, logger logger.Logger, browser repository.BrowserRepository, artifacts repository.ArtifactRepository) *wildberriesParser {
+	wbCfg := NewWildberriesConfig(cfg)
+	return &wildberriesParser{
+		cfg:       wbCfg,
+		logger:    logger,
+		browser:   browser,
+		region:    newRegionSelector(wbCfg.Region),
+		block:     newBlockDetector(wbCfg.Block, domain.MarketplaceWildberries, logger),
+		artifacts: newArtifactCollector(wbCfg.Artifacts, domain.MarketplaceWildberries, artifacts, logger),
+		now:       time.Now,
+	}
+}
+
+// Marketplace returns the marketplace the parser gets products from.
+func (wp *wildberriesParser) Marketplace() domain.Marketplace {
+	return domain.MarketplaceWildberries
 }
 
 // GetAllProducts parses and gets a list of products from the site.
-func (wp *wildberriesParser) GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	// Open stealth page
-	page, err := wp.browser.NewPage(ctx)
+func (wp *wildberriesParser) GetAllProducts(ctx context.Context, query domain.SearchQuery) (_ []domain.Product, err error) {
+	// Navigate to wb by base url from config
+	page, err := wp.openPage(ctx, wp.cfg.BaseURL, query.Region, query.Debug)
 	if err != nil {
-		return nil, utils.WrapError("page", err, ctx)
+		return nil, err
 	}
 	defer page.Close()
-
-	// Navigate to wb by base url from config
-	if err := page.NavigateWithReferer(ctx, wp.cfg.BaseURL); err != nil {
-		return nil, utils.WrapError("navigate page with referer", err, ctx)
-	}
-	// Wait for the DOM to load to find the closing button.
-	if err := page.WaitDOMStable(ctx); err != nil {
-		return nil, utils.WrapError("wait dom stable", err, ctx)
-	}
-
-	// Close the pop-up window if there is one
-	if err := page.ClosePopUpWindow(ctx, wp.cfg.CloseButtonSelector); err != nil {
-		return nil, utils.WrapError("close pop up window", err, ctx)
-	}
+	// Capture the page before it's closed if the search fails
+	defer func() { err = wp.artifacts.Collect(ctx, page, "search", query.Debug, err) }()
+	// Keep the cookies of the successful search for the next pages
+	defer func() { saveSession(ctx, page, domain.MarketplaceWildberries, wp.logger, err) }()
 
 	// Find wb serach bar
 	searchBar, err := page.Element(ctx, wp.cfg.SearchBarSelector)
@@ -74,7 +85,7 @@ func (wp *wildberriesParser) GetAllProducts(ctx context.Context, name string, pr
 	}
 	*/
 
-	if err := searchBar.Input(ctx, name); err != nil {
+	if err := searchBar.Input(ctx, query.Name); err != nil {
 		return nil, utils.WrapError("input search bar", err, ctx)
 	}
 	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
@@ -85,7 +96,153 @@ func (wp *wildberriesParser) GetAllProducts(ctx context.Context, name string, pr
 	if err := page.WaitDOMStable(ctx); err != nil {
 		return nil, utils.WrapError("wait dom stable", err, ctx)
 	}
+	// The search may be answered with a captcha instead of the results
+	if err := wp.block.Check(ctx, page); err != nil {
+		return nil, utils.WrapError("check block page", err, ctx)
+	}
+
+	return wp.parseItems(ctx, page)
+}
+
+// GetCategoryProducts parses and gets a list of products from the catalog category page.
+func (wp *wildberriesParser) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) (_ []domain.Product, err error) {
+	categoryURL, err := CategoryURL(wp.cfg.BaseURL, query.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := wp.openPage(ctx, categoryURL, query.Region, query.Debug)
+	if err != nil {
+		return nil, err
+	}
+	defer page.Close()
+	defer func() { err = wp.artifacts.Collect(ctx, page, "category", query.Debug, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceWildberries, wp.logger, err) }()
 
+	return wp.parseItems(ctx, page)
+}
+
+// GetProduct parses the product from its product page.
+func (wp *wildberriesParser) GetProduct(ctx context.Context, query domain.ProductQuery) (_ domain.Product, err error) {
+	link := fmt.Sprintf("%s/catalog/%s/detail.aspx", strings.TrimSuffix(wp.cfg.BaseURL, "/"), url.PathEscape(query.ID))
+
+	page, err := wp.openPage(ctx, link, query.Region, query.Debug)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	defer page.Close()
+	defer func() { err = wp.artifacts.Collect(ctx, page, "product", query.Debug, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceWildberries, wp.logger, err) }()
+
+	product, err := parseProductPage(ctx, page, wp.cfg.ProductPage, link, wp.now(), wp.logger)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	product.ID = query.ID
+
+	return product, nil
+}
+
+// GetSuggestions types the prefix into the search bar and gets the suggested search queries.
+func (wp *wildberriesParser) GetSuggestions(ctx context.Context, prefix string) (_ []string, err error) {
+	page, err := wp.openPage(ctx, wp.cfg.BaseURL, "", false)
+	if err != nil {
+		return nil, err
+	}
+	defer page.Close()
+	defer func() { err = wp.artifacts.Collect(ctx, page, "suggestions", false, err) }()
+	defer func() { saveSession(ctx, page, domain.MarketplaceWildberries, wp.logger, err) }()
+
+	searchBar, err := page.Element(ctx, wp.cfg.SearchBarSelector)
+	if err != nil {
+		return nil, utils.WrapError("element search bar", err, ctx)
+	}
+	if err := page.MoveCursorToElement(ctx, wp.cfg.SearchBarSelector); err != nil {
+		return nil, utils.WrapError("move cursor to element search bar", err, ctx)
+	}
+	if err := searchBar.Click(ctx); err != nil {
+		return nil, utils.WrapError("click search bar", err, ctx)
+	}
+	time.Sleep(time.Duration(rand.Intn(500)+500) * time.Millisecond)
+
+	if err := searchBar.Input(ctx, prefix); err != nil {
+		return nil, utils.WrapError("input search bar", err, ctx)
+	}
+	// Wait for the suggestion list to be rendered, Elements doesn't wait for the selector
+	if _, err := page.Element(ctx, wp.cfg.SuggestionsSelector); err != nil {
+		return nil, utils.WrapError("element suggestions", err, ctx)
+	}
+
+	items, err := page.Elements(ctx, wp.cfg.SuggestionsSelector)
+	if err != nil {
+		return nil, utils.WrapError("elements suggestions", err, ctx)
+	}
+
+	return ParseSuggestions(ctx, items)
+}
+
+// openPage opens a stealth page on the given url with the delivery region set.
+func (wp *wildberriesParser) openPage(ctx context.Context, url string, region string, debug bool) (repository.Page, error) {
+	page, err := wp.browser.NewPage(ctx, pageOptions(domain.MarketplaceWildberries, wp.region.Address(region), wp.cfg.Proxy))
+	if err != nil {
+		return nil, utils.WrapError("page", err, ctx)
+	}
+
+	if err := wp.preparePage(ctx, page, url, region); err != nil {
+		err = wp.artifacts.Collect(ctx, page, "open page", debug, err)
+		page.Close()
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (wp *wildberriesParser) preparePage(ctx context.Context, page repository.Page, url string, region string) error {
+	if wp.cfg.Blocking.Enabled {
+		if err := page.BlockRequests(ctx, wp.cfg.Blocking.Rules); err != nil {
+			return utils.WrapError("block requests", err, ctx)
+		}
+	}
+
+	// Restore the cached delivery region before navigation, so the site loads with it
+	regionRestored := false
+	if region != "" {
+		var err error
+		regionRestored, err = wp.region.Restore(ctx, page, region)
+		if err != nil {
+			return utils.WrapError("restore region", err, ctx)
+		}
+	}
+
+	if err := page.NavigateWithReferer(ctx, url); err != nil {
+		return utils.WrapError("navigate page with referer", err, ctx)
+	}
+	// Wait for the DOM to load to find the closing button.
+	if err := page.WaitDOMStable(ctx); err != nil {
+		return utils.WrapError("wait dom stable", err, ctx)
+	}
+	// Fail fast on a captcha or "access denied" page instead of waiting for the selectors
+	if err := wp.block.Check(ctx, page); err != nil {
+		return utils.WrapError("check block page", err, ctx)
+	}
+
+	// Close the pop-up window if there is one
+	if err := page.ClosePopUpWindow(ctx, wp.cfg.CloseButtonSelector); err != nil {
+		return utils.WrapError("close pop up window", err, ctx)
+	}
+
+	// Select the delivery region via the address picker if it wasn't cached yet
+	if region != "" && !regionRestored {
+		if err := wp.region.Select(ctx, page, region); err != nil {
+			return utils.WrapError("select region", err, ctx)
+		}
+	}
+
+	return nil
+}
+
+// parseItems parses product cards of the opened page.
+func (wp *wildberriesParser) parseItems(ctx context.Context, page repository.Page) ([]domain.Product, error) {
 	// Find and parse product-cards and parse
 	items, err := page.Elements(ctx, wp.cfg.ItemsSelector)
 	if err != nil {
@@ -170,12 +327,49 @@ func (wp *wildberriesParser) GetAllProducts(ctx context.Context, name string, pr
 			}
 		}
 
+		// Out-of-stock cards are marked with a sold-out badge
+		itmOutOfStock, _ := itm.Element(ctx, wp.cfg.OutOfStockSelector)
+		inStock := itmOutOfStock == nil
+		// Find the number of items left, it is shown only when stock is low
+		var stock int
+		itmStock, _ := itm.Element(ctx, wp.cfg.StockSelector)
+		if itmStock != nil {
+			stockStr, err := itmStock.Text(ctx)
+			if err != nil {
+				return nil, utils.WrapError("text stock", err, ctx)
+			}
+			stock, err = ParseStringToInteger(stockStr)
+			if err != nil {
+				// Log if an error occurs while parsing string to integer and set stock = 0
+				wp.logger.Error("parser string to integer stock", err)
+				stock = 0
+			}
+		}
+		// Find product delivery date
+		var deliveryText string
+		var deliveryDate time.Time
+		itmDelivery, _ := itm.Element(ctx, wp.cfg.DeliverySelector)
+		if itmDelivery != nil {
+			deliveryStr, err := itmDelivery.Text(ctx)
+			if err != nil {
+				return nil, utils.WrapError("text delivery", err, ctx)
+			}
+			deliveryText = strings.TrimSpace(deliveryStr)
+			// The delivery text is kept even if the date can't be parsed from it
+			deliveryDate, _ = ParseDeliveryDate(deliveryText, wp.now())
+		}
+
 		res = append(res, domain.Product{
-			Name:         name,
-			Link:         link,
-			Price:        price,
-			Rating:       rating,
-			ReviewsCount: reviews,
+			ID:            wp.Marketplace().ProductID(link),
+			Name:          name,
+			Link:          link,
+			Price:         price,
+			Rating:        rating,
+			ReviewsCount:  reviews,
+			InStock:       inStock,
+			StockQuantity: stock,
+			DeliveryText:  deliveryText,
+			DeliveryDate:  deliveryDate,
 		})
 	}
 
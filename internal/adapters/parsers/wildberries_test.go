@@ -2,7 +2,9 @@ package parsers_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,7 +33,7 @@ func TestParsers_NewWildberriesParser(t *testing.T) {
 
 	browserRepoMock := &mocks.BrowserRepositoryMock{}
 
-	wbParser := parsers.NewWildberriesParser(cfg, nil, browserRepoMock)
+	wbParser := parsers.NewWildberriesParser(cfg, nil, browserRepoMock, nil)
 	assert.NotNil(t, wbParser)
 }
 
@@ -46,6 +48,9 @@ func TestParsers_WildberriesParser(t *testing.T) {
 	priceElMock := &mocks.ElementMock{}
 	ratingElMock := &mocks.ElementMock{}
 	reviewsElMock := &mocks.ElementMock{}
+	outOfStockElMock := &mocks.ElementMock{}
+	stockElMock := &mocks.ElementMock{}
+	deliveryElMock := &mocks.ElementMock{}
 
 	cfg := &config.Config{
 		Server: config.ServerConfig{
@@ -58,19 +63,27 @@ func TestParsers_WildberriesParser(t *testing.T) {
 				PriceSelector:       "priceselector",
 				RatingSelector:      "ratingselector",
 				ReviewsSelector:     "reviewsselector",
+				OutOfStockSelector:  "outofstockselector",
+				StockSelector:       "stockselector",
+				DeliverySelector:    "deliveryselector",
 			},
 		},
 	}
 
-	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock)
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+	wb.SetNow(func() time.Time { return time.Date(2025, time.March, 10, 15, 4, 5, 0, time.UTC) })
 
 	t.Run("success", func(t *testing.T) {
 		p := domain.Product{
-			Name:         "product",
-			Link:         "link",
-			Price:        100.0,
-			Rating:       5.0,
-			ReviewsCount: 253,
+			Name:          "product",
+			Link:          "link",
+			Price:         100.0,
+			Rating:        5.0,
+			ReviewsCount:  253,
+			InStock:       true,
+			StockQuantity: 3,
+			DeliveryText:  "завтра",
+			DeliveryDate:  time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
 		}
 
 		prods := make([]domain.Product, 0, 1)
@@ -83,8 +96,9 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		linkPtr := "link"
 		labelPtr := "product"
 
-		browserRepoMock.On("NewPage", mock.Anything).Return(pageMock, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
 		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
 		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(nil).Once()
 		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
 		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
@@ -110,7 +124,15 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.ReviewsSelector).Return(reviewsElMock, nil).Once()
 		reviewsElMock.On("Text", mock.Anything).Return("253", nil).Once()
 
-		res, err := wb.GetAllProducts(context.Background(), prodName, priceFrom, priceTo)
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.OutOfStockSelector).Return(nil, errors.New("not found")).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.StockSelector).Return(stockElMock, nil).Once()
+		stockElMock.On("Text", mock.Anything).Return("Осталось 3 шт", nil).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.DeliverySelector).Return(deliveryElMock, nil).Once()
+		deliveryElMock.On("Text", mock.Anything).Return(" завтра ", nil).Once()
+
+		res, err := wb.GetAllProducts(context.Background(), domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.ElementsMatch(t, prods, res)
@@ -123,6 +145,8 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		priceElMock.AssertExpectations(t)
 		ratingElMock.AssertExpectations(t)
 		reviewsElMock.AssertExpectations(t)
+		stockElMock.AssertExpectations(t)
+		deliveryElMock.AssertExpectations(t)
 	})
 
 	t.Run("zero rating/price/reviews", func(t *testing.T) {
@@ -132,6 +156,7 @@ func TestParsers_WildberriesParser(t *testing.T) {
 			Price:        0.0,
 			Rating:       0.0,
 			ReviewsCount: 0,
+			InStock:      false,
 		}
 
 		prods := make([]domain.Product, 0, 1)
@@ -144,8 +169,9 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		linkPtr := "link"
 		labelPtr := "product"
 
-		browserRepoMock.On("NewPage", mock.Anything).Return(pageMock, nil).Once()
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
 		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
 		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(nil).Once()
 		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
 		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
@@ -174,7 +200,15 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		loggerMock.On("Error", "parser string to integer reviews", mock.Anything).Once()
 		reviewsElMock.On("Text", mock.Anything).Return("invalid", nil).Once()
 
-		res, err := wb.GetAllProducts(context.Background(), prodName, priceFrom, priceTo)
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.OutOfStockSelector).Return(outOfStockElMock, nil).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.StockSelector).Return(stockElMock, nil).Once()
+		loggerMock.On("Error", "parser string to integer stock", mock.Anything).Once()
+		stockElMock.On("Text", mock.Anything).Return("invalid", nil).Once()
+
+		itemMock.On("Element", mock.Anything, cfg.Server.WbCfg.DeliverySelector).Return(nil, errors.New("not found")).Once()
+
+		res, err := wb.GetAllProducts(context.Background(), domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.ElementsMatch(t, prods, res)
@@ -187,6 +221,515 @@ func TestParsers_WildberriesParser(t *testing.T) {
 		priceElMock.AssertExpectations(t)
 		ratingElMock.AssertExpectations(t)
 		reviewsElMock.AssertExpectations(t)
+		stockElMock.AssertExpectations(t)
+	})
+
+}
+
+func TestParsers_WildberriesParserRegion(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	searchBarMock := &mocks.ElementMock{}
+	addressButtonMock := &mocks.ElementMock{}
+	addressInputMock := &mocks.ElementMock{}
+	suggestionMock := &mocks.ElementMock{}
+	confirmButtonMock := &mocks.ElementMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Regions:        map[string]string{"spb": "Санкт-Петербург, Невский проспект, 1"},
+			RegionCacheTTL: time.Hour,
+			WbCfg: &config.WbConfig{
+				BaseURL:             "baseurl",
+				CloseButtonSelector: "closebuttonselector",
+				SearchBarSelector:   "searchbarselector",
+				ItemsSelector:       "itemsselector",
+				Region: config.RegionPickerConfig{
+					AddressButtonSelector:     "addressbuttonselector",
+					AddressInputSelector:      "addressinputselector",
+					AddressSuggestionSelector: "addresssuggestionselector",
+					AddressConfirmSelector:    "addressconfirmselector",
+				},
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+
+	query := domain.SearchQuery{Name: "macbook pro 16gb 512gb", PriceFrom: 50000.0, PriceTo: 250000.0, Region: "SPB"}
+	cookies := []repository.Cookie{{Name: "address", Value: "spb", Domain: ".baseurl", Path: "/"}}
+	localStorage := map[string]string{"address": "spb"}
+
+	expectSearch := func() {
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(nil).Once()
+		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+
+		pageMock.On("Element", mock.Anything, cfg.Server.WbCfg.SearchBarSelector).Return(searchBarMock, nil).Once()
+		pageMock.On("MoveCursorToElement", mock.Anything, cfg.Server.WbCfg.SearchBarSelector).Return(nil).Once()
+		searchBarMock.On("Click", mock.Anything).Return(nil).Once()
+		searchBarMock.On("Input", mock.Anything, query.Name).Return(nil).Once()
+		pageMock.On("KeyboardType", mock.Anything, mock.Anything).Return(nil).Once()
+
+		pageMock.On("Elements", mock.Anything, cfg.Server.WbCfg.ItemsSelector).Return([]repository.Element{}, nil).Once()
+	}
+
+	t.Run("select region", func(t *testing.T) {
+		expectSearch()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Times(3)
+
+		pageMock.On("MoveCursorToElement", mock.Anything, "addressbuttonselector").Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addressbuttonselector").Return(addressButtonMock, nil).Once()
+		addressButtonMock.On("Click", mock.Anything).Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addressinputselector").Return(addressInputMock, nil).Once()
+		addressInputMock.On("Input", mock.Anything, "Санкт-Петербург, Невский проспект, 1").Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addresssuggestionselector").Return(suggestionMock, nil).Once()
+		suggestionMock.On("Click", mock.Anything).Return(nil).Once()
+		pageMock.On("Element", mock.Anything, "addressconfirmselector").Return(confirmButtonMock, nil).Once()
+		confirmButtonMock.On("Click", mock.Anything).Return(nil).Once()
+
+		pageMock.On("Cookies", mock.Anything).Return(cookies, nil).Once()
+		pageMock.On("LocalStorage", mock.Anything).Return(localStorage, nil).Once()
+
+		res, err := wb.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		browserRepoMock.AssertExpectations(t)
+		pageMock.AssertExpectations(t)
+		addressButtonMock.AssertExpectations(t)
+		addressInputMock.AssertExpectations(t)
+		suggestionMock.AssertExpectations(t)
+		confirmButtonMock.AssertExpectations(t)
+	})
+
+	t.Run("restore cached region", func(t *testing.T) {
+		expectSearch()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Twice()
+
+		pageMock.On("SetCookies", mock.Anything, cookies).Return(nil).Once()
+		pageMock.On("SetLocalStorage", mock.Anything, localStorage).Return(nil).Once()
+
+		res, err := wb.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		browserRepoMock.AssertExpectations(t)
+		pageMock.AssertExpectations(t)
+	})
+}
+
+func TestParsers_WildberriesParserCategory(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL:             "https://www.wildberries.ru",
+				CloseButtonSelector: "closebuttonselector",
+				ItemsSelector:       "itemsselector",
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+	assert.Equal(t, domain.MarketplaceWildberries, wb.Marketplace())
+
+	t.Run("success", func(t *testing.T) {
+		query := domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika"}
+
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, "https://www.wildberries.ru/catalog/elektronika").Return(nil).Once()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, cfg.Server.WbCfg.ItemsSelector).Return([]repository.Element{}, nil).Once()
+
+		res, err := wb.GetCategoryProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		browserRepoMock.AssertExpectations(t)
+		pageMock.AssertExpectations(t)
+	})
+
+	t.Run("category on another site", func(t *testing.T) {
+		query := domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "https://example.com/catalog"}
+
+		_, err := wb.GetCategoryProducts(context.Background(), query)
+		assert.ErrorIs(t, err, repository.ErrInvalidCategory)
+
+		// The page is opened only once by the previous subtest
+		browserRepoMock.AssertNumberOfCalls(t, "NewPage", 1)
+	})
+}
+
+func TestParsers_WildberriesParserSuggestions(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	searchBarMock := &mocks.ElementMock{}
+	firstMock := &mocks.ElementMock{}
+	secondMock := &mocks.ElementMock{}
+	duplicateMock := &mocks.ElementMock{}
+	emptyMock := &mocks.ElementMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL:             "baseurl",
+				CloseButtonSelector: "closebuttonselector",
+				SearchBarSelector:   "searchbarselector",
+				SuggestionsSelector: "suggestionsselector",
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+
+	// Suggestions reuse the session of the default region
+	browserRepoMock.On("NewPage", mock.Anything, repository.PageOptions{
+		Session: &repository.SessionKey{Marketplace: domain.MarketplaceWildberries},
+	}).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(nil).Once()
+	pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+	pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+
+	pageMock.On("Element", mock.Anything, cfg.Server.WbCfg.SearchBarSelector).Return(searchBarMock, nil).Once()
+	pageMock.On("MoveCursorToElement", mock.Anything, cfg.Server.WbCfg.SearchBarSelector).Return(nil).Once()
+	searchBarMock.On("Click", mock.Anything).Return(nil).Once()
+	searchBarMock.On("Input", mock.Anything, "соков").Return(nil).Once()
+
+	pageMock.On("Element", mock.Anything, cfg.Server.WbCfg.SuggestionsSelector).Return(firstMock, nil).Once()
+	pageMock.On("Elements", mock.Anything, cfg.Server.WbCfg.SuggestionsSelector).
+		Return([]repository.Element{firstMock, emptyMock, secondMock, duplicateMock}, nil).Once()
+	firstMock.On("Text", mock.Anything).Return(" соковыжималка ", nil).Once()
+	emptyMock.On("Text", mock.Anything).Return("", nil).Once()
+	secondMock.On("Text", mock.Anything).Return("соковарка", nil).Once()
+	duplicateMock.On("Text", mock.Anything).Return("Соковыжималка", nil).Once()
+
+	res, err := wb.GetSuggestions(context.Background(), "соков")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"соковыжималка", "соковарка"}, res)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+	searchBarMock.AssertExpectations(t)
+}
+
+func TestParsers_WildberriesParserRequestBlocking(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	cfg := &config.Config{
+		Browser: config.BrowserConfig{
+			Blocking: config.RequestBlockingConfig{Enabled: true, ResourceTypes: []string{"image"}},
+		},
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL: "baseurl",
+				Blocking: &config.RequestBlockingConfig{
+					Enabled:       true,
+					ResourceTypes: []string{"image", "font"},
+					URLPatterns:   []string{"*mc.yandex.ru*"},
+				},
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+
+	errNavigate := errors.New("navigate")
+	browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	// The marketplace rules override the browser ones
+	pageMock.On("BlockRequests", mock.Anything, repository.RequestBlocking{
+		ResourceTypes: []string{"image", "font"},
+		URLPatterns:   []string{"*mc.yandex.ru*"},
+	}).Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(errNavigate).Once()
+
+	_, err := wb.GetAllProducts(context.Background(), domain.SearchQuery{Name: "prod"})
+	assert.ErrorIs(t, err, errNavigate)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+}
+
+func TestParsers_WildberriesParserProxy(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	cfg := &config.Config{
+		Browser: config.BrowserConfig{
+			Proxy: config.ProxyConfig{URL: "http://browser-proxy:3128"},
+		},
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL: "baseurl",
+				Proxy: &config.ProxyConfig{
+					URL:      "http://wb-proxy:3128",
+					Username: "user",
+					Password: "secret",
+					Bypass:   []string{"localhost"},
+				},
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+
+	errNavigate := errors.New("navigate")
+	// The marketplace proxy overrides the browser one
+	browserRepoMock.On("NewPage", mock.Anything, repository.PageOptions{
+		Session: &repository.SessionKey{Marketplace: domain.MarketplaceWildberries},
+		Proxy: &repository.Proxy{
+			Server:   "http://wb-proxy:3128",
+			Username: "user",
+			Password: "secret",
+			Bypass:   []string{"localhost"},
+		},
+	}).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(errNavigate).Once()
+
+	_, err := wb.GetAllProducts(context.Background(), domain.SearchQuery{Name: "prod"})
+	assert.ErrorIs(t, err, errNavigate)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+}
+
+func TestParsers_WildberriesParserBlocked(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+	artifactRepoMock := &mocks.ArtifactRepositoryMock{}
+	pageMock := &mocks.PageMock{}
+
+	cfg := &config.Config{
+		Artifacts: config.ArtifactsConfig{CaptureTimeout: time.Second},
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL:             "baseurl",
+				CloseButtonSelector: "closebuttonselector",
+				Block: config.BlockConfig{
+					Markers:    []string{"Почти готово"},
+					RetryAfter: time.Minute,
+				},
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, artifactRepoMock)
+
+	snapshot := repository.Snapshot{URL: "baseurl/captcha", HTML: "<html><h1>ПОЧТИ ГОТОВО...</h1></html>", Screenshot: []byte("png")}
+
+	browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+	pageMock.On("Close").Return(nil).Once()
+	pageMock.On("NavigateWithReferer", mock.Anything, cfg.Server.WbCfg.BaseURL).Return(nil).Once()
+	pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+	pageMock.On("Text", mock.Anything).Return("Проверка\nПОЧТИ ГОТОВО...", nil).Once()
+	pageMock.On("URL", mock.Anything).Return(snapshot.URL, nil).Once()
+	loggerMock.On("Warn", "source blocked", mock.Anything).Once()
+
+	// The block page is captured although capturing on failure is disabled
+	pageMock.On("Snapshot", mock.Anything).Return(snapshot, nil).Once()
+	artifactRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(a repository.Artifact) bool {
+		return a.Marketplace == domain.MarketplaceWildberries && a.Operation == "open page" && a.Snapshot.URL == snapshot.URL && a.Error != ""
+	})).Return("artifact-id", nil).Once()
+	loggerMock.On("Info", "debug artifact saved", mock.Anything).Once()
+
+	_, err := wb.GetAllProducts(context.Background(), domain.SearchQuery{Name: "prod"})
+	assert.ErrorIs(t, err, repository.ErrSourceBlocked)
+
+	var blockedErr *repository.BlockedError
+	assert.ErrorAs(t, err, &blockedErr)
+	assert.Equal(t, "baseurl/captcha", blockedErr.URL)
+	assert.Equal(t, time.Minute, blockedErr.RetryAfter)
+
+	var artifactErr *domain.ArtifactError
+	assert.ErrorAs(t, err, &artifactErr)
+	assert.Equal(t, "artifact-id", artifactErr.ArtifactID)
+
+	browserRepoMock.AssertExpectations(t)
+	pageMock.AssertExpectations(t)
+	artifactRepoMock.AssertExpectations(t)
+	loggerMock.AssertExpectations(t)
+}
+
+func TestParsers_WildberriesParserArtifacts(t *testing.T) {
+	cfg := &config.Config{
+		Artifacts: config.ArtifactsConfig{OnFailure: true, CaptureTimeout: time.Second},
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL:             "https://www.wildberries.ru",
+				CloseButtonSelector: "closebuttonselector",
+				ItemsSelector:       "itemsselector",
+			},
+		},
+	}
+	query := domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika"}
+	snapshot := repository.Snapshot{URL: "https://www.wildberries.ru/catalog/elektronika", HTML: "<html></html>", HARPath: "har/page.har"}
+
+	t.Run("failure", func(t *testing.T) {
+		loggerMock := &mocks.LoggerMock{}
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		artifactRepoMock := &mocks.ArtifactRepositoryMock{}
+		pageMock := &mocks.PageMock{}
+		wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, artifactRepoMock)
+
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, snapshot.URL).Return(nil).Once()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, cfg.Server.WbCfg.ItemsSelector).Return(nil, errors.New("elements")).Once()
+
+		pageMock.On("Snapshot", mock.Anything).Return(snapshot, nil).Once()
+		artifactRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(a repository.Artifact) bool {
+			return a.Operation == "category" && a.Error == "find elements: elements"
+		})).Return("artifact-id", nil).Once()
+		loggerMock.On("Info", "debug artifact saved", mock.Anything).Once()
+
+		_, err := wb.GetCategoryProducts(context.Background(), query)
+		var artifactErr *domain.ArtifactError
+		assert.ErrorAs(t, err, &artifactErr)
+		assert.Equal(t, "artifact-id", artifactErr.ArtifactID)
+		assert.Equal(t, "har/page.har", artifactErr.HARPath)
+		assert.Contains(t, err.Error(), "har/page.har")
+
+		pageMock.AssertExpectations(t)
+		artifactRepoMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("debug success", func(t *testing.T) {
+		loggerMock := &mocks.LoggerMock{}
+		browserRepoMock := &mocks.BrowserRepositoryMock{}
+		artifactRepoMock := &mocks.ArtifactRepositoryMock{}
+		pageMock := &mocks.PageMock{}
+		wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, artifactRepoMock)
+
+		debugQuery := query
+		debugQuery.Debug = true
+
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, snapshot.URL).Return(nil).Once()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, cfg.Server.WbCfg.ItemsSelector).Return([]repository.Element{}, nil).Once()
+
+		pageMock.On("Snapshot", mock.Anything).Return(snapshot, nil).Once()
+		artifactRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(a repository.Artifact) bool {
+			return a.Operation == "category" && a.Error == ""
+		})).Return("artifact-id", nil).Once()
+		loggerMock.On("Info", "debug artifact saved", mock.Anything).Once()
+
+		res, err := wb.GetCategoryProducts(context.Background(), debugQuery)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		pageMock.AssertExpectations(t)
+		artifactRepoMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
 	})
+}
 
+func TestParsers_WildberriesParserProduct(t *testing.T) {
+	loggerMock := &mocks.LoggerMock{}
+	browserRepoMock := &mocks.BrowserRepositoryMock{}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			WbCfg: &config.WbConfig{
+				BaseURL:             "https://www.wildberries.ru",
+				CloseButtonSelector: "closebuttonselector",
+				ProductPage: config.ProductPageConfig{
+					NameSelector:      "nameselector",
+					PriceSelector:     "priceselector",
+					RatingSelector:    "ratingselector",
+					OutOfStockMarkers: []string{"Нет в наличии"},
+					NotFoundMarkers:   []string{"Товар не найден"},
+				},
+			},
+		},
+	}
+
+	wb := parsers.NewWildberriesParser(cfg, loggerMock, browserRepoMock, nil)
+	link := "https://www.wildberries.ru/catalog/123/detail.aspx"
+
+	openPage := func(pageMock *mocks.PageMock, text string) {
+		browserRepoMock.On("NewPage", mock.Anything, mock.Anything).Return(pageMock, nil).Once()
+		pageMock.On("Close").Return(nil).Once()
+		pageMock.On("NavigateWithReferer", mock.Anything, link).Return(nil).Once()
+		pageMock.On("WaitDOMStable", mock.Anything).Return(nil).Once()
+		pageMock.On("ClosePopUpWindow", mock.Anything, cfg.Server.WbCfg.CloseButtonSelector).Return(nil).Once()
+		pageMock.On("Text", mock.Anything).Return(text, nil).Once()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		pageMock := &mocks.PageMock{}
+		nameMock := &mocks.ElementMock{}
+		priceMock := &mocks.ElementMock{}
+
+		openPage(pageMock, "Смартфон")
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, "nameselector").Return([]repository.Element{nameMock}, nil).Once()
+		pageMock.On("Elements", mock.Anything, "priceselector").Return([]repository.Element{priceMock}, nil).Once()
+		pageMock.On("Elements", mock.Anything, "ratingselector").Return([]repository.Element{}, nil).Once()
+		nameMock.On("Text", mock.Anything).Return(" Смартфон ", nil).Once()
+		priceMock.On("Text", mock.Anything).Return("1 990 ₽", nil).Once()
+
+		res, err := wb.GetProduct(context.Background(), domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Product{ID: "123", Name: "Смартфон", Price: 1990.0, InStock: true, Link: link}, res)
+
+		pageMock.AssertExpectations(t)
+		nameMock.AssertExpectations(t)
+		priceMock.AssertExpectations(t)
+	})
+
+	t.Run("out of stock", func(t *testing.T) {
+		pageMock := &mocks.PageMock{}
+		nameMock := &mocks.ElementMock{}
+
+		openPage(pageMock, "Смартфон\nНет в наличии")
+		pageMock.On("SaveSession", mock.Anything).Return(nil).Once()
+		pageMock.On("Elements", mock.Anything, "nameselector").Return([]repository.Element{nameMock}, nil).Once()
+		pageMock.On("Elements", mock.Anything, mock.Anything).Return([]repository.Element{}, nil).Twice()
+		nameMock.On("Text", mock.Anything).Return("Смартфон", nil).Once()
+
+		res, err := wb.GetProduct(context.Background(), domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Product{ID: "123", Name: "Смартфон", Link: link}, res)
+
+		pageMock.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		pageMock := &mocks.PageMock{}
+
+		openPage(pageMock, "товар не найден")
+		pageMock.On("Elements", mock.Anything, "nameselector").Return([]repository.Element{}, nil).Once()
+
+		_, err := wb.GetProduct(context.Background(), domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"})
+		assert.ErrorIs(t, err, repository.ErrProductNotFound)
+
+		pageMock.AssertExpectations(t)
+		pageMock.AssertNotCalled(t, "SaveSession", mock.Anything)
+	})
 }
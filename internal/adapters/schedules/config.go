@@ -0,0 +1,13 @@
+package schedules
+
+import "github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+
+type Config struct {
+	path string
+}
+
+func NewSchedulesConfig(cfg *config.Config) *Config {
+	return &Config{
+		path: cfg.Scheduler.Path,
+	}
+}
@@ -0,0 +1,11 @@
+CREATE TABLE schedules (
+    id          TEXT    PRIMARY KEY,
+    cron        TEXT    NOT NULL,
+    -- JSON of the search query
+    query       TEXT    NOT NULL,
+    from_config INTEGER NOT NULL,
+    -- unix time in milliseconds
+    created_at  INTEGER NOT NULL,
+    -- JSON of the last run
+    last_run    TEXT    NOT NULL
+);
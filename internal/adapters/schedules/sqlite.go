@@ -0,0 +1,152 @@
+package schedules
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sqlite"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteRepository keeps the schedules in an SQLite database file, its schema is migrated on open.
+// The search query and the last run are kept as JSON, the planned next run isn't kept.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// Create a new SQLite schedule repository, the database file and its dir are created if they don't exist.
+func NewSQLiteRepository(cfg *config.Config) (*SQLiteRepository, error) {
+	c := NewSchedulesConfig(cfg)
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("schedules migrations: %w", err)
+	}
+	db, err := sqlite.Open(c.path, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("open schedules database: %w", err)
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+const scheduleColumns = `id, cron, query, from_config, created_at, last_run`
+
+func (r *SQLiteRepository) SaveSchedule(ctx context.Context, schedule domain.Schedule) error {
+	query, err := json.Marshal(schedule.Query)
+	if err != nil {
+		return fmt.Errorf("marshal query: %w", err)
+	}
+	lastRun, err := json.Marshal(schedule.LastRun)
+	if err != nil {
+		return fmt.Errorf("marshal last run: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT OR REPLACE INTO schedules (`+scheduleColumns+`) VALUES (?, ?, ?, ?, ?, ?)`,
+		schedule.ID, schedule.Cron, string(query), schedule.FromConfig, schedule.CreatedAt.UnixMilli(), string(lastRun))
+	if err != nil {
+		return fmt.Errorf("save schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) SaveScheduleRun(ctx context.Context, id string, run domain.ScheduleRun) error {
+	lastRun, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("marshal last run: %w", err)
+	}
+
+	res, err := r.db.ExecContext(ctx, `UPDATE schedules SET last_run = ? WHERE id = ?`, string(lastRun), id)
+	if err != nil {
+		return fmt.Errorf("save schedule run: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrScheduleNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) GetSchedule(ctx context.Context, id string) (domain.Schedule, error) {
+	schedule, err := scanSchedule(r.db.QueryRowContext(ctx, `SELECT `+scheduleColumns+` FROM schedules WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Schedule{}, repository.ErrScheduleNotFound
+	}
+	if err != nil {
+		return domain.Schedule{}, fmt.Errorf("get schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (r *SQLiteRepository) ListSchedules(ctx context.Context) ([]domain.Schedule, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+scheduleColumns+` FROM schedules ORDER BY created_at, id`)
+	if err != nil {
+		return nil, fmt.Errorf("query schedules: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.Schedule{}
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan schedule: %w", err)
+		}
+		res = append(res, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read schedules: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *SQLiteRepository) DeleteSchedule(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM schedules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete schedule: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrScheduleNotFound
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSchedule(row scanner) (domain.Schedule, error) {
+	var s domain.Schedule
+	var query, lastRun string
+	var createdAt int64
+	if err := row.Scan(&s.ID, &s.Cron, &query, &s.FromConfig, &createdAt, &lastRun); err != nil {
+		return domain.Schedule{}, err
+	}
+	if err := json.Unmarshal([]byte(query), &s.Query); err != nil {
+		return domain.Schedule{}, fmt.Errorf("unmarshal query: %w", err)
+	}
+	if err := json.Unmarshal([]byte(lastRun), &s.LastRun); err != nil {
+		return domain.Schedule{}, fmt.Errorf("unmarshal last run: %w", err)
+	}
+	s.CreatedAt = time.UnixMilli(createdAt).UTC()
+
+	return s, nil
+}
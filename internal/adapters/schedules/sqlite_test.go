@@ -0,0 +1,70 @@
+package schedules_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/schedules"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func TestSchedules_SQLiteRepository(t *testing.T) {
+	repo, err := schedules.NewSQLiteRepository(&config.Config{Scheduler: config.SchedulerConfig{Path: filepath.Join(t.TempDir(), "scheduler.db")}})
+	assert.NoError(t, err)
+	defer repo.Close()
+
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err = repo.GetSchedule(context.Background(), "phones")
+	assert.ErrorIs(t, err, repository.ErrScheduleNotFound)
+
+	fromConfig := domain.Schedule{
+		ID:         "phones",
+		Cron:       "0 */6 * * *",
+		Query:      domain.SearchQuery{Name: "iphone", PriceTo: 90000.0, Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}},
+		FromConfig: true,
+		CreatedAt:  createdAt.Add(time.Minute),
+	}
+	fromAPI := domain.Schedule{
+		ID:        "id",
+		Cron:      "@hourly",
+		Query:     domain.SearchQuery{Name: "prod", InStockOnly: true},
+		CreatedAt: createdAt,
+	}
+	assert.NoError(t, repo.SaveSchedule(context.Background(), fromConfig))
+	assert.NoError(t, repo.SaveSchedule(context.Background(), fromAPI))
+
+	res, err := repo.GetSchedule(context.Background(), "phones")
+	assert.NoError(t, err)
+	assert.Equal(t, fromConfig, res)
+
+	list, err := repo.ListSchedules(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Schedule{fromAPI, fromConfig}, list)
+
+	run := domain.ScheduleRun{
+		Status:     domain.JobFailed,
+		StartedAt:  createdAt.Add(time.Hour),
+		FinishedAt: createdAt.Add(time.Hour + time.Minute),
+		Products:   3,
+		TimedOut:   []domain.Marketplace{domain.MarketplaceWildberries},
+		Error:      "store result: disk full",
+	}
+	assert.NoError(t, repo.SaveScheduleRun(context.Background(), "id", run))
+	res, err = repo.GetSchedule(context.Background(), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, run, res.LastRun)
+	assert.Equal(t, fromAPI.Query, res.Query)
+
+	assert.ErrorIs(t, repo.SaveScheduleRun(context.Background(), "unknown", run), repository.ErrScheduleNotFound)
+
+	assert.NoError(t, repo.DeleteSchedule(context.Background(), "id"))
+	_, err = repo.GetSchedule(context.Background(), "id")
+	assert.ErrorIs(t, err, repository.ErrScheduleNotFound)
+	assert.ErrorIs(t, repo.DeleteSchedule(context.Background(), "id"), repository.ErrScheduleNotFound)
+}
@@ -0,0 +1,21 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+)
+
+type Config struct {
+	dir string
+	key string
+	ttl time.Duration
+}
+
+func NewSessionsConfig(cfg *config.Config) *Config {
+	return &Config{
+		dir: cfg.Sessions.Dir,
+		key: cfg.Sessions.Key,
+		ttl: cfg.Sessions.TTL,
+	}
+}
@@ -0,0 +1,219 @@
+package sessions
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+const sessionExt = ".session"
+
+// FileSystemRepository keeps every session in its own file encrypted with AES-GCM.
+// The file is named by the marketplace and the hash of the region, so the sessions
+// of a marketplace can be removed without decrypting them.
+type FileSystemRepository struct {
+	cfg  *Config
+	aead cipher.AEAD
+
+	mu sync.Mutex
+	// generation is advanced by the deletes of all sessions, generations by the deletes of a marketplace sessions.
+	generation  uint64
+	generations map[domain.Marketplace]uint64
+}
+
+// Create a new file system session repository, the encryption key is derived from the configured secret.
+func NewFileSystemRepository(cfg *config.Config) (*FileSystemRepository, error) {
+	sessionsCfg := NewSessionsConfig(cfg)
+
+	key := sha256.Sum256([]byte(sessionsCfg.key))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("new cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("new gcm: %w", err)
+	}
+
+	return &FileSystemRepository{
+		cfg:         sessionsCfg,
+		aead:        aead,
+		generations: make(map[domain.Marketplace]uint64),
+	}, nil
+}
+
+// Load decrypts the session file. An expired or undecryptable file is removed and reported as not found.
+func (r *FileSystemRepository) Load(ctx context.Context, key repository.SessionKey) (repository.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := fileName(key)
+	path := filepath.Join(r.cfg.dir, name)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return repository.Session{}, repository.ErrSessionNotFound
+		}
+		return repository.Session{}, fmt.Errorf("read session: %w", err)
+	}
+
+	var session repository.Session
+	if err := r.decrypt(name, data, &session); err != nil {
+		// The key may be changed, the session is useless then
+		_ = os.Remove(path)
+		return repository.Session{}, fmt.Errorf("%w: %w", repository.ErrSessionNotFound, err)
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		_ = os.Remove(path)
+		return repository.Session{}, repository.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+// Save encrypts the session and replaces its file atomically.
+// The session captured before the last Delete of its marketplace is dropped, so the pages opened before
+// a reset don't bring the removed session back.
+func (r *FileSystemRepository) Save(ctx context.Context, key repository.SessionKey, session repository.Session) error {
+	if session.ExpiresAt.IsZero() {
+		session.ExpiresAt = time.Now().Add(r.cfg.ttl)
+	}
+
+	name := fileName(key)
+	data, err := r.encrypt(name, session)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if session.Generation != r.currentGeneration(key.Marketplace) {
+		return nil
+	}
+
+	if err := os.MkdirAll(r.cfg.dir, 0o700); err != nil {
+		return fmt.Errorf("create sessions dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(r.cfg.dir, name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create session file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write session: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close session file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(r.cfg.dir, name)); err != nil {
+		return fmt.Errorf("rename session file: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the session files of the marketplace, all session files if the marketplace is empty.
+func (r *FileSystemRepository) Delete(ctx context.Context, marketplace domain.Marketplace) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if marketplace == "" {
+		r.generation++
+	} else {
+		r.generations[marketplace]++
+	}
+
+	entries, err := os.ReadDir(r.cfg.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read sessions dir: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), sessionExt) {
+			continue
+		}
+		if marketplace != "" && !strings.HasPrefix(e.Name(), string(marketplace)+"-") {
+			continue
+		}
+		if err := os.Remove(filepath.Join(r.cfg.dir, e.Name())); err != nil {
+			return fmt.Errorf("remove session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Generation returns the current generation of the marketplace sessions, it's advanced by every Delete of them.
+func (r *FileSystemRepository) Generation(ctx context.Context, marketplace domain.Marketplace) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.currentGeneration(marketplace)
+}
+
+func (r *FileSystemRepository) currentGeneration(marketplace domain.Marketplace) uint64 {
+	return r.generation + r.generations[marketplace]
+}
+
+// encrypt seals the JSON of the session, the file name is authenticated so a file can't be swapped with another one.
+func (r *FileSystemRepository) encrypt(name string, session repository.Session) ([]byte, error) {
+	plain, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("marshal session: %w", err)
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("nonce: %w", err)
+	}
+
+	return r.aead.Seal(nonce, nonce, plain, []byte(name)), nil
+}
+
+func (r *FileSystemRepository) decrypt(name string, data []byte, session *repository.Session) error {
+	if len(data) < r.aead.NonceSize() {
+		return errors.New("decrypt session: too short")
+	}
+
+	nonce, sealed := data[:r.aead.NonceSize()], data[r.aead.NonceSize():]
+	plain, err := r.aead.Open(nil, nonce, sealed, []byte(name))
+	if err != nil {
+		return fmt.Errorf("decrypt session: %w", err)
+	}
+
+	if err := json.Unmarshal(plain, session); err != nil {
+		return fmt.Errorf("unmarshal session: %w", err)
+	}
+
+	return nil
+}
+
+func fileName(key repository.SessionKey) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(key.Region))))
+	return fmt.Sprintf("%s-%s%s", key.Marketplace, hex.EncodeToString(sum[:8]), sessionExt)
+}
@@ -0,0 +1,134 @@
+package sessions_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sessions"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func newRepository(t *testing.T, dir string, key string) *sessions.FileSystemRepository {
+	t.Helper()
+
+	repo, err := sessions.NewFileSystemRepository(&config.Config{
+		Sessions: config.SessionsConfig{Dir: dir, Key: key, TTL: time.Hour},
+	})
+	assert.NoError(t, err)
+
+	return repo
+}
+
+func TestSessions_FileSystemRepositorySaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	repo := newRepository(t, dir, "secret")
+	key := repository.SessionKey{Marketplace: domain.MarketplaceWildberries, Region: "Москва"}
+
+	_, err := repo.Load(context.Background(), key)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+
+	session := repository.Session{
+		Cookies:      []repository.Cookie{{Name: "x_wbaas_token", Value: "token", Domain: ".wildberries.ru", Path: "/"}},
+		LocalStorage: map[string]string{"region": "77"},
+	}
+	assert.NoError(t, repo.Save(context.Background(), key, session))
+
+	// The region is case-insensitive
+	res, err := repo.Load(context.Background(), repository.SessionKey{Marketplace: domain.MarketplaceWildberries, Region: "москва"})
+	assert.NoError(t, err)
+	assert.Equal(t, session.Cookies, res.Cookies)
+	assert.Equal(t, session.LocalStorage, res.LocalStorage)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), res.ExpiresAt, time.Minute)
+
+	// The file is encrypted
+	files, err := filepath.Glob(filepath.Join(dir, "wildberries-*.session"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "x_wbaas_token")
+
+	_, err = repo.Load(context.Background(), repository.SessionKey{Marketplace: domain.MarketplaceWildberries})
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+}
+
+func TestSessions_FileSystemRepositoryExpired(t *testing.T) {
+	repo := newRepository(t, t.TempDir(), "secret")
+	key := repository.SessionKey{Marketplace: domain.MarketplaceOzon}
+
+	assert.NoError(t, repo.Save(context.Background(), key, repository.Session{ExpiresAt: time.Now().Add(-time.Minute)}))
+
+	_, err := repo.Load(context.Background(), key)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+}
+
+func TestSessions_FileSystemRepositoryWrongKey(t *testing.T) {
+	dir := t.TempDir()
+	key := repository.SessionKey{Marketplace: domain.MarketplaceOzon}
+
+	assert.NoError(t, newRepository(t, dir, "secret").Save(context.Background(), key, repository.Session{}))
+
+	_, err := newRepository(t, dir, "another secret").Load(context.Background(), key)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+
+	// The undecryptable session is removed
+	_, err = newRepository(t, dir, "secret").Load(context.Background(), key)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+}
+
+func TestSessions_FileSystemRepositoryDelete(t *testing.T) {
+	repo := newRepository(t, t.TempDir(), "secret")
+	wbKey := repository.SessionKey{Marketplace: domain.MarketplaceWildberries}
+	ozonKey := repository.SessionKey{Marketplace: domain.MarketplaceOzon}
+
+	assert.NoError(t, repo.Save(context.Background(), wbKey, repository.Session{}))
+	assert.NoError(t, repo.Save(context.Background(), ozonKey, repository.Session{}))
+
+	assert.NoError(t, repo.Delete(context.Background(), domain.MarketplaceWildberries))
+	_, err := repo.Load(context.Background(), wbKey)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+	_, err = repo.Load(context.Background(), ozonKey)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.Delete(context.Background(), ""))
+	_, err = repo.Load(context.Background(), ozonKey)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+}
+
+func TestSessions_FileSystemRepositoryReset(t *testing.T) {
+	repo := newRepository(t, t.TempDir(), "secret")
+	wbKey := repository.SessionKey{Marketplace: domain.MarketplaceWildberries}
+	ozonKey := repository.SessionKey{Marketplace: domain.MarketplaceOzon}
+
+	// The pages are opened before the reset
+	wbGeneration := repo.Generation(context.Background(), domain.MarketplaceWildberries)
+	ozonGeneration := repo.Generation(context.Background(), domain.MarketplaceOzon)
+
+	assert.NoError(t, repo.Delete(context.Background(), domain.MarketplaceWildberries))
+
+	// The session of the reset marketplace is dropped, the other one is saved
+	assert.NoError(t, repo.Save(context.Background(), wbKey, repository.Session{Generation: wbGeneration}))
+	_, err := repo.Load(context.Background(), wbKey)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+	assert.NoError(t, repo.Save(context.Background(), ozonKey, repository.Session{Generation: ozonGeneration}))
+	_, err = repo.Load(context.Background(), ozonKey)
+	assert.NoError(t, err)
+
+	// The page opened after the reset saves its session
+	wbGeneration = repo.Generation(context.Background(), domain.MarketplaceWildberries)
+	assert.NoError(t, repo.Save(context.Background(), wbKey, repository.Session{Generation: wbGeneration}))
+	_, err = repo.Load(context.Background(), wbKey)
+	assert.NoError(t, err)
+
+	// Resetting all sessions advances the generation of every marketplace
+	assert.NoError(t, repo.Delete(context.Background(), ""))
+	assert.NoError(t, repo.Save(context.Background(), ozonKey, repository.Session{Generation: ozonGeneration}))
+	_, err = repo.Load(context.Background(), ozonKey)
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+}
@@ -0,0 +1,15 @@
+package sinks
+
+import "github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+
+type Config struct {
+	filePath     string
+	databasePath string
+}
+
+func NewSinksConfig(cfg *config.Config) *Config {
+	return &Config{
+		filePath:     cfg.Scheduler.Sink.FilePath,
+		databasePath: cfg.Scheduler.Sink.DatabasePath,
+	}
+}
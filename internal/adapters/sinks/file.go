@@ -0,0 +1,95 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+// FileSink appends every result to a JSON Lines file, a line per result.
+type FileSink struct {
+	path string
+	// mu serializes the appends, so the lines of the parallel runs don't interleave.
+	mu sync.Mutex
+}
+
+// Create a new file sink, the dir of the file is created if it doesn't exist.
+func NewFileSink(cfg *config.Config) (*FileSink, error) {
+	c := NewSinksConfig(cfg)
+	if err := os.MkdirAll(filepath.Dir(c.filePath), 0o755); err != nil {
+		return nil, fmt.Errorf("create results dir: %w", err)
+	}
+
+	return &FileSink{path: c.filePath}, nil
+}
+
+type resultRecord struct {
+	ScheduleID   string          `json:"scheduleId"`
+	Query        string          `json:"query"`
+	Marketplaces []string        `json:"marketplaces,omitempty"`
+	StartedAt    time.Time       `json:"startedAt"`
+	Products     []productRecord `json:"products"`
+	TimedOut     []string        `json:"timedOut,omitempty"`
+}
+
+type productRecord struct {
+	ID           string  `json:"id,omitempty"`
+	Name         string  `json:"name"`
+	Link         string  `json:"link"`
+	Price        float64 `json:"price"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviewsCount"`
+	InStock      bool    `json:"inStock"`
+}
+
+func (s *FileSink) Store(ctx context.Context, result domain.ScheduledResult) error {
+	record := resultRecord{
+		ScheduleID: result.ScheduleID,
+		Query:      result.Query.Name,
+		StartedAt:  result.StartedAt,
+		Products:   make([]productRecord, 0, len(result.Products)),
+	}
+	for _, m := range result.Query.Marketplaces {
+		record.Marketplaces = append(record.Marketplaces, string(m))
+	}
+	for _, m := range result.TimedOut {
+		record.TimedOut = append(record.TimedOut, string(m))
+	}
+	for _, p := range result.Products {
+		record.Products = append(record.Products, productRecord{
+			ID:           p.ID,
+			Name:         p.Name,
+			Link:         p.Link,
+			Price:        p.Price,
+			Rating:       p.Rating,
+			ReviewsCount: p.ReviewsCount,
+			InStock:      p.InStock,
+		})
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal result: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open results file: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("write result: %w", err)
+	}
+
+	return f.Close()
+}
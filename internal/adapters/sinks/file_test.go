@@ -0,0 +1,64 @@
+package sinks_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sinks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+func TestSinks_FileSinkStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scheduler", "results.jsonl")
+	sink, err := sinks.NewFileSink(&config.Config{Scheduler: config.SchedulerConfig{Sink: config.SinkConfig{FilePath: path}}})
+	assert.NoError(t, err)
+
+	startedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	result := domain.ScheduledResult{
+		ScheduleID: "phones",
+		Query:      domain.SearchQuery{Name: "iphone", Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}},
+		StartedAt:  startedAt,
+		Products:   []domain.Product{{ID: "1", Name: "iphone", Link: "link", Price: 90000.0, Rating: 4.8, ReviewsCount: 10, InStock: true}},
+		TimedOut:   []domain.Marketplace{domain.MarketplaceWildberries},
+	}
+	assert.NoError(t, sink.Store(context.Background(), result))
+	assert.NoError(t, sink.Store(context.Background(), domain.ScheduledResult{ScheduleID: "empty", Query: domain.SearchQuery{Name: "prod"}, StartedAt: startedAt}))
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]any
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	assert.Len(t, lines, 2)
+
+	assert.Equal(t, "phones", lines[0]["scheduleId"])
+	assert.Equal(t, "iphone", lines[0]["query"])
+	assert.Equal(t, []any{"ozon"}, lines[0]["marketplaces"])
+	assert.Equal(t, "2025-03-01T12:00:00Z", lines[0]["startedAt"])
+	assert.Equal(t, []any{"wildberries"}, lines[0]["timedOut"])
+	assert.Equal(t, []any{map[string]any{
+		"id":           "1",
+		"name":         "iphone",
+		"link":         "link",
+		"price":        90000.0,
+		"rating":       4.8,
+		"reviewsCount": 10.0,
+		"inStock":      true,
+	}}, lines[0]["products"])
+
+	assert.Equal(t, "empty", lines[1]["scheduleId"])
+	assert.Equal(t, []any{}, lines[1]["products"])
+}
@@ -0,0 +1,36 @@
+package sinks
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// LogSink logs a summary of every result: the number of the found products and the lowest price.
+type LogSink struct {
+	logger logger.Logger
+}
+
+func NewLogSink(logger logger.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Store(ctx context.Context, result domain.ScheduledResult) error {
+	var minPrice float64
+	for _, p := range result.Products {
+		if p.Price > 0 && (minPrice == 0 || p.Price < minPrice) {
+			minPrice = p.Price
+		}
+	}
+
+	s.logger.Info("scheduled search result",
+		"schedule_id", result.ScheduleID,
+		"query", result.Query.Name,
+		"products", len(result.Products),
+		"min_price", minPrice,
+		"timed_out", result.TimedOut,
+	)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+CREATE TABLE runs (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    schedule_id TEXT    NOT NULL,
+    -- JSON of the search query
+    query       TEXT    NOT NULL,
+    -- unix time in milliseconds
+    started_at  INTEGER NOT NULL,
+    -- comma-separated marketplaces that exceeded their time budget
+    timed_out   TEXT    NOT NULL
+);
+
+CREATE INDEX runs_schedule ON runs (schedule_id, started_at);
+
+CREATE TABLE products (
+    run_id        INTEGER NOT NULL REFERENCES runs (id),
+    product_id    TEXT    NOT NULL,
+    name          TEXT    NOT NULL,
+    link          TEXT    NOT NULL,
+    price         REAL    NOT NULL,
+    rating        REAL    NOT NULL,
+    reviews_count INTEGER NOT NULL,
+    in_stock      INTEGER NOT NULL
+);
+
+CREATE INDEX products_run ON products (run_id);
@@ -0,0 +1,112 @@
+package sinks
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sqlite"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteSink inserts every result to an SQLite database file as a run and its found products,
+// its schema is migrated on open.
+type SQLiteSink struct {
+	db *sql.DB
+}
+
+// Create a new SQLite sink, the database file and its dir are created if they don't exist.
+func NewSQLiteSink(cfg *config.Config) (*SQLiteSink, error) {
+	c := NewSinksConfig(cfg)
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("results migrations: %w", err)
+	}
+	db, err := sqlite.Open(c.databasePath, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("open results database: %w", err)
+	}
+
+	return &SQLiteSink{db: db}, nil
+}
+
+func (s *SQLiteSink) Close() error {
+	return s.db.Close()
+}
+
+// queryRecord is the search query of a run stored as json, the options that don't change the result are left out.
+type queryRecord struct {
+	Name         string   `json:"name"`
+	PriceFrom    float64  `json:"priceFrom,omitempty"`
+	PriceTo      float64  `json:"priceTo,omitempty"`
+	InStockOnly  bool     `json:"inStockOnly,omitempty"`
+	Region       string   `json:"region,omitempty"`
+	Marketplaces []string `json:"marketplaces,omitempty"`
+}
+
+// Store inserts the run and its products in a single transaction.
+func (s *SQLiteSink) Store(ctx context.Context, result domain.ScheduledResult) error {
+	record := queryRecord{
+		Name:        result.Query.Name,
+		PriceFrom:   result.Query.PriceFrom,
+		PriceTo:     result.Query.PriceTo,
+		InStockOnly: result.Query.InStockOnly,
+		Region:      result.Query.Region,
+	}
+	for _, m := range result.Query.Marketplaces {
+		record.Marketplaces = append(record.Marketplaces, string(m))
+	}
+	query, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal query: %w", err)
+	}
+	timedOut := make([]string, 0, len(result.TimedOut))
+	for _, m := range result.TimedOut {
+		timedOut = append(timedOut, string(m))
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO runs (schedule_id, query, started_at, timed_out) VALUES (?, ?, ?, ?)`,
+		result.ScheduleID, string(query), result.StartedAt.UnixMilli(), strings.Join(timedOut, ","))
+	if err != nil {
+		return fmt.Errorf("insert run: %w", err)
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("run id: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO products
+		(run_id, product_id, name, link, price, rating, reviews_count, in_stock)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, p := range result.Products {
+		if _, err := stmt.ExecContext(ctx, runID, p.ID, p.Name, p.Link, p.Price, p.Rating, p.ReviewsCount, p.InStock); err != nil {
+			return fmt.Errorf("insert product: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package sinks_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sinks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+func TestSinks_SQLiteSinkStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.db")
+	sink, err := sinks.NewSQLiteSink(&config.Config{Scheduler: config.SchedulerConfig{Sink: config.SinkConfig{DatabasePath: path}}})
+	assert.NoError(t, err)
+
+	startedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	err = sink.Store(context.Background(), domain.ScheduledResult{
+		ScheduleID: "phones",
+		Query:      domain.SearchQuery{Name: "iphone", PriceTo: 100000.0, NoCache: true, Marketplaces: []domain.Marketplace{domain.MarketplaceWildberries}},
+		StartedAt:  startedAt,
+		Products: []domain.Product{
+			{ID: "1", Name: "iphone", Link: "link1", Price: 90000.0, Rating: 4.8, ReviewsCount: 10, InStock: true},
+			{ID: "2", Name: "iphone 2", Link: "link2", Price: 80000.0},
+		},
+		TimedOut: []domain.Marketplace{domain.MarketplaceWildberries, domain.MarketplaceOzon},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, sink.Close())
+
+	db, err := sql.Open("sqlite", path)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	var scheduleID, query, timedOut string
+	var runAt int64
+	err = db.QueryRow(`SELECT schedule_id, query, started_at, timed_out FROM runs`).Scan(&scheduleID, &query, &runAt, &timedOut)
+	assert.NoError(t, err)
+	assert.Equal(t, "phones", scheduleID)
+	assert.JSONEq(t, `{"name":"iphone","priceTo":100000,"marketplaces":["wildberries"]}`, query)
+	assert.Equal(t, startedAt.UnixMilli(), runAt)
+	assert.Equal(t, "wildberries,ozon", timedOut)
+
+	rows, err := db.Query(`SELECT product_id, price, in_stock FROM products ORDER BY product_id`)
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	type product struct {
+		id      string
+		price   float64
+		inStock bool
+	}
+	var products []product
+	for rows.Next() {
+		var p product
+		assert.NoError(t, rows.Scan(&p.id, &p.price, &p.inStock))
+		products = append(products, p)
+	}
+	assert.Equal(t, []product{{"1", 90000.0, true}, {"2", 80000.0, false}}, products)
+}
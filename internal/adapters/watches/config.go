@@ -0,0 +1,22 @@
+package watches
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+)
+
+type Config struct {
+	path           string
+	webhookTimeout time.Duration
+	// allowPrivateNetworks allows the webhooks to connect to the non-public addresses.
+	allowPrivateNetworks bool
+}
+
+func NewWatchesConfig(cfg *config.Config) *Config {
+	return &Config{
+		path:                 cfg.Watches.Path,
+		webhookTimeout:       cfg.Watches.Webhook.Timeout,
+		allowPrivateNetworks: cfg.Watches.Webhook.AllowPrivateNetworks,
+	}
+}
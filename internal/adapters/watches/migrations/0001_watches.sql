@@ -0,0 +1,35 @@
+CREATE TABLE watches (
+    id           TEXT    PRIMARY KEY,
+    -- empty for a query watch
+    marketplace  TEXT    NOT NULL,
+    product_id   TEXT    NOT NULL,
+    -- JSON of the search query
+    query        TEXT    NOT NULL,
+    target_price REAL    NOT NULL,
+    notify_stock INTEGER NOT NULL,
+    webhook_url  TEXT    NOT NULL,
+    secret       TEXT    NOT NULL,
+    interval_ms  INTEGER NOT NULL,
+    -- unix time in milliseconds
+    created_at   INTEGER NOT NULL,
+    -- JSON of the result of the last check
+    state        TEXT    NOT NULL
+);
+
+CREATE TABLE deliveries (
+    id              TEXT    PRIMARY KEY,
+    watch_id        TEXT    NOT NULL,
+    -- JSON of the webhook payload
+    notification    TEXT    NOT NULL,
+    status          TEXT    NOT NULL,
+    attempts        INTEGER NOT NULL,
+    response_status INTEGER NOT NULL,
+    error           TEXT    NOT NULL,
+    -- unix time in milliseconds, 0 for none
+    created_at      INTEGER NOT NULL,
+    next_attempt_at INTEGER NOT NULL,
+    delivered_at    INTEGER NOT NULL
+);
+
+CREATE INDEX deliveries_watch ON deliveries (watch_id, created_at);
+CREATE INDEX deliveries_pending ON deliveries (status, next_attempt_at);
@@ -0,0 +1,252 @@
+package watches
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/sqlite"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLiteRepository keeps the watches and their deliveries in an SQLite database file, its schema is migrated on open.
+// The search query, the state and the notification are kept as JSON.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// Create a new SQLite watch repository, the database file and its dir are created if they don't exist.
+func NewSQLiteRepository(cfg *config.Config) (*SQLiteRepository, error) {
+	c := NewWatchesConfig(cfg)
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("watches migrations: %w", err)
+	}
+	db, err := sqlite.Open(c.path, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("open watches database: %w", err)
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+const watchColumns = `id, marketplace, product_id, query, target_price, notify_stock, webhook_url, secret, interval_ms, created_at, state`
+
+func (r *SQLiteRepository) SaveWatch(ctx context.Context, watch domain.Watch) error {
+	query, err := json.Marshal(watch.Query)
+	if err != nil {
+		return fmt.Errorf("marshal query: %w", err)
+	}
+	state, err := json.Marshal(watch.State)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT OR REPLACE INTO watches (`+watchColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		watch.ID, string(watch.Marketplace), watch.ProductID, string(query), watch.TargetPrice, watch.NotifyStock,
+		watch.WebhookURL, watch.Secret, watch.Interval.Milliseconds(), unixMilli(watch.CreatedAt), string(state))
+	if err != nil {
+		return fmt.Errorf("save watch: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) SaveWatchState(ctx context.Context, id string, state domain.WatchState) error {
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	res, err := r.db.ExecContext(ctx, `UPDATE watches SET state = ? WHERE id = ?`, string(stateJSON), id)
+	if err != nil {
+		return fmt.Errorf("save watch state: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrWatchNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) GetWatch(ctx context.Context, id string) (domain.Watch, error) {
+	watch, err := scanWatch(r.db.QueryRowContext(ctx, `SELECT `+watchColumns+` FROM watches WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Watch{}, repository.ErrWatchNotFound
+	}
+	if err != nil {
+		return domain.Watch{}, fmt.Errorf("get watch: %w", err)
+	}
+
+	return watch, nil
+}
+
+func (r *SQLiteRepository) ListWatches(ctx context.Context) ([]domain.Watch, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+watchColumns+` FROM watches ORDER BY created_at, id`)
+	if err != nil {
+		return nil, fmt.Errorf("query watches: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.Watch{}
+	for rows.Next() {
+		watch, err := scanWatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan watch: %w", err)
+		}
+		res = append(res, watch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read watches: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *SQLiteRepository) DeleteWatch(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM watches WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete watch: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrWatchNotFound
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM deliveries WHERE watch_id = ?`, id); err != nil {
+		return fmt.Errorf("delete deliveries: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+const deliveryColumns = `id, watch_id, notification, status, attempts, response_status, error, created_at, next_attempt_at, delivered_at`
+
+func (r *SQLiteRepository) SaveDelivery(ctx context.Context, delivery domain.Delivery) error {
+	notification, err := json.Marshal(delivery.Notification)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT OR REPLACE INTO deliveries (`+deliveryColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		delivery.ID, delivery.WatchID, string(notification), string(delivery.Status), delivery.Attempts, delivery.ResponseStatus,
+		delivery.Error, unixMilli(delivery.CreatedAt), unixMilli(delivery.NextAttemptAt), unixMilli(delivery.DeliveredAt))
+	if err != nil {
+		return fmt.Errorf("save delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) ListDeliveries(ctx context.Context, watchID string, limit int) ([]domain.Delivery, error) {
+	return r.queryDeliveries(ctx, `SELECT `+deliveryColumns+` FROM deliveries
+		WHERE watch_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?`, watchID, limit)
+}
+
+func (r *SQLiteRepository) PendingDeliveries(ctx context.Context, due time.Time, limit int) ([]domain.Delivery, error) {
+	return r.queryDeliveries(ctx, `SELECT `+deliveryColumns+` FROM deliveries
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY next_attempt_at, id
+		LIMIT ?`, string(domain.DeliveryPending), due.UnixMilli(), limit)
+}
+
+func (r *SQLiteRepository) queryDeliveries(ctx context.Context, query string, args ...any) ([]domain.Delivery, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	res := []domain.Delivery{}
+	for rows.Next() {
+		var d domain.Delivery
+		var notification, status string
+		var createdAt, nextAttemptAt, deliveredAt int64
+		err := rows.Scan(&d.ID, &d.WatchID, &notification, &status, &d.Attempts, &d.ResponseStatus, &d.Error,
+			&createdAt, &nextAttemptAt, &deliveredAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan delivery: %w", err)
+		}
+		if err := json.Unmarshal([]byte(notification), &d.Notification); err != nil {
+			return nil, fmt.Errorf("unmarshal notification: %w", err)
+		}
+		d.Status = domain.DeliveryStatus(status)
+		d.CreatedAt = fromUnixMilli(createdAt)
+		d.NextAttemptAt = fromUnixMilli(nextAttemptAt)
+		d.DeliveredAt = fromUnixMilli(deliveredAt)
+		res = append(res, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read deliveries: %w", err)
+	}
+
+	return res, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWatch(row scanner) (domain.Watch, error) {
+	var w domain.Watch
+	var marketplace, query, state string
+	var intervalMs, createdAt int64
+	err := row.Scan(&w.ID, &marketplace, &w.ProductID, &query, &w.TargetPrice, &w.NotifyStock, &w.WebhookURL, &w.Secret,
+		&intervalMs, &createdAt, &state)
+	if err != nil {
+		return domain.Watch{}, err
+	}
+	if err := json.Unmarshal([]byte(query), &w.Query); err != nil {
+		return domain.Watch{}, fmt.Errorf("unmarshal query: %w", err)
+	}
+	if err := json.Unmarshal([]byte(state), &w.State); err != nil {
+		return domain.Watch{}, fmt.Errorf("unmarshal state: %w", err)
+	}
+	w.Marketplace = domain.Marketplace(marketplace)
+	w.Interval = time.Duration(intervalMs) * time.Millisecond
+	w.CreatedAt = fromUnixMilli(createdAt)
+
+	return w, nil
+}
+
+// unixMilli returns 0 for the zero time, so the unset times are kept as 0.
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixMilli()
+}
+
+func fromUnixMilli(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms).UTC()
+}
@@ -0,0 +1,142 @@
+package watches_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/watches"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+func newSQLiteRepository(t *testing.T) *watches.SQLiteRepository {
+	repo, err := watches.NewSQLiteRepository(&config.Config{Watches: config.WatchesConfig{Path: filepath.Join(t.TempDir(), "watches.db")}})
+	assert.NoError(t, err)
+	t.Cleanup(func() { repo.Close() })
+
+	return repo
+}
+
+func TestWatches_SQLiteRepositoryWatches(t *testing.T) {
+	repo := newSQLiteRepository(t)
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := repo.GetWatch(context.Background(), "id1")
+	assert.ErrorIs(t, err, repository.ErrWatchNotFound)
+
+	productWatch := domain.Watch{
+		ID:          "id1",
+		Marketplace: domain.MarketplaceWildberries,
+		ProductID:   "123",
+		Query:       domain.SearchQuery{Name: "prod", Marketplaces: []domain.Marketplace{domain.MarketplaceWildberries}},
+		TargetPrice: 100.0,
+		NotifyStock: true,
+		WebhookURL:  "https://example.com/hook",
+		Secret:      "secret",
+		Interval:    time.Hour,
+		CreatedAt:   createdAt,
+	}
+	queryWatch := domain.Watch{
+		ID:          "id2",
+		Query:       domain.SearchQuery{Name: "prod", PriceTo: 500.0},
+		TargetPrice: 200.0,
+		WebhookURL:  "https://example.com/hook",
+		Secret:      "secret2",
+		Interval:    30 * time.Minute,
+		CreatedAt:   createdAt.Add(time.Minute),
+	}
+	assert.NoError(t, repo.SaveWatch(context.Background(), queryWatch))
+	assert.NoError(t, repo.SaveWatch(context.Background(), productWatch))
+
+	res, err := repo.GetWatch(context.Background(), "id1")
+	assert.NoError(t, err)
+	assert.Equal(t, productWatch, res)
+
+	list, err := repo.ListWatches(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Watch{productWatch, queryWatch}, list)
+
+	state := domain.WatchState{
+		CheckedAt:   createdAt.Add(time.Hour),
+		Found:       true,
+		Product:     domain.Product{ID: "123", Name: "prod", Link: "link", Price: 90.0, InStock: true},
+		BelowTarget: true,
+	}
+	assert.NoError(t, repo.SaveWatchState(context.Background(), "id1", state))
+	res, err = repo.GetWatch(context.Background(), "id1")
+	assert.NoError(t, err)
+	assert.Equal(t, state, res.State)
+	assert.Equal(t, productWatch.TargetPrice, res.TargetPrice)
+
+	assert.ErrorIs(t, repo.SaveWatchState(context.Background(), "unknown", state), repository.ErrWatchNotFound)
+
+	assert.NoError(t, repo.DeleteWatch(context.Background(), "id1"))
+	_, err = repo.GetWatch(context.Background(), "id1")
+	assert.ErrorIs(t, err, repository.ErrWatchNotFound)
+	assert.ErrorIs(t, repo.DeleteWatch(context.Background(), "id1"), repository.ErrWatchNotFound)
+}
+
+func TestWatches_SQLiteRepositoryDeliveries(t *testing.T) {
+	repo := newSQLiteRepository(t)
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, repo.SaveWatch(context.Background(), domain.Watch{ID: "watch", WebhookURL: "https://example.com/hook", CreatedAt: now}))
+
+	notification := domain.WatchNotification{
+		WatchID:     "watch",
+		Event:       domain.WatchPriceDrop,
+		Marketplace: domain.MarketplaceOzon,
+		Product:     domain.Product{ID: "1", Name: "prod", Price: 90.0},
+		TargetPrice: 100.0,
+		OccurredAt:  now,
+	}
+	delivered := domain.Delivery{
+		ID:             "d1",
+		WatchID:        "watch",
+		Notification:   notification,
+		Status:         domain.DeliveryDelivered,
+		Attempts:       1,
+		ResponseStatus: 200,
+		CreatedAt:      now,
+		DeliveredAt:    now.Add(time.Second),
+	}
+	pending := domain.Delivery{
+		ID:             "d2",
+		WatchID:        "watch",
+		Notification:   notification,
+		Status:         domain.DeliveryPending,
+		Attempts:       1,
+		ResponseStatus: 500,
+		Error:          "webhook responded with status 500",
+		CreatedAt:      now.Add(time.Minute),
+		NextAttemptAt:  now.Add(2 * time.Minute),
+	}
+	assert.NoError(t, repo.SaveDelivery(context.Background(), delivered))
+	assert.NoError(t, repo.SaveDelivery(context.Background(), pending))
+
+	res, err := repo.ListDeliveries(context.Background(), "watch", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Delivery{pending, delivered}, res)
+
+	res, err = repo.ListDeliveries(context.Background(), "watch", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Delivery{pending}, res)
+
+	res, err = repo.PendingDeliveries(context.Background(), now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	res, err = repo.PendingDeliveries(context.Background(), now.Add(2*time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Delivery{pending}, res)
+
+	// The deliveries are removed with their watch
+	assert.NoError(t, repo.DeleteWatch(context.Background(), "watch"))
+	res, err = repo.ListDeliveries(context.Background(), "watch", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
@@ -0,0 +1,152 @@
+package watches
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+const (
+	HeaderWebhookID        = "X-Webhook-Id"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	// HeaderWebhookSignature is "sha256=" and the hex HMAC-SHA256 of the timestamp, "." and the body keyed by the secret of the watch.
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// errNonPublicAddress is returned when a webhook resolves or redirects to a non-public address.
+var errNonPublicAddress = errors.New("non-public address")
+
+// HTTPSender posts the notifications of the watches as signed JSON.
+// The connections to the non-public addresses are refused unless the private networks are allowed,
+// the address is checked once it's resolved, so a webhook host can't be pointed at an internal service.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(cfg *config.Config) *HTTPSender {
+	c := NewWatchesConfig(cfg)
+
+	dialer := &net.Dialer{Timeout: c.webhookTimeout}
+	if !c.allowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("parse address %q: %w", address, err)
+			}
+			if !utils.PublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w %s", errNonPublicAddress, addrPort.Addr())
+			}
+			return nil
+		}
+	}
+
+	return &HTTPSender{client: &http.Client{
+		Timeout: c.webhookTimeout,
+		// The webhooks aren't sent through the proxy of the environment, so the dialed address is the webhook one
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: c.webhookTimeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}}
+}
+
+type payload struct {
+	ID              string         `json:"id"`
+	WatchID         string         `json:"watchId"`
+	Event           string         `json:"event"`
+	Marketplace     string         `json:"marketplace,omitempty"`
+	Product         payloadProduct `json:"product"`
+	TargetPrice     float64        `json:"targetPrice"`
+	PreviousPrice   float64        `json:"previousPrice"`
+	PreviousInStock bool           `json:"previousInStock"`
+	OccurredAt      time.Time      `json:"occurredAt"`
+}
+
+type payloadProduct struct {
+	ID           string  `json:"id,omitempty"`
+	Name         string  `json:"name"`
+	Link         string  `json:"link"`
+	Price        float64 `json:"price"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviewsCount"`
+	InStock      bool    `json:"inStock"`
+}
+
+func (s *HTTPSender) Send(ctx context.Context, url string, secret string, deliveryID string, notification domain.WatchNotification) (int, error) {
+	body, err := json.Marshal(payload{
+		ID:          deliveryID,
+		WatchID:     notification.WatchID,
+		Event:       string(notification.Event),
+		Marketplace: string(notification.Marketplace),
+		Product: payloadProduct{
+			ID:           notification.Product.ID,
+			Name:         notification.Product.Name,
+			Link:         notification.Product.Link,
+			Price:        notification.Product.Price,
+			Rating:       notification.Product.Rating,
+			ReviewsCount: notification.Product.ReviewsCount,
+			InStock:      notification.Product.InStock,
+		},
+		TargetPrice:     notification.TargetPrice,
+		PreviousPrice:   notification.PreviousPrice,
+		PreviousInStock: notification.PreviousInStock,
+		OccurredAt:      notification.OccurredAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, deliveryID)
+	req.Header.Set(HeaderWebhookEvent, string(notification.Event))
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of the timestamp, "." and the body keyed by the secret.
+// The receivers compare it to the signature header and reject the old timestamps, so a payload can't be replayed.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,96 @@
+package watches_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/watches"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+func TestWatches_HTTPSenderSend(t *testing.T) {
+	cfg := &config.Config{Watches: config.WatchesConfig{Webhook: config.WebhookConfig{Timeout: time.Second, AllowPrivateNetworks: true}}}
+	notification := domain.WatchNotification{
+		WatchID:       "watch",
+		Event:         domain.WatchPriceDrop,
+		Marketplace:   domain.MarketplaceOzon,
+		Product:       domain.Product{ID: "1", Name: "prod", Link: "link", Price: 90.0, InStock: true},
+		TargetPrice:   100.0,
+		PreviousPrice: 120.0,
+		OccurredAt:    time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("signed payload", func(t *testing.T) {
+		var header http.Header
+		var body []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		status, err := watches.NewHTTPSender(cfg).Send(context.Background(), srv.URL, "secret", "delivery", notification)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+		assert.Equal(t, "delivery", header.Get(watches.HeaderWebhookID))
+		assert.Equal(t, "price_drop", header.Get(watches.HeaderWebhookEvent))
+		timestamp := header.Get(watches.HeaderWebhookTimestamp)
+		assert.NotEmpty(t, timestamp)
+		assert.Equal(t, "sha256="+watches.Sign("secret", timestamp, body), header.Get(watches.HeaderWebhookSignature))
+		assert.NotEqual(t, watches.Sign("other", timestamp, body), watches.Sign("secret", timestamp, body))
+
+		var payload map[string]any
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "delivery", payload["id"])
+		assert.Equal(t, "watch", payload["watchId"])
+		assert.Equal(t, "price_drop", payload["event"])
+		assert.Equal(t, 100.0, payload["targetPrice"])
+		assert.Equal(t, 120.0, payload["previousPrice"])
+		assert.Equal(t, 90.0, payload["product"].(map[string]any)["price"])
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		status, err := watches.NewHTTPSender(cfg).Send(context.Background(), srv.URL, "secret", "delivery", notification)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadGateway, status)
+	})
+
+	t.Run("no response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		srv.Close()
+
+		status, err := watches.NewHTTPSender(cfg).Send(context.Background(), srv.URL, "secret", "delivery", notification)
+		assert.Error(t, err)
+		assert.Zero(t, status)
+	})
+
+	t.Run("private network", func(t *testing.T) {
+		called := false
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		cfg := &config.Config{Watches: config.WatchesConfig{Webhook: config.WebhookConfig{Timeout: time.Second}}}
+		status, err := watches.NewHTTPSender(cfg).Send(context.Background(), srv.URL, "secret", "delivery", notification)
+		assert.ErrorContains(t, err, "non-public address")
+		assert.Zero(t, status)
+		assert.False(t, called)
+	})
+}
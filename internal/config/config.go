@@ -2,25 +2,319 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Browser BrowserConfig `yaml:"browser"`
-	Options OptionsConfig `yaml:"options"`
-	Server  ServerConfig  `yaml:"server"`
+	Browser   BrowserConfig   `yaml:"browser"`
+	Options   OptionsConfig   `yaml:"options"`
+	Server    ServerConfig    `yaml:"server"`
+	Artifacts ArtifactsConfig `yaml:"artifacts"`
+	Sessions  SessionsConfig  `yaml:"sessions"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Admission AdmissionConfig `yaml:"admission"`
+	Jobs      JobsConfig      `yaml:"jobs"`
+	Batch     BatchConfig     `yaml:"batch"`
+	History   HistoryConfig   `yaml:"history"`
+	Watches   WatchesConfig   `yaml:"watches"`
+	Scheduler SchedulerConfig `yaml:"scheduler"`
 }
 
+const (
+	// BrowserModeRemote connects to the chromium nodes by ws_url and ws_urls.
+	BrowserModeRemote = "remote"
+	// BrowserModeLocal launches the chromium binary on this machine, it's meant for debugging of parsers without Docker.
+	BrowserModeLocal = "local"
+)
+
 type BrowserConfig struct {
-	WsURL             string        `yaml:"ws_url" env:"BROWSER_WS_URL" env-required:"true"`
+	Mode  string `yaml:"mode" env:"BROWSER_MODE" env-default:"remote"`
+	WsURL string `yaml:"ws_url" env:"BROWSER_WS_URL"`
+	// WsURLs are the endpoints of additional chromium nodes, the pages are distributed over all nodes.
+	WsURLs            []string      `yaml:"ws_urls" env:"BROWSER_WS_URLS" env-separator:","`
 	Referer           string        `yaml:"referer" env-default:"https://google.com"`
 	AcceptLanguage    string        `yaml:"accept_language" env-default:"ru-RU,ru;q=0.9"`
 	DomStableDuration time.Duration `yaml:"dom_stable_duration" env-default:"2500ms"`
 	DomStableDiff     float64       `yaml:"dom_stable_diff" env-default:"0.85"`
+	DomStableTimeout  time.Duration `yaml:"dom_stable_timeout" env-default:"30s"`
 	HeadlessMode      bool          `yaml:"headless_mode" env:"BROWSER_HEADLESS_MODE" env-default:"true"`
+	// Flags are extra chromium command line flags like "window-size=1920,1080" or "auto-open-devtools-for-tabs".
+	Flags []string `yaml:"flags" env:"BROWSER_FLAGS" env-separator:";"`
+
+	Local    LocalBrowserConfig    `yaml:"local"`
+	Blocking RequestBlockingConfig `yaml:"blocking"`
+	Proxy    ProxyConfig           `yaml:"proxy"`
+
+	Pool PoolConfig `yaml:"pool"`
+	HAR  HARConfig  `yaml:"har"`
+}
+
+// Endpoints returns the WebSocket URLs of all chromium nodes without duplicates.
+func (c BrowserConfig) Endpoints() []string {
+	res := make([]string, 0, len(c.WsURLs)+1)
+	for _, u := range append([]string{c.WsURL}, c.WsURLs...) {
+		u = strings.TrimSpace(u)
+		if u != "" && !slices.Contains(res, u) {
+			res = append(res, u)
+		}
+	}
+
+	return res
+}
+
+// RequestBlockingConfig describes the requests the parser pages don't load to speed up scraping.
+type RequestBlockingConfig struct {
+	Enabled bool `yaml:"enabled" env:"BROWSER_BLOCKING_ENABLED" env-default:"false"`
+	// ResourceTypes are the CDP resource types like "image", "media", "font" or "stylesheet".
+	ResourceTypes []string `yaml:"resource_types"`
+	// URLPatterns are glob patterns of the blocked URLs, "*" matches any characters.
+	URLPatterns []string `yaml:"url_patterns"`
+}
+
+// ResourceTypes are the CDP resource types the requests can be blocked by.
+var ResourceTypes = []string{
+	"Document", "Stylesheet", "Image", "Media", "Font", "Script", "TextTrack", "XHR", "Fetch", "Prefetch",
+	"EventSource", "WebSocket", "Manifest", "SignedExchange", "Ping", "CSPViolationReport", "Preflight", "Other",
+}
+
+// validate checks that the resource types are known, they are matched case-insensitively.
+func (c *RequestBlockingConfig) validate() error {
+	for _, t := range c.ResourceTypes {
+		if !slices.ContainsFunc(ResourceTypes, func(known string) bool { return strings.EqualFold(known, t) }) {
+			return fmt.Errorf("unknown resource type %q", t)
+		}
+	}
+
+	return nil
+}
+
+// ProxyConfig describes the outbound HTTP proxy the parser pages connect through.
+type ProxyConfig struct {
+	// URL is the proxy server like "http://host:3128", the pages connect directly if it's empty.
+	// The credentials can be set in the URL too, they are moved to Username and Password on load.
+	URL      string `yaml:"url" env:"BROWSER_PROXY_URL"`
+	Username string `yaml:"username" env:"BROWSER_PROXY_USERNAME"`
+	// Password is read only from the environment or the URL, so it's never kept in the config file.
+	Password string `env:"BROWSER_PROXY_PASSWORD"`
+	// Bypass are the hosts connected directly like "localhost" or "*.example.com".
+	Bypass []string `yaml:"bypass" env:"BROWSER_PROXY_BYPASS" env-separator:","`
+}
+
+// normalize validates the proxy URL and moves its credentials to Username and Password, the explicit ones take precedence.
+func (c *ProxyConfig) normalize() error {
+	if c.URL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("parse proxy url: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("proxy url %q must have a scheme and a host", u.Redacted())
+	}
+
+	if u.User != nil {
+		if c.Username == "" {
+			c.Username = u.User.Username()
+		}
+		if password, ok := u.User.Password(); ok && c.Password == "" {
+			c.Password = password
+		}
+		u.User = nil
+	}
+	c.URL = u.String()
+
+	return nil
+}
+
+// LocalBrowserConfig describes the chromium launched in the local mode.
+type LocalBrowserConfig struct {
+	// Bin is the path to the chromium binary, the installed or downloaded by rod browser is used if it's empty.
+	Bin string `yaml:"bin" env:"BROWSER_BIN"`
+	// UserDataDir keeps the browser profile between runs, a temporary dir is used if it's empty.
+	UserDataDir string `yaml:"user_data_dir" env:"BROWSER_USER_DATA_DIR"`
+}
+
+// PoolConfig describes the long-lived browser connections shared by the parser pages.
+type PoolConfig struct {
+	// Size is the number of connections to every chromium node.
+	Size int `yaml:"size" env:"BROWSER_POOL_SIZE" env-default:"1"`
+	// MaxPages limits the number of pages opened at the same time on every chromium node, the pool allows
+	// MaxPages times the number of nodes over all connections and spreads the pages over the healthy nodes evenly.
+	MaxPages            int           `yaml:"max_pages" env:"BROWSER_POOL_MAX_PAGES" env-default:"4"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" env:"BROWSER_POOL_HEALTH_CHECK_INTERVAL" env-default:"30s"`
+}
+
+// HARConfig enables recording of the network traffic of every parser page to a HAR file.
+type HARConfig struct {
+	Enabled bool   `yaml:"enabled" env:"BROWSER_HAR_ENABLED" env-default:"false"`
+	Dir     string `yaml:"dir" env:"BROWSER_HAR_DIR" env-default:"har"`
+	// IncludeContent adds the response bodies to the HAR, it makes the files much bigger.
+	IncludeContent bool `yaml:"include_content" env:"BROWSER_HAR_INCLUDE_CONTENT" env-default:"false"`
+}
+
+// ArtifactsConfig describes where and how long debug artifacts of parser failures are kept.
+type ArtifactsConfig struct {
+	Dir string `yaml:"dir" env:"ARTIFACTS_DIR" env-default:"artifacts"`
+	// OnFailure enables capturing of every failed page, not only of debug requests and blocked pages.
+	OnFailure      bool          `yaml:"on_failure" env:"ARTIFACTS_ON_FAILURE" env-default:"true"`
+	MaxAge         time.Duration `yaml:"max_age" env:"ARTIFACTS_MAX_AGE" env-default:"72h"`
+	MaxCount       int           `yaml:"max_count" env:"ARTIFACTS_MAX_COUNT" env-default:"200"`
+	CaptureTimeout time.Duration `yaml:"capture_timeout" env:"ARTIFACTS_CAPTURE_TIMEOUT" env-default:"10s"`
+}
+
+// SessionsConfig describes the store of marketplace cookies and localStorage reused by the following pages.
+type SessionsConfig struct {
+	Enabled bool   `yaml:"enabled" env:"SESSIONS_ENABLED" env-default:"false"`
+	Dir     string `yaml:"dir" env:"SESSIONS_DIR" env-default:"sessions"`
+	// Key is the secret the session files are encrypted with, it's required if the store is enabled.
+	Key string        `env:"SESSIONS_KEY"`
+	TTL time.Duration `yaml:"ttl" env:"SESSIONS_TTL" env-default:"24h"`
+}
+
+// CacheConfig describes the in-memory cache of the results of identical searches.
+type CacheConfig struct {
+	Enabled    bool          `yaml:"enabled" env:"CACHE_ENABLED" env-default:"false"`
+	TTL        time.Duration `yaml:"ttl" env:"CACHE_TTL" env-default:"5m"`
+	MaxEntries int           `yaml:"max_entries" env:"CACHE_MAX_ENTRIES" env-default:"1000"`
+	// CacheErrors enables caching of the failed searches for ErrorTTL, so a failing search isn't repeated at once.
+	CacheErrors bool          `yaml:"cache_errors" env:"CACHE_ERRORS" env-default:"false"`
+	ErrorTTL    time.Duration `yaml:"error_ttl" env:"CACHE_ERROR_TTL" env-default:"30s"`
+}
+
+// AdmissionConfig describes the global limit of the concurrent requests to the marketplaces and its wait queue.
+type AdmissionConfig struct {
+	// MaxConcurrent is the number of the requests run at the same time, the limit is disabled if it's 0.
+	MaxConcurrent int `yaml:"max_concurrent" env:"ADMISSION_MAX_CONCURRENT" env-default:"8"`
+	// MaxQueue is the number of the requests waiting for a slot, the next ones are rejected with 429.
+	MaxQueue   int           `yaml:"max_queue" env:"ADMISSION_MAX_QUEUE" env-default:"16"`
+	RetryAfter time.Duration `yaml:"retry_after" env:"ADMISSION_RETRY_AFTER" env-default:"5s"`
+}
+
+// JobsConfig describes the workers of the asynchronous search jobs and the store of their results.
+type JobsConfig struct {
+	Workers int `yaml:"workers" env:"JOBS_WORKERS" env-default:"2"`
+	// QueueSize is the number of the jobs waiting for a worker, the next ones are rejected with 429.
+	QueueSize  int           `yaml:"queue_size" env:"JOBS_QUEUE_SIZE" env-default:"100"`
+	RetryAfter time.Duration `yaml:"retry_after" env:"JOBS_RETRY_AFTER" env-default:"30s"`
+	// Timeout is the deadline of a job, it replaces the request timeout of the synchronous search.
+	Timeout time.Duration `yaml:"timeout" env:"JOBS_TIMEOUT" env-default:"5m"`
+	// TTL is the time the finished job is kept.
+	TTL time.Duration `yaml:"ttl" env:"JOBS_TTL" env-default:"1h"`
+}
+
+// BatchConfig describes the batch searches of /products/search/batch.
+type BatchConfig struct {
+	// Parallelism is the number of the queries of a batch run at the same time.
+	Parallelism int `yaml:"parallelism" env:"BATCH_PARALLELISM" env-default:"4"`
+	MaxQueries  int `yaml:"max_queries" env:"BATCH_MAX_QUERIES" env-default:"1000"`
+	// QueryTimeout is the deadline of a query of a batch.
+	QueryTimeout time.Duration `yaml:"query_timeout" env:"BATCH_QUERY_TIMEOUT" env-default:"30s"`
+	// Timeout is the deadline of a batch, it replaces the request timeout for the batch endpoint.
+	Timeout time.Duration `yaml:"timeout" env:"BATCH_TIMEOUT" env-default:"1h"`
+}
+
+// HistoryConfig describes the SQLite database the price observations of the found products are recorded to.
+type HistoryConfig struct {
+	Enabled bool   `yaml:"enabled" env:"HISTORY_ENABLED" env-default:"true"`
+	Path    string `yaml:"path" env:"HISTORY_PATH" env-default:"history/history.db"`
+	// Retention is how long the observations are kept, they are kept forever if it's 0.
+	Retention time.Duration `yaml:"retention" env:"HISTORY_RETENTION" env-default:"2160h"`
+	// PruneInterval is how often the observations older than the retention are removed.
+	PruneInterval time.Duration `yaml:"prune_interval" env:"HISTORY_PRUNE_INTERVAL" env-default:"1h"`
+	// BufferSize is the number of the searches whose observations wait to be recorded, the next ones are dropped.
+	BufferSize int `yaml:"buffer_size" env:"HISTORY_BUFFER_SIZE" env-default:"64"`
+}
+
+// WatchesConfig describes the price watches of /watches and the SQLite database they are kept in.
+type WatchesConfig struct {
+	Enabled bool   `yaml:"enabled" env:"WATCHES_ENABLED" env-default:"true"`
+	Path    string `yaml:"path" env:"WATCHES_PATH" env-default:"watches/watches.db"`
+	// Tick is how often the due watches and the webhook retries are looked for.
+	Tick time.Duration `yaml:"tick" env:"WATCHES_TICK" env-default:"1m"`
+	// Workers is the number of the watches checked and the webhooks delivered at the same time.
+	Workers int `yaml:"workers" env:"WATCHES_WORKERS" env-default:"2"`
+	// DefaultInterval is the interval of the checks of a watch created without one, MinInterval is the shortest one allowed.
+	DefaultInterval time.Duration `yaml:"default_interval" env:"WATCHES_DEFAULT_INTERVAL" env-default:"1h"`
+	MinInterval     time.Duration `yaml:"min_interval" env:"WATCHES_MIN_INTERVAL" env-default:"5m"`
+	// CheckTimeout is the deadline of the search of a check.
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"WATCHES_CHECK_TIMEOUT" env-default:"1m"`
+
+	Webhook WebhookConfig `yaml:"webhook"`
+}
+
+// WebhookConfig describes the delivery of the webhooks of the watches.
+type WebhookConfig struct {
+	Timeout time.Duration `yaml:"timeout" env:"WATCHES_WEBHOOK_TIMEOUT" env-default:"10s"`
+	// MaxAttempts is the number of the attempts including the first one, the delivery is failed after them.
+	MaxAttempts int `yaml:"max_attempts" env:"WATCHES_WEBHOOK_MAX_ATTEMPTS" env-default:"6"`
+	// BaseDelay is the delay before the first retry, it doubles with every next one up to MaxDelay.
+	BaseDelay time.Duration `yaml:"base_delay" env:"WATCHES_WEBHOOK_BASE_DELAY" env-default:"30s"`
+	MaxDelay  time.Duration `yaml:"max_delay" env:"WATCHES_WEBHOOK_MAX_DELAY" env-default:"30m"`
+	// LogSize is the number of the last deliveries of a watch returned by /watches/{id}/deliveries.
+	LogSize int `yaml:"log_size" env:"WATCHES_WEBHOOK_LOG_SIZE" env-default:"50"`
+	// AllowPrivateNetworks allows the webhooks to the loopback, private and link-local addresses,
+	// they are rejected by default so the watches can't reach the internal services.
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" env:"WATCHES_WEBHOOK_ALLOW_PRIVATE_NETWORKS" env-default:"false"`
+}
+
+// SchedulerConfig describes the recurring searches run by the built-in scheduler and the sink of their results.
+type SchedulerConfig struct {
+	Enabled bool `yaml:"enabled" env:"SCHEDULER_ENABLED" env-default:"true"`
+	// Path is the SQLite database the schedules and the status of their last runs are kept in.
+	Path string `yaml:"path" env:"SCHEDULER_PATH" env-default:"scheduler/scheduler.db"`
+	// Tick is how often the due schedules are looked for.
+	Tick time.Duration `yaml:"tick" env:"SCHEDULER_TICK" env-default:"10s"`
+	// MaxConcurrent is the number of the schedules run at the same time, the due ones wait for a free slot.
+	MaxConcurrent int `yaml:"max_concurrent" env:"SCHEDULER_MAX_CONCURRENT" env-default:"2"`
+	// Jitter is the maximum random delay of a run after its scheduled time, so the schedules with the same time
+	// don't hit the marketplaces at once.
+	Jitter time.Duration `yaml:"jitter" env:"SCHEDULER_JITTER" env-default:"30s"`
+	// Timeout is the deadline of the search of a run.
+	Timeout time.Duration `yaml:"timeout" env:"SCHEDULER_TIMEOUT" env-default:"5m"`
+	// MinInterval is the shortest time allowed between the runs of a schedule.
+	MinInterval time.Duration `yaml:"min_interval" env:"SCHEDULER_MIN_INTERVAL" env-default:"15m"`
+
+	Sink SinkConfig `yaml:"sink"`
+	// Schedules are the schedules defined in the config, they are added to the ones created via the API on start.
+	Schedules []ScheduleConfig `yaml:"schedules"`
+}
+
+const (
+	// SinkLog logs a summary of every result.
+	SinkLog = "log"
+	// SinkFile appends every result to a JSON Lines file.
+	SinkFile = "file"
+	// SinkDatabase inserts the found products of every result to an SQLite database.
+	SinkDatabase = "database"
+)
+
+// SinkConfig describes where the results of the scheduled searches are stored.
+type SinkConfig struct {
+	Type         string `yaml:"type" env:"SCHEDULER_SINK_TYPE" env-default:"log"`
+	FilePath     string `yaml:"file_path" env:"SCHEDULER_SINK_FILE_PATH" env-default:"scheduler/results.jsonl"`
+	DatabasePath string `yaml:"database_path" env:"SCHEDULER_SINK_DATABASE_PATH" env-default:"scheduler/results.db"`
+}
+
+// ScheduleConfig is a recurring search defined in the config.
+type ScheduleConfig struct {
+	// ID identifies the schedule in the admin API, it must be unique.
+	ID string `yaml:"id"`
+	// Cron is a standard 5-field cron expression or a descriptor like "@hourly".
+	Cron         string   `yaml:"cron"`
+	Query        string   `yaml:"query"`
+	Marketplaces []string `yaml:"marketplaces"`
+	PriceFrom    float64  `yaml:"price_from"`
+	PriceTo      float64  `yaml:"price_to"`
+	InStockOnly  bool     `yaml:"in_stock_only"`
+	Region       string   `yaml:"region"`
 }
 
 type OptionsConfig struct {
@@ -33,6 +327,11 @@ type ServerConfig struct {
 	WbCfg          *WbConfig     `yaml:"wb_config"`
 	OzonCfg        *OzonConfig   `yaml:"ozon_config"`
 	RequestTimeout time.Duration `yaml:"request_timeout" env:"SERVER_REQUEST_TIMEOUT" env-default:"30s"`
+	// ResponseReserve is the part of the request timeout the marketplaces can't use, so the found products are still returned in time.
+	ResponseReserve time.Duration `yaml:"response_reserve" env:"SERVER_RESPONSE_RESERVE" env-default:"500ms"`
+	// Regions maps region preset names to delivery addresses.
+	Regions        map[string]string `yaml:"regions"`
+	RegionCacheTTL time.Duration     `yaml:"region_cache_ttl" env:"SERVER_REGION_CACHE_TTL" env-default:"12h"`
 }
 
 type WbConfig struct {
@@ -44,6 +343,24 @@ type WbConfig struct {
 	PriceSelector       string `yaml:"price_selector" env-required:"true"`
 	RatingSelector      string `yaml:"rating_selector" env-required:"true"`
 	ReviewsSelector     string `yaml:"reviews_selector" env-required:"true"`
+	OutOfStockSelector  string `yaml:"out_of_stock_selector" env-required:"true"`
+	StockSelector       string `yaml:"stock_selector" env-required:"true"`
+	DeliverySelector    string `yaml:"delivery_selector" env-required:"true"`
+	SuggestionsSelector string `yaml:"suggestions_selector" env-required:"true"`
+	// ProductPage describes the product page the watched products are parsed from.
+	ProductPage ProductPageConfig `yaml:"product_page"`
+
+	Region RegionPickerConfig `yaml:"region"`
+	Block  BlockConfig        `yaml:"block"`
+	// Timeout is the time budget of the marketplace within a search request, 0 to limit it by the request timeout only.
+	Timeout time.Duration `yaml:"timeout"`
+	Retry   RetryConfig   `yaml:"retry"`
+	Breaker BreakerConfig `yaml:"breaker"`
+	Limit   LimitConfig   `yaml:"limit"`
+	// Blocking overrides the browser request blocking for the marketplace if it's set.
+	Blocking *RequestBlockingConfig `yaml:"blocking"`
+	// Proxy overrides the browser proxy for the marketplace if it's set, an empty URL means a direct connection.
+	Proxy *ProxyConfig `yaml:"proxy"`
 }
 
 type OzonConfig struct {
@@ -55,6 +372,76 @@ type OzonConfig struct {
 	PriceSelector       string `yaml:"price_selector" env-required:"true"`
 	RatingSelector      string `yaml:"rating_selector" env-required:"true"`
 	ReviewsSelector     string `yaml:"reviews_selector" env-required:"true"`
+	OutOfStockSelector  string `yaml:"out_of_stock_selector" env-required:"true"`
+	StockSelector       string `yaml:"stock_selector" env-required:"true"`
+	DeliverySelector    string `yaml:"delivery_selector" env-required:"true"`
+	SuggestionsSelector string `yaml:"suggestions_selector" env-required:"true"`
+	// ProductPage describes the product page the watched products are parsed from.
+	ProductPage ProductPageConfig `yaml:"product_page"`
+
+	Region RegionPickerConfig `yaml:"region"`
+	Block  BlockConfig        `yaml:"block"`
+	// Timeout is the time budget of the marketplace within a search request, 0 to limit it by the request timeout only.
+	Timeout time.Duration `yaml:"timeout"`
+	Retry   RetryConfig   `yaml:"retry"`
+	Breaker BreakerConfig `yaml:"breaker"`
+	Limit   LimitConfig   `yaml:"limit"`
+	// Blocking overrides the browser request blocking for the marketplace if it's set.
+	Blocking *RequestBlockingConfig `yaml:"blocking"`
+	// Proxy overrides the browser proxy for the marketplace if it's set, an empty URL means a direct connection.
+	Proxy *ProxyConfig `yaml:"proxy"`
+}
+
+// ProductPageConfig describes the product page of a marketplace, the selectors are matched against the whole page.
+type ProductPageConfig struct {
+	NameSelector     string `yaml:"name_selector" env-required:"true"`
+	PriceSelector    string `yaml:"price_selector" env-required:"true"`
+	RatingSelector   string `yaml:"rating_selector"`
+	ReviewsSelector  string `yaml:"reviews_selector"`
+	DeliverySelector string `yaml:"delivery_selector"`
+	// OutOfStockMarkers are the texts of the page of a product that can't be ordered.
+	OutOfStockMarkers []string `yaml:"out_of_stock_markers"`
+	// NotFoundMarkers are the texts of the page of a removed or unknown product.
+	NotFoundMarkers []string `yaml:"not_found_markers"`
+}
+
+type RegionPickerConfig struct {
+	AddressButtonSelector     string `yaml:"address_button_selector" env-required:"true"`
+	AddressInputSelector      string `yaml:"address_input_selector" env-required:"true"`
+	AddressSuggestionSelector string `yaml:"address_suggestion_selector" env-required:"true"`
+	AddressConfirmSelector    string `yaml:"address_confirm_selector" env-required:"true"`
+}
+
+// RetryConfig describes the retries of the transient failures of a marketplace like navigation errors or DOM stability timeouts.
+type RetryConfig struct {
+	// MaxAttempts is the number of attempts including the first one, the marketplace isn't retried if it's less than 2.
+	MaxAttempts int           `yaml:"max_attempts"`
+	BaseDelay   time.Duration `yaml:"base_delay"`
+	MaxDelay    time.Duration `yaml:"max_delay"`
+}
+
+// BreakerConfig describes the circuit breaker that stops calling a marketplace after failures in a row.
+type BreakerConfig struct {
+	// FailureThreshold is the number of failures in a row that opens the circuit, the breaker is disabled if it's 0.
+	FailureThreshold int `yaml:"failure_threshold"`
+	// CoolDown is the time the circuit stays open before a trial call is passed.
+	CoolDown time.Duration `yaml:"cool_down"`
+}
+
+// LimitConfig describes the limit of the concurrent calls of a marketplace and its wait queue.
+type LimitConfig struct {
+	// MaxConcurrent is the number of the calls run at the same time, the limit is disabled if it's 0.
+	MaxConcurrent int `yaml:"max_concurrent"`
+	// MaxQueue is the number of the calls waiting for a slot, the next ones are rejected with 429.
+	MaxQueue   int           `yaml:"max_queue"`
+	RetryAfter time.Duration `yaml:"retry_after"`
+}
+
+// BlockConfig describes how to recognize a captcha or "access denied" page of a marketplace.
+type BlockConfig struct {
+	// Markers are case-insensitive substrings of the page title or visible text that only a block page contains.
+	Markers    []string      `yaml:"markers"`
+	RetryAfter time.Duration `yaml:"retry_after" env-default:"5m"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -69,6 +456,83 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("read config: %w", err)
 	}
 
+	switch cfg.Browser.Mode {
+	case BrowserModeRemote:
+		if len(cfg.Browser.Endpoints()) == 0 {
+			return nil, fmt.Errorf("BROWSER_WS_URL or BROWSER_WS_URLS not set")
+		}
+	case BrowserModeLocal:
+	default:
+		return nil, fmt.Errorf("unknown browser mode %q", cfg.Browser.Mode)
+	}
+
+	if cfg.Browser.Pool.HealthCheckInterval <= 0 {
+		return nil, fmt.Errorf("browser pool health check interval must be positive, got %s", cfg.Browser.Pool.HealthCheckInterval)
+	}
+
+	if cfg.History.Enabled && cfg.History.Retention > 0 && cfg.History.PruneInterval <= 0 {
+		return nil, fmt.Errorf("history prune interval must be positive, got %s", cfg.History.PruneInterval)
+	}
+
+	if cfg.Jobs.Timeout <= 0 {
+		return nil, fmt.Errorf("jobs timeout must be positive, got %s", cfg.Jobs.Timeout)
+	}
+
+	if cfg.Batch.QueryTimeout <= 0 {
+		return nil, fmt.Errorf("batch query timeout must be positive, got %s", cfg.Batch.QueryTimeout)
+	}
+	if cfg.Batch.Timeout <= 0 {
+		return nil, fmt.Errorf("batch timeout must be positive, got %s", cfg.Batch.Timeout)
+	}
+
+	if cfg.Watches.Enabled && cfg.Watches.CheckTimeout <= 0 {
+		return nil, fmt.Errorf("watches check timeout must be positive, got %s", cfg.Watches.CheckTimeout)
+	}
+	if cfg.Watches.Enabled && cfg.Watches.Tick <= 0 {
+		return nil, fmt.Errorf("watches tick must be positive, got %s", cfg.Watches.Tick)
+	}
+
+	if cfg.Scheduler.Enabled && cfg.Scheduler.Tick <= 0 {
+		return nil, fmt.Errorf("scheduler tick must be positive, got %s", cfg.Scheduler.Tick)
+	}
+
+	switch cfg.Scheduler.Sink.Type {
+	case SinkLog, SinkFile, SinkDatabase:
+	default:
+		return nil, fmt.Errorf("unknown scheduler sink %q", cfg.Scheduler.Sink.Type)
+	}
+
+	if cfg.Sessions.Enabled && cfg.Sessions.Key == "" {
+		return nil, fmt.Errorf("SESSIONS_KEY not set")
+	}
+
+	if err := cfg.Browser.Proxy.normalize(); err != nil {
+		return nil, fmt.Errorf("browser proxy: %w", err)
+	}
+	if cfg.Server.WbCfg != nil && cfg.Server.WbCfg.Proxy != nil {
+		if err := cfg.Server.WbCfg.Proxy.normalize(); err != nil {
+			return nil, fmt.Errorf("wildberries proxy: %w", err)
+		}
+	}
+	if cfg.Server.OzonCfg != nil && cfg.Server.OzonCfg.Proxy != nil {
+		if err := cfg.Server.OzonCfg.Proxy.normalize(); err != nil {
+			return nil, fmt.Errorf("ozon proxy: %w", err)
+		}
+	}
+
+	if err := cfg.Browser.Blocking.validate(); err != nil {
+		return nil, fmt.Errorf("browser blocking: %w", err)
+	}
+	if cfg.Server.WbCfg != nil && cfg.Server.WbCfg.Blocking != nil {
+		if err := cfg.Server.WbCfg.Blocking.validate(); err != nil {
+			return nil, fmt.Errorf("wildberries blocking: %w", err)
+		}
+	}
+	if cfg.Server.OzonCfg != nil && cfg.Server.OzonCfg.Blocking != nil {
+		if err := cfg.Server.OzonCfg.Blocking.validate(); err != nil {
+			return nil, fmt.Errorf("ozon blocking: %w", err)
+		}
+	}
 
 
 	/*
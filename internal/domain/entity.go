@@ -1,9 +1,356 @@
 package domain
 
+import "time"
+
 type Product struct {
+	// ID is the id of the product in the marketplace, empty if it can't be parsed from the link.
+	ID           string
 	Name         string
 	Link         string
 	Price        float64
 	Rating       float64
 	ReviewsCount int
+	InStock      bool
+	// StockQuantity is the number of items left, 0 if the marketplace does not show it.
+	StockQuantity int
+	DeliveryText  string
+	// DeliveryDate is the nearest delivery date, zero if it could not be parsed from DeliveryText.
+	DeliveryDate time.Time
+}
+
+type SearchQuery struct {
+	Name        string
+	PriceFrom   float64
+	PriceTo     float64
+	InStockOnly bool
+	// Region is a city name or a preset name from config, empty for the marketplace default.
+	Region string
+	// Debug enables capturing of debug artifacts even if the search succeeds.
+	Debug bool
+	// NoCache searches the marketplaces even if the result of the same search is cached.
+	NoCache bool
+	// Marketplaces are the marketplaces to search in, empty for all of them.
+	Marketplaces []Marketplace
+}
+
+// SearchResult is the products found by every marketplace that finished within its time budget.
+type SearchResult struct {
+	Products []Product
+	// TimedOut are the marketplaces that exceeded their time budget, their products are missing.
+	TimedOut []Marketplace
+	// CachedAt is the time the result was found if it's taken from the cache, zero for a fresh result.
+	CachedAt time.Time
+}
+
+// SourceResult is the result of a single marketplace within a streamed search.
+type SourceResult struct {
+	Marketplace Marketplace
+	Products    []Product
+	// TimedOut is set if the marketplace exceeded its time budget.
+	TimedOut bool
+	// Err is the error the marketplace failed with.
+	Err error
+}
+
+// BatchQuery is a search query of a batch identified by its key.
+type BatchQuery struct {
+	Key   string
+	Query SearchQuery
+}
+
+// BatchResult is the result of a search query of a batch.
+type BatchResult struct {
+	Key    string
+	Result SearchResult
+	// Err is the error the query failed with.
+	Err error
+}
+
+type Suggestions struct {
+	Marketplace Marketplace
+	Queries     []string
+}
+
+type CategoryQuery struct {
+	Marketplace Marketplace
+	// Category is a catalog path relative to the marketplace base URL or a full catalog URL.
+	Category    string
+	PriceFrom   float64
+	PriceTo     float64
+	InStockOnly bool
+	Region      string
+	Debug       bool
+}
+
+// ProductQuery is a product of a marketplace, it's parsed from its product page.
+type ProductQuery struct {
+	Marketplace Marketplace
+	ID          string
+	Region      string
+	Debug       bool
+}
+
+// BrowserNode is the state of a chromium node the parser pages are opened on.
+type BrowserNode struct {
+	URL         string
+	Healthy     bool
+	Connections int
+	Pages       int
+	// Failures is the number of failures in a row.
+	Failures  int
+	LastError string
+	// CheckedAt is the time of the last connecting or health check, zero if there were none.
+	CheckedAt time.Time
+}
+
+// CircuitState is the state of the circuit breaker of a marketplace.
+type CircuitState string
+
+const (
+	// CircuitClosed passes the calls to the marketplace.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen fails the calls without calling the marketplace until the cool-down ends.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen passes a single trial call, it closes the circuit on success and opens it again on failure.
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// SourceStatus is the state of the circuit breaker of a marketplace.
+type SourceStatus struct {
+	Marketplace Marketplace
+	State       CircuitState
+	// Failures is the number of failures in a row.
+	Failures int
+	// Opens is the number of times the circuit was opened since the start.
+	Opens     int
+	LastError string
+	// OpenedAt is the time the circuit was opened last, zero if it was never opened.
+	OpenedAt time.Time
+	// RetryAt is the end of the cool-down of the open circuit, zero if it isn't open.
+	RetryAt time.Time
+}
+
+// JobStatus is the state of an asynchronous search job.
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+	JobCanceled  JobStatus = "canceled"
+)
+
+// Finished reports whether the job won't change anymore.
+func (s JobStatus) Finished() bool {
+	return s == JobSucceeded || s == JobFailed || s == JobCanceled
+}
+
+// SourceState is the progress of a marketplace within a search job.
+type SourceState string
+
+const (
+	SourcePending  SourceState = "pending"
+	SourceRunning  SourceState = "running"
+	SourceDone     SourceState = "done"
+	SourceTimedOut SourceState = "timed_out"
+	SourceFailed   SourceState = "failed"
+	SourceCanceled SourceState = "canceled"
+)
+
+type SourceProgress struct {
+	Marketplace Marketplace
+	State       SourceState
+}
+
+// SearchJob is a search run in the background, its result is kept for a while after it's finished.
+type SearchJob struct {
+	ID      string
+	Status  JobStatus
+	Query   SearchQuery
+	Sources []SourceProgress
+	// Result is the result of the succeeded job, the running job has the results of its finished marketplaces in it.
+	Result SearchResult
+	// Error is the error the job failed with, empty if it didn't fail.
+	Error     string
+	CreatedAt time.Time
+	// StartedAt and FinishedAt are zero until the job is started and finished.
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// PriceObservation is the state of a product seen in a marketplace search.
+type PriceObservation struct {
+	Marketplace  Marketplace
+	ProductID    string
+	Name         string
+	Price        float64
+	Rating       float64
+	ReviewsCount int
+	InStock      bool
+	ObservedAt   time.Time
+}
+
+type HistoryQuery struct {
+	Marketplace Marketplace
+	ProductID   string
+	// From and To limit the time of the observations, zero for no limit.
+	From time.Time
+	To   time.Time
+	// Interval is the width of the buckets the observations are downsampled to, 0 returns every observation.
+	Interval time.Duration
+}
+
+// PricePoint is the state of a product within a bucket of its history, a single observation without downsampling.
+type PricePoint struct {
+	// Time is the start of the bucket or the time of the observation.
+	Time time.Time
+	// Price is the average price, MinPrice and MaxPrice are the extremes within the bucket.
+	Price    float64
+	MinPrice float64
+	MaxPrice float64
+	// Rating, ReviewsCount and InStock are taken from the last observation of the bucket.
+	Rating       float64
+	ReviewsCount int
+	InStock      bool
+	// Samples is the number of the observations in the bucket.
+	Samples int
+}
+
+// Watch is a product or a search query re-scraped periodically, its webhook is notified when the price drops
+// below the target or the stock of the product changes.
+type Watch struct {
+	ID string
+	// Marketplace and ProductID are set for a product watch, the product is parsed from its product page.
+	// The watch without a marketplace is a query watch.
+	Marketplace Marketplace
+	ProductID   string
+	// Query is the search of a query watch, the cheapest found product is compared to the target. The name of
+	// a product watch only labels it, its region is the delivery region of the product page.
+	Query       SearchQuery
+	TargetPrice float64
+	// NotifyStock enables the notifications of the stock changes of the watched product.
+	NotifyStock bool
+	WebhookURL  string
+	// Secret is the key the webhook payloads are signed with.
+	Secret string
+	// Interval is the time between the checks of the watch.
+	Interval  time.Duration
+	CreatedAt time.Time
+	State     WatchState
+}
+
+// ProductWatch reports whether the watch follows a single product, not the cheapest product of a search.
+func (w Watch) ProductWatch() bool {
+	return w.Marketplace != ""
+}
+
+// WatchState is the result of the last check of a watch.
+type WatchState struct {
+	// CheckedAt is the time of the last check, zero if the watch wasn't checked yet.
+	CheckedAt time.Time
+	// Found is set if the last check found the product, Product is the watched or the cheapest found product then.
+	// The product watch keeps the last seen product while the product is missing.
+	Found   bool
+	Product Product
+	// BelowTarget is set while the price is below the target, so a drop is notified once.
+	BelowTarget bool
+	// Error is the error the last check failed with, empty if it succeeded.
+	Error string
+}
+
+// WatchEvent is the change of a watched product a webhook is notified about.
+type WatchEvent string
+
+const (
+	WatchPriceDrop   WatchEvent = "price_drop"
+	WatchStockChange WatchEvent = "stock_change"
+)
+
+// WatchNotification is the payload of a webhook.
+type WatchNotification struct {
+	WatchID     string
+	Event       WatchEvent
+	Marketplace Marketplace
+	Product     Product
+	TargetPrice float64
+	// PreviousPrice and PreviousInStock are the state of the product at the previous check, zero if it wasn't found.
+	PreviousPrice   float64
+	PreviousInStock bool
+	OccurredAt      time.Time
+}
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is waiting for its next attempt.
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryFailed has run out of attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Delivery is a webhook notification and the result of its delivery attempts.
+type Delivery struct {
+	ID           string
+	WatchID      string
+	Notification WatchNotification
+	Status       DeliveryStatus
+	Attempts     int
+	// ResponseStatus is the HTTP status of the last attempt, 0 if the webhook didn't respond.
+	ResponseStatus int
+	// Error is the error of the last failed attempt.
+	Error     string
+	CreatedAt time.Time
+	// NextAttemptAt is the time of the next attempt of the pending delivery.
+	NextAttemptAt time.Time
+	// DeliveredAt is zero until the delivery succeeds.
+	DeliveredAt time.Time
+}
+
+// Schedule is a recurring search run by the scheduler, the results of its runs are passed to the result sink.
+type Schedule struct {
+	ID string
+	// Cron is a standard 5-field cron expression or a descriptor like "@hourly", in the local time of the service
+	// unless it's prefixed with "CRON_TZ=<zone> ".
+	Cron  string
+	Query SearchQuery
+	// FromConfig is set for the schedules defined in the config, they can't be removed via the API.
+	FromConfig bool
+	CreatedAt  time.Time
+	LastRun    ScheduleRun
+	// NextRunAt is the planned time of the next run including the jitter, zero until the scheduler plans it.
+	NextRunAt time.Time
+}
+
+// ScheduleRun is the last run of a schedule.
+type ScheduleRun struct {
+	// Status is empty if the schedule hasn't run yet, JobRunning while it runs.
+	Status     JobStatus
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Products is the number of the found products.
+	Products int
+	TimedOut []Marketplace
+	// Error is the error the run failed with.
+	Error string
+}
+
+// Duration returns the duration of the finished run, 0 while it runs.
+func (r ScheduleRun) Duration() time.Duration {
+	if r.FinishedAt.IsZero() {
+		return 0
+	}
+
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// ScheduledResult is the result of a run of a schedule stored by the result sink.
+type ScheduledResult struct {
+	ScheduleID string
+	Query      SearchQuery
+	StartedAt  time.Time
+	Products   []Product
+	TimedOut   []Marketplace
 }
@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrEmptyProductName      = errors.New("empty product name")
@@ -9,4 +13,93 @@ var (
 	ErrPriceFromBelowZero    = errors.New("price from below zero")
 	ErrPriceFromAbovePriceTo = errors.New("price from above price to")
 	ErrPriceToBelowZero      = errors.New("price to below zero")
+	ErrEmptyCategory         = errors.New("empty category")
+	ErrInvalidCategory       = errors.New("invalid category")
+	ErrUnknownMarketplace    = errors.New("unknown marketplace")
+	ErrEmptyPrefix           = errors.New("empty prefix")
+	ErrSourceBlocked         = errors.New("source blocked")
+	ErrSourceUnavailable     = errors.New("source unavailable")
+	ErrTooManyRequests       = errors.New("too many requests")
+	ErrJobNotFound           = errors.New("job not found")
+	ErrEmptyBatch            = errors.New("empty batch")
+	ErrBatchTooLarge         = errors.New("batch too large")
+	ErrDuplicateBatchKey     = errors.New("duplicate batch key")
+	ErrEmptyProductID        = errors.New("empty product id")
+	ErrInvalidHistoryRange   = errors.New("history from after to")
+	ErrInvalidInterval       = errors.New("invalid interval")
+	ErrHistoryDisabled       = errors.New("price history disabled")
+	ErrWatchNotFound         = errors.New("watch not found")
+	ErrInvalidWatchTarget    = errors.New("invalid watch target")
+	ErrProductNotFound       = errors.New("product not found")
+	ErrInvalidTargetPrice    = errors.New("invalid target price")
+	ErrInvalidWebhookURL     = errors.New("invalid webhook url")
+	ErrWatchesDisabled       = errors.New("watches disabled")
+	ErrScheduleNotFound      = errors.New("schedule not found")
+	ErrInvalidCron           = errors.New("invalid cron expression")
+	ErrScheduleFromConfig    = errors.New("schedule is defined in config")
+	ErrSchedulerDisabled     = errors.New("scheduler disabled")
 )
+
+// SourceBlockedError is returned when a marketplace serves a captcha or "access denied" page.
+type SourceBlockedError struct {
+	Marketplace Marketplace
+	RetryAfter  time.Duration
+}
+
+func (e *SourceBlockedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrSourceBlocked, e.Marketplace)
+}
+
+func (e *SourceBlockedError) Unwrap() error {
+	return ErrSourceBlocked
+}
+
+// SourceUnavailableError is returned without calling a marketplace while its circuit breaker is open.
+type SourceUnavailableError struct {
+	Marketplace Marketplace
+	RetryAfter  time.Duration
+}
+
+func (e *SourceUnavailableError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrSourceUnavailable, e.Marketplace)
+}
+
+func (e *SourceUnavailableError) Unwrap() error {
+	return ErrSourceUnavailable
+}
+
+// TooManyRequestsError is returned when the concurrency limit is reached and its wait queue is full.
+type TooManyRequestsError struct {
+	// Scope is the limit that rejected the request: "global" or the marketplace.
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrTooManyRequests, e.Scope)
+}
+
+func (e *TooManyRequestsError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// ArtifactError references the debug artifact captured on the failure, it's returned by the parsers
+// and kept by the services, so the id can be reported to the client.
+type ArtifactError struct {
+	ArtifactID string
+	// HARPath is the file of the network traffic of the failed page, empty if it isn't recorded.
+	// It's logged with the error but not reported to the client.
+	HARPath string
+	Err     error
+}
+
+func (e *ArtifactError) Error() string {
+	if e.HARPath != "" {
+		return fmt.Sprintf("%s (artifact %s, har %s)", e.Err, e.ArtifactID, e.HARPath)
+	}
+	return fmt.Sprintf("%s (artifact %s)", e.Err, e.ArtifactID)
+}
+
+func (e *ArtifactError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+)
+
+type Marketplace string
+
+const (
+	MarketplaceWildberries Marketplace = "wildberries"
+	MarketplaceOzon        Marketplace = "ozon"
+)
+
+var productIDPatterns = map[Marketplace]*regexp.Regexp{
+	// https://www.wildberries.ru/catalog/123456789/detail.aspx
+	MarketplaceWildberries: regexp.MustCompile(`^/catalog/(\d+)/`),
+	// https://www.ozon.ru/product/some-product-name-123456789/
+	MarketplaceOzon: regexp.MustCompile(`^/product/(?:[^/]*-)?(\d+)/?$`),
+}
+
+// Valid reports whether the marketplace is a supported one.
+func (m Marketplace) Valid() bool {
+	switch m {
+	case MarketplaceWildberries, MarketplaceOzon:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductID returns the id of the product in the marketplace parsed from its link, empty if the link isn't a product page.
+func (m Marketplace) ProductID(link string) string {
+	pattern, ok := productIDPatterns[m]
+	if !ok {
+		return ""
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	match := pattern.FindStringSubmatch(u.Path)
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
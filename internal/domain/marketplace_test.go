@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+func TestMarketplace_ProductID(t *testing.T) {
+	testCases := []struct {
+		name        string
+		marketplace domain.Marketplace
+		link        string
+		expID       string
+	}{
+		{
+			name:        "wildberries",
+			marketplace: domain.MarketplaceWildberries,
+			link:        "https://www.wildberries.ru/catalog/123456789/detail.aspx?targetUrl=SP",
+			expID:       "123456789",
+		},
+		{
+			name:        "ozon",
+			marketplace: domain.MarketplaceOzon,
+			link:        "https://www.ozon.ru/product/smartfon-apple-iphone-15-128gb-1234567890/?at=abc",
+			expID:       "1234567890",
+		},
+		{
+			name:        "ozon without name",
+			marketplace: domain.MarketplaceOzon,
+			link:        "https://www.ozon.ru/product/1234567890",
+			expID:       "1234567890",
+		},
+		{
+			name:        "not a product page",
+			marketplace: domain.MarketplaceWildberries,
+			link:        "https://www.wildberries.ru/catalog/elektronika/noutbuki",
+			expID:       "",
+		},
+		{
+			name:        "link of another marketplace",
+			marketplace: domain.MarketplaceOzon,
+			link:        "https://www.wildberries.ru/catalog/123456789/detail.aspx",
+			expID:       "",
+		},
+		{
+			name:        "unknown marketplace",
+			marketplace: domain.Marketplace("unknown"),
+			link:        "https://www.wildberries.ru/catalog/123456789/detail.aspx",
+			expID:       "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expID, tc.marketplace.ProductID(tc.link))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type ArtifactRepository interface {
+	// Save stores the artifact and returns its id.
+	Save(ctx context.Context, artifact Artifact) (string, error)
+}
+
+// Artifact is the debug information about a parser page.
+type Artifact struct {
+	Marketplace domain.Marketplace
+	Operation   string
+	Snapshot    Snapshot
+	// Error is the error the operation failed with, empty if it succeeded.
+	Error     string
+	CreatedAt time.Time
+}
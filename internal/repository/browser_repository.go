@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-rod/rod"
 )
@@ -10,5 +11,37 @@ type BrowserRepository interface {
 	Connect(ctx context.Context) (*rod.Browser, error)
 	Ping(ctx context.Context) error
 
-	NewPage(ctx context.Context) (Page, error)
-}
\ No newline at end of file
+	NewPage(ctx context.Context, opts PageOptions) (Page, error)
+	Nodes() []BrowserNode
+}
+
+type PageOptions struct {
+	// Session is the stored session restored into the page and updated by Page.SaveSession, nil for a clean page.
+	Session *SessionKey
+	// Proxy overrides the browser proxy for the page, nil to use the browser one.
+	// An empty server means a direct connection.
+	Proxy *Proxy
+}
+
+// Proxy is the outbound HTTP proxy of a page.
+type Proxy struct {
+	// Server is the proxy URL without the credentials like "http://host:3128".
+	Server   string
+	Username string
+	Password string
+	// Bypass are the hosts connected directly.
+	Bypass []string
+}
+
+// BrowserNode is the state of a remote browser the pages are opened on.
+type BrowserNode struct {
+	URL string
+	// Healthy is false if the node is ejected after failed connecting or health check.
+	Healthy     bool
+	Connections int
+	Pages       int
+	// Failures is the number of failures in a row.
+	Failures  int
+	LastError string
+	CheckedAt time.Time
+}
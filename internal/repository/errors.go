@@ -1,8 +1,40 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrGatewayTimeout      = errors.New("gateway timeout")
 	ErrClientClosedRequest = errors.New("client closed request")
+	ErrInvalidCategory     = errors.New("invalid category")
+	ErrSourceBlocked       = errors.New("source blocked")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrJobNotFound         = errors.New("job not found")
+	ErrWatchNotFound       = errors.New("watch not found")
+	ErrNoObservations      = errors.New("no observations")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrScheduleNotFound    = errors.New("schedule not found")
+
+	// ErrNavigation, ErrDOMUnstable and ErrConnectionLost are the transient browser failures, the sources are retried after them.
+	ErrNavigation     = errors.New("navigation failed")
+	ErrDOMUnstable    = errors.New("dom is not stable")
+	ErrConnectionLost = errors.New("browser connection lost")
 )
+
+// BlockedError is returned when a marketplace serves a captcha or "access denied" page instead of the requested one.
+type BlockedError struct {
+	URL        string
+	Marker     string
+	RetryAfter time.Duration
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf("%s: page %s contains %q", ErrSourceBlocked, e.URL, e.Marker)
+}
+
+func (e *BlockedError) Unwrap() error {
+	return ErrSourceBlocked
+}
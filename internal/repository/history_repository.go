@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type HistoryRepository interface {
+	// Record saves the observations of the products.
+	Record(ctx context.Context, observations []domain.PriceObservation) error
+	// Observations returns the observations of the product ordered by time, from and to limit the time if they aren't zero.
+	Observations(ctx context.Context, marketplace domain.Marketplace, productID string, from, to time.Time) ([]domain.PriceObservation, error)
+	// Latest returns the last observation of the product, ErrNoObservations if it wasn't observed.
+	Latest(ctx context.Context, marketplace domain.Marketplace, productID string) (domain.PriceObservation, error)
+	// Prune removes the observations made before the time and returns their number.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
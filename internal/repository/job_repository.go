@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type JobRepository interface {
+	// Save creates or replaces the job. The finished job expires after the TTL of the repository.
+	Save(ctx context.Context, job domain.SearchJob) error
+	// Get returns the job, ErrJobNotFound if there is no such job or it's expired.
+	Get(ctx context.Context, id string) (domain.SearchJob, error)
+}
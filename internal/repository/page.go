@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-rod/rod/lib/input"
 )
@@ -14,5 +15,44 @@ type Page interface {
 	Element(ctx context.Context, selector string) (Element, error)
 	Elements(ctx context.Context, selector string) ([]Element, error)
 	KeyboardType(ctx context.Context, key input.Key) error
+	Cookies(ctx context.Context) ([]Cookie, error)
+	SetCookies(ctx context.Context, cookies []Cookie) error
+	LocalStorage(ctx context.Context) (map[string]string, error)
+	SetLocalStorage(ctx context.Context, items map[string]string) error
+	URL(ctx context.Context) (string, error)
+	HTML(ctx context.Context) (string, error)
+	// Text returns the title and the rendered text of the page without scripts, styles and hidden elements.
+	Text(ctx context.Context) (string, error)
+	Snapshot(ctx context.Context) (Snapshot, error)
+	BlockRequests(ctx context.Context, rules RequestBlocking) error
+	// SaveSession stores the cookies and localStorage of the page, it does nothing if the page has no session.
+	SaveSession(ctx context.Context) error
 	Close() error
 }
+
+type Cookie struct {
+	Name     string
+	Value    string
+	Domain   string
+	Path     string
+	Expires  time.Time
+	HTTPOnly bool
+	Secure   bool
+}
+
+// Snapshot is the state of a page captured for debugging.
+type Snapshot struct {
+	URL        string
+	HTML       string
+	Screenshot []byte
+	// HARPath is the file the network traffic recorded until the snapshot is saved to, empty if it isn't recorded.
+	HARPath string
+}
+
+// RequestBlocking describes the requests a page fails instead of loading.
+type RequestBlocking struct {
+	// ResourceTypes are case-insensitive CDP resource types like "image" or "font".
+	ResourceTypes []string
+	// URLPatterns are glob patterns of the URLs, "*" matches any characters and "?" matches a single one.
+	URLPatterns []string
+}
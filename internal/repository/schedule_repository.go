@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type ScheduleRepository interface {
+	// SaveSchedule creates or replaces the schedule.
+	SaveSchedule(ctx context.Context, schedule domain.Schedule) error
+	// SaveScheduleRun replaces the last run of the schedule only, ErrScheduleNotFound if there is no such schedule.
+	SaveScheduleRun(ctx context.Context, id string, run domain.ScheduleRun) error
+	// GetSchedule returns the schedule, ErrScheduleNotFound if there is no such schedule.
+	GetSchedule(ctx context.Context, id string) (domain.Schedule, error)
+	// ListSchedules returns the schedules ordered by the creation time.
+	ListSchedules(ctx context.Context) ([]domain.Schedule, error)
+	// DeleteSchedule removes the schedule, ErrScheduleNotFound if there is no such schedule.
+	DeleteSchedule(ctx context.Context, id string) error
+}
+
+// ResultSink stores the results of the scheduled searches.
+type ResultSink interface {
+	Store(ctx context.Context, result domain.ScheduledResult) error
+}
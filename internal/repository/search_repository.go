@@ -7,5 +7,10 @@ import (
 )
 
 type SearchRepository interface {
-	GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)
-}
\ No newline at end of file
+	Marketplace() domain.Marketplace
+	GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error)
+	GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error)
+	// GetProduct parses the product page of the product, it returns ErrProductNotFound if the marketplace has no such product.
+	GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error)
+	GetSuggestions(ctx context.Context, prefix string) ([]string, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type SessionRepository interface {
+	// Load returns the session, ErrSessionNotFound if there is no such session or it's expired.
+	Load(ctx context.Context, key SessionKey) (Session, error)
+	// Save stores the session, the expiry is set by the repository if it's zero.
+	// The session is dropped if its generation is older than the current generation of the marketplace.
+	Save(ctx context.Context, key SessionKey, session Session) error
+	// Delete removes the sessions of the marketplace, all sessions if the marketplace is empty.
+	// It advances the generation of the marketplace, so the sessions captured before aren't saved anymore.
+	Delete(ctx context.Context, marketplace domain.Marketplace) error
+	// Generation returns the current generation of the marketplace sessions.
+	Generation(ctx context.Context, marketplace domain.Marketplace) uint64
+}
+
+// SessionKey identifies the session, the region is a part of it since the marketplaces keep the delivery address in cookies.
+type SessionKey struct {
+	Marketplace domain.Marketplace
+	Region      string
+}
+
+// Session is the browser state of a marketplace site.
+type Session struct {
+	Cookies      []Cookie
+	LocalStorage map[string]string
+	ExpiresAt    time.Time
+	// Generation is the generation the session was captured in, it isn't stored.
+	Generation uint64 `json:"-"`
+}
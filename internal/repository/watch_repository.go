@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+type WatchRepository interface {
+	// SaveWatch creates or replaces the watch.
+	SaveWatch(ctx context.Context, watch domain.Watch) error
+	// SaveWatchState replaces the state of the watch only, ErrWatchNotFound if there is no such watch.
+	SaveWatchState(ctx context.Context, id string, state domain.WatchState) error
+	// GetWatch returns the watch, ErrWatchNotFound if there is no such watch.
+	GetWatch(ctx context.Context, id string) (domain.Watch, error)
+	// ListWatches returns the watches ordered by the creation time.
+	ListWatches(ctx context.Context) ([]domain.Watch, error)
+	// DeleteWatch removes the watch and its deliveries, ErrWatchNotFound if there is no such watch.
+	DeleteWatch(ctx context.Context, id string) error
+
+	// SaveDelivery creates or replaces the delivery.
+	SaveDelivery(ctx context.Context, delivery domain.Delivery) error
+	// ListDeliveries returns the last deliveries of the watch, the newest first.
+	ListDeliveries(ctx context.Context, watchID string, limit int) ([]domain.Delivery, error)
+	// PendingDeliveries returns the pending deliveries with the next attempt due at the time, the oldest first.
+	PendingDeliveries(ctx context.Context, due time.Time, limit int) ([]domain.Delivery, error)
+}
+
+type WebhookSender interface {
+	// Send posts the notification signed with the secret to the webhook. It returns the HTTP status of the response,
+	// 0 if there was no response. A status other than 2xx is an error.
+	Send(ctx context.Context, url string, secret string, deliveryID string, notification domain.WatchNotification) (int, error)
+}
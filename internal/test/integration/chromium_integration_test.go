@@ -2,15 +2,21 @@ package integration_test
 
 import (
 	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/chromium"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
 	integr "github.com/vo1dFl0w/marketplace-parser-service/internal/test/integration"
 )
 
 func TestIntegration_ChromiumConnect(t *testing.T) {
-	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg)
+	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg, nil)
 	b := chromium.NewBrowser(chromiumRepo)
 
 	browser, err := b.Chromium().Connect(context.Background())
@@ -22,9 +28,47 @@ func TestIntegration_ChromiumConnect(t *testing.T) {
 }
 
 func TestIntegration_ChromiumPing(t *testing.T) {
-	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg)
+	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg, nil)
 	browser := chromium.NewBrowser(chromiumRepo)
 
 	err := browser.Chromium().Ping(context.Background())
 	assert.NoError(t, err)
 }
+
+func TestIntegration_ChromiumProxy(t *testing.T) {
+	// The proxy stand-in requires the credentials and answers every proxied request itself
+	var proxied atomic.Int32
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Proxy-Authorization") != auth {
+			w.Header().Set("Proxy-Authenticate", `Basic realm="proxy"`)
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+		proxied.Add(1)
+		_, _ = w.Write([]byte("<html><body>proxied " + r.Host + "</body></html>"))
+	}))
+	defer proxy.Close()
+
+	// The remote chromium can't reach the stand-in on this machine, so the local one is launched
+	cfg := *integr.Cfg
+	cfg.Browser.Mode = config.BrowserModeLocal
+	cfg.Browser.Proxy = config.ProxyConfig{}
+
+	chromiumRepo := chromium.NewChromiumRepository(&cfg, nil)
+	defer chromiumRepo.Close()
+
+	page, err := chromiumRepo.NewPage(context.Background(), repository.PageOptions{
+		Proxy: &repository.Proxy{Server: proxy.URL, Username: "user", Password: "secret"},
+	})
+	assert.NoError(t, err)
+	defer page.Close()
+
+	err = page.NavigateWithReferer(context.Background(), "http://marketplace.test/")
+	assert.NoError(t, err)
+
+	html, err := page.HTML(context.Background())
+	assert.NoError(t, err)
+	assert.Contains(t, html, "proxied marketplace.test")
+	assert.Positive(t, proxied.Load())
+}
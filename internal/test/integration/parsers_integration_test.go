@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/artifacts"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/browser/chromium"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/adapters/parsers"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
 	integr "github.com/vo1dFl0w/marketplace-parser-service/internal/test/integration"
 	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
 )
@@ -25,12 +27,12 @@ func TestIntegration_WildberriesParser(t *testing.T) {
 	loggerCfg := logger.NewLoggerConfig(integr.Cfg.Server.Env, integr.Cfg.Options.LoggerTimeFormat)
 	logger := logger.LoadLogger(loggerCfg)
 
-	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg)
+	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg, nil)
 	browserRepo := chromium.NewBrowser(chromiumRepo)
 
-	wb := parsers.NewWildberriesParser(integr.Cfg, logger, browserRepo.Chromium())
+	wb := parsers.NewWildberriesParser(integr.Cfg, logger, browserRepo.Chromium(), artifacts.NewFileSystemRepository(integr.Cfg))
 
-	res, err := wb.GetAllProducts(ctx, prodName, priceFrom, priceTo)
+	res, err := wb.GetAllProducts(ctx, domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 }
@@ -48,12 +50,12 @@ func TestIntegration_OzonParser(t *testing.T) {
 	loggerCfg := logger.NewLoggerConfig(integr.Cfg.Server.Env, integr.Cfg.Options.LoggerTimeFormat)
 	logger := logger.LoadLogger(loggerCfg)
 
-	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg)
+	chromiumRepo := chromium.NewChromiumRepository(integr.Cfg, nil)
 	browserRepo := chromium.NewBrowser(chromiumRepo)
 
-	oz := parsers.NewOzonParser(integr.Cfg, logger, browserRepo.Chromium())
+	oz := parsers.NewOzonParser(integr.Cfg, logger, browserRepo.Chromium(), artifacts.NewFileSystemRepository(integr.Cfg))
 
-	res, err := oz.GetAllProducts(ctx, prodName, priceFrom, priceTo)
+	res, err := oz.GetAllProducts(ctx, domain.SearchQuery{Name: prodName, PriceFrom: priceFrom, PriceTo: priceTo})
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 
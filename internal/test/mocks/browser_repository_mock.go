@@ -102,8 +102,8 @@ func (_c *BrowserRepositoryMock_Connect_Call) RunAndReturn(run func(ctx context.
 }
 
 // NewPage provides a mock function for the type BrowserRepositoryMock
-func (_mock *BrowserRepositoryMock) NewPage(ctx context.Context) (repository.Page, error) {
-	ret := _mock.Called(ctx)
+func (_mock *BrowserRepositoryMock) NewPage(ctx context.Context, opts repository.PageOptions) (repository.Page, error) {
+	ret := _mock.Called(ctx, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewPage")
@@ -111,18 +111,18 @@ func (_mock *BrowserRepositoryMock) NewPage(ctx context.Context) (repository.Pag
 
 	var r0 repository.Page
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (repository.Page, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PageOptions) (repository.Page, error)); ok {
+		return returnFunc(ctx, opts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) repository.Page); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PageOptions) repository.Page); ok {
+		r0 = returnFunc(ctx, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(repository.Page)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PageOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -136,18 +136,24 @@ type BrowserRepositoryMock_NewPage_Call struct {
 
 // NewPage is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *BrowserRepositoryMock_Expecter) NewPage(ctx interface{}) *BrowserRepositoryMock_NewPage_Call {
-	return &BrowserRepositoryMock_NewPage_Call{Call: _e.mock.On("NewPage", ctx)}
+//   - opts repository.PageOptions
+func (_e *BrowserRepositoryMock_Expecter) NewPage(ctx interface{}, opts interface{}) *BrowserRepositoryMock_NewPage_Call {
+	return &BrowserRepositoryMock_NewPage_Call{Call: _e.mock.On("NewPage", ctx, opts)}
 }
 
-func (_c *BrowserRepositoryMock_NewPage_Call) Run(run func(ctx context.Context)) *BrowserRepositoryMock_NewPage_Call {
+func (_c *BrowserRepositoryMock_NewPage_Call) Run(run func(ctx context.Context, opts repository.PageOptions)) *BrowserRepositoryMock_NewPage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 repository.PageOptions
+		if args[1] != nil {
+			arg1 = args[1].(repository.PageOptions)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -158,7 +164,53 @@ func (_c *BrowserRepositoryMock_NewPage_Call) Return(page repository.Page, err e
 	return _c
 }
 
-func (_c *BrowserRepositoryMock_NewPage_Call) RunAndReturn(run func(ctx context.Context) (repository.Page, error)) *BrowserRepositoryMock_NewPage_Call {
+func (_c *BrowserRepositoryMock_NewPage_Call) RunAndReturn(run func(ctx context.Context, opts repository.PageOptions) (repository.Page, error)) *BrowserRepositoryMock_NewPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nodes provides a mock function for the type BrowserRepositoryMock
+func (_mock *BrowserRepositoryMock) Nodes() []repository.BrowserNode {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nodes")
+	}
+
+	var r0 []repository.BrowserNode
+	if returnFunc, ok := ret.Get(0).(func() []repository.BrowserNode); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.BrowserNode)
+		}
+	}
+	return r0
+}
+
+// BrowserRepositoryMock_Nodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nodes'
+type BrowserRepositoryMock_Nodes_Call struct {
+	*mock.Call
+}
+
+// Nodes is a helper method to define mock.On call
+func (_e *BrowserRepositoryMock_Expecter) Nodes() *BrowserRepositoryMock_Nodes_Call {
+	return &BrowserRepositoryMock_Nodes_Call{Call: _e.mock.On("Nodes")}
+}
+
+func (_c *BrowserRepositoryMock_Nodes_Call) Run(run func()) *BrowserRepositoryMock_Nodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BrowserRepositoryMock_Nodes_Call) Return(browserNodes []repository.BrowserNode) *BrowserRepositoryMock_Nodes_Call {
+	_c.Call.Return(browserNodes)
+	return _c
+}
+
+func (_c *BrowserRepositoryMock_Nodes_Call) RunAndReturn(run func() []repository.BrowserNode) *BrowserRepositoryMock_Nodes_Call {
 	_c.Call.Return(run)
 	return _c
 }
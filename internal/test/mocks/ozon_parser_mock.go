@@ -39,8 +39,8 @@ func (_m *OzonParserMock) EXPECT() *OzonParserMock_Expecter {
 }
 
 // GetAllProducts provides a mock function for the type OzonParserMock
-func (_mock *OzonParserMock) GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	ret := _mock.Called(ctx, name, priceFrom, priceTo)
+func (_mock *OzonParserMock) GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllProducts")
@@ -48,18 +48,18 @@ func (_mock *OzonParserMock) GetAllProducts(ctx context.Context, name string, pr
 
 	var r0 []domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) ([]domain.Product, error)); ok {
-		return returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) []domain.Product); ok {
-		r0 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) []domain.Product); ok {
+		r0 = returnFunc(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, float64, float64) error); ok {
-		r1 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SearchQuery) error); ok {
+		r1 = returnFunc(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,36 +73,24 @@ type OzonParserMock_GetAllProducts_Call struct {
 
 // GetAllProducts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - priceFrom float64
-//   - priceTo float64
-func (_e *OzonParserMock_Expecter) GetAllProducts(ctx interface{}, name interface{}, priceFrom interface{}, priceTo interface{}) *OzonParserMock_GetAllProducts_Call {
-	return &OzonParserMock_GetAllProducts_Call{Call: _e.mock.On("GetAllProducts", ctx, name, priceFrom, priceTo)}
+//   - query domain.SearchQuery
+func (_e *OzonParserMock_Expecter) GetAllProducts(ctx interface{}, query interface{}) *OzonParserMock_GetAllProducts_Call {
+	return &OzonParserMock_GetAllProducts_Call{Call: _e.mock.On("GetAllProducts", ctx, query)}
 }
 
-func (_c *OzonParserMock_GetAllProducts_Call) Run(run func(ctx context.Context, name string, priceFrom float64, priceTo float64)) *OzonParserMock_GetAllProducts_Call {
+func (_c *OzonParserMock_GetAllProducts_Call) Run(run func(ctx context.Context, query domain.SearchQuery)) *OzonParserMock_GetAllProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.SearchQuery
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 float64
-		if args[2] != nil {
-			arg2 = args[2].(float64)
-		}
-		var arg3 float64
-		if args[3] != nil {
-			arg3 = args[3].(float64)
+			arg1 = args[1].(domain.SearchQuery)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
@@ -113,7 +101,7 @@ func (_c *OzonParserMock_GetAllProducts_Call) Return(products []domain.Product,
 	return _c
 }
 
-func (_c *OzonParserMock_GetAllProducts_Call) RunAndReturn(run func(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)) *OzonParserMock_GetAllProducts_Call {
+func (_c *OzonParserMock_GetAllProducts_Call) RunAndReturn(run func(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error)) *OzonParserMock_GetAllProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
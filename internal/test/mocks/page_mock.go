@@ -39,6 +39,63 @@ func (_m *PageMock) EXPECT() *PageMock_Expecter {
 	return &PageMock_Expecter{mock: &_m.Mock}
 }
 
+// BlockRequests provides a mock function for the type PageMock
+func (_mock *PageMock) BlockRequests(ctx context.Context, rules repository.RequestBlocking) error {
+	ret := _mock.Called(ctx, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockRequests")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequestBlocking) error); ok {
+		r0 = returnFunc(ctx, rules)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PageMock_BlockRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockRequests'
+type PageMock_BlockRequests_Call struct {
+	*mock.Call
+}
+
+// BlockRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rules repository.RequestBlocking
+func (_e *PageMock_Expecter) BlockRequests(ctx interface{}, rules interface{}) *PageMock_BlockRequests_Call {
+	return &PageMock_BlockRequests_Call{Call: _e.mock.On("BlockRequests", ctx, rules)}
+}
+
+func (_c *PageMock_BlockRequests_Call) Run(run func(ctx context.Context, rules repository.RequestBlocking)) *PageMock_BlockRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RequestBlocking
+		if args[1] != nil {
+			arg1 = args[1].(repository.RequestBlocking)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_BlockRequests_Call) Return(err error) *PageMock_BlockRequests_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PageMock_BlockRequests_Call) RunAndReturn(run func(ctx context.Context, rules repository.RequestBlocking) error) *PageMock_BlockRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type PageMock
 func (_mock *PageMock) Close() error {
 	ret := _mock.Called()
@@ -140,6 +197,68 @@ func (_c *PageMock_ClosePopUpWindow_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// Cookies provides a mock function for the type PageMock
+func (_mock *PageMock) Cookies(ctx context.Context) ([]repository.Cookie, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cookies")
+	}
+
+	var r0 []repository.Cookie
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]repository.Cookie, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []repository.Cookie); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Cookie)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_Cookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookies'
+type PageMock_Cookies_Call struct {
+	*mock.Call
+}
+
+// Cookies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) Cookies(ctx interface{}) *PageMock_Cookies_Call {
+	return &PageMock_Cookies_Call{Call: _e.mock.On("Cookies", ctx)}
+}
+
+func (_c *PageMock_Cookies_Call) Run(run func(ctx context.Context)) *PageMock_Cookies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_Cookies_Call) Return(cookies []repository.Cookie, err error) *PageMock_Cookies_Call {
+	_c.Call.Return(cookies, err)
+	return _c
+}
+
+func (_c *PageMock_Cookies_Call) RunAndReturn(run func(ctx context.Context) ([]repository.Cookie, error)) *PageMock_Cookies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Element provides a mock function for the type PageMock
 func (_mock *PageMock) Element(ctx context.Context, selector string) (repository.Element, error) {
 	ret := _mock.Called(ctx, selector)
@@ -276,6 +395,66 @@ func (_c *PageMock_Elements_Call) RunAndReturn(run func(ctx context.Context, sel
 	return _c
 }
 
+// HTML provides a mock function for the type PageMock
+func (_mock *PageMock) HTML(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTML")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_HTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTML'
+type PageMock_HTML_Call struct {
+	*mock.Call
+}
+
+// HTML is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) HTML(ctx interface{}) *PageMock_HTML_Call {
+	return &PageMock_HTML_Call{Call: _e.mock.On("HTML", ctx)}
+}
+
+func (_c *PageMock_HTML_Call) Run(run func(ctx context.Context)) *PageMock_HTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_HTML_Call) Return(s string, err error) *PageMock_HTML_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *PageMock_HTML_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *PageMock_HTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // KeyboardType provides a mock function for the type PageMock
 func (_mock *PageMock) KeyboardType(ctx context.Context, key input.Key) error {
 	ret := _mock.Called(ctx, key)
@@ -333,6 +512,68 @@ func (_c *PageMock_KeyboardType_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// LocalStorage provides a mock function for the type PageMock
+func (_mock *PageMock) LocalStorage(ctx context.Context) (map[string]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LocalStorage")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_LocalStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LocalStorage'
+type PageMock_LocalStorage_Call struct {
+	*mock.Call
+}
+
+// LocalStorage is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) LocalStorage(ctx interface{}) *PageMock_LocalStorage_Call {
+	return &PageMock_LocalStorage_Call{Call: _e.mock.On("LocalStorage", ctx)}
+}
+
+func (_c *PageMock_LocalStorage_Call) Run(run func(ctx context.Context)) *PageMock_LocalStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_LocalStorage_Call) Return(m map[string]string, err error) *PageMock_LocalStorage_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *PageMock_LocalStorage_Call) RunAndReturn(run func(ctx context.Context) (map[string]string, error)) *PageMock_LocalStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MoveCursorToElement provides a mock function for the type PageMock
 func (_mock *PageMock) MoveCursorToElement(ctx context.Context, elemName string) error {
 	ret := _mock.Called(ctx, elemName)
@@ -447,6 +688,351 @@ func (_c *PageMock_NavigateWithReferer_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// SaveSession provides a mock function for the type PageMock
+func (_mock *PageMock) SaveSession(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PageMock_SaveSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSession'
+type PageMock_SaveSession_Call struct {
+	*mock.Call
+}
+
+// SaveSession is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) SaveSession(ctx interface{}) *PageMock_SaveSession_Call {
+	return &PageMock_SaveSession_Call{Call: _e.mock.On("SaveSession", ctx)}
+}
+
+func (_c *PageMock_SaveSession_Call) Run(run func(ctx context.Context)) *PageMock_SaveSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_SaveSession_Call) Return(err error) *PageMock_SaveSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PageMock_SaveSession_Call) RunAndReturn(run func(ctx context.Context) error) *PageMock_SaveSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCookies provides a mock function for the type PageMock
+func (_mock *PageMock) SetCookies(ctx context.Context, cookies []repository.Cookie) error {
+	ret := _mock.Called(ctx, cookies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCookies")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []repository.Cookie) error); ok {
+		r0 = returnFunc(ctx, cookies)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PageMock_SetCookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCookies'
+type PageMock_SetCookies_Call struct {
+	*mock.Call
+}
+
+// SetCookies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cookies []repository.Cookie
+func (_e *PageMock_Expecter) SetCookies(ctx interface{}, cookies interface{}) *PageMock_SetCookies_Call {
+	return &PageMock_SetCookies_Call{Call: _e.mock.On("SetCookies", ctx, cookies)}
+}
+
+func (_c *PageMock_SetCookies_Call) Run(run func(ctx context.Context, cookies []repository.Cookie)) *PageMock_SetCookies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []repository.Cookie
+		if args[1] != nil {
+			arg1 = args[1].([]repository.Cookie)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_SetCookies_Call) Return(err error) *PageMock_SetCookies_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PageMock_SetCookies_Call) RunAndReturn(run func(ctx context.Context, cookies []repository.Cookie) error) *PageMock_SetCookies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLocalStorage provides a mock function for the type PageMock
+func (_mock *PageMock) SetLocalStorage(ctx context.Context, items map[string]string) error {
+	ret := _mock.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLocalStorage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]string) error); ok {
+		r0 = returnFunc(ctx, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PageMock_SetLocalStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLocalStorage'
+type PageMock_SetLocalStorage_Call struct {
+	*mock.Call
+}
+
+// SetLocalStorage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items map[string]string
+func (_e *PageMock_Expecter) SetLocalStorage(ctx interface{}, items interface{}) *PageMock_SetLocalStorage_Call {
+	return &PageMock_SetLocalStorage_Call{Call: _e.mock.On("SetLocalStorage", ctx, items)}
+}
+
+func (_c *PageMock_SetLocalStorage_Call) Run(run func(ctx context.Context, items map[string]string)) *PageMock_SetLocalStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_SetLocalStorage_Call) Return(err error) *PageMock_SetLocalStorage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PageMock_SetLocalStorage_Call) RunAndReturn(run func(ctx context.Context, items map[string]string) error) *PageMock_SetLocalStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function for the type PageMock
+func (_mock *PageMock) Snapshot(ctx context.Context) (repository.Snapshot, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 repository.Snapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (repository.Snapshot, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) repository.Snapshot); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(repository.Snapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type PageMock_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) Snapshot(ctx interface{}) *PageMock_Snapshot_Call {
+	return &PageMock_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx)}
+}
+
+func (_c *PageMock_Snapshot_Call) Run(run func(ctx context.Context)) *PageMock_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_Snapshot_Call) Return(snapshot repository.Snapshot, err error) *PageMock_Snapshot_Call {
+	_c.Call.Return(snapshot, err)
+	return _c
+}
+
+func (_c *PageMock_Snapshot_Call) RunAndReturn(run func(ctx context.Context) (repository.Snapshot, error)) *PageMock_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Text provides a mock function for the type PageMock
+func (_mock *PageMock) Text(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Text")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_Text_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Text'
+type PageMock_Text_Call struct {
+	*mock.Call
+}
+
+// Text is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) Text(ctx interface{}) *PageMock_Text_Call {
+	return &PageMock_Text_Call{Call: _e.mock.On("Text", ctx)}
+}
+
+func (_c *PageMock_Text_Call) Run(run func(ctx context.Context)) *PageMock_Text_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_Text_Call) Return(s string, err error) *PageMock_Text_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *PageMock_Text_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *PageMock_Text_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URL provides a mock function for the type PageMock
+func (_mock *PageMock) URL(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for URL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PageMock_URL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URL'
+type PageMock_URL_Call struct {
+	*mock.Call
+}
+
+// URL is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PageMock_Expecter) URL(ctx interface{}) *PageMock_URL_Call {
+	return &PageMock_URL_Call{Call: _e.mock.On("URL", ctx)}
+}
+
+func (_c *PageMock_URL_Call) Run(run func(ctx context.Context)) *PageMock_URL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PageMock_URL_Call) Return(s string, err error) *PageMock_URL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *PageMock_URL_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *PageMock_URL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WaitDOMStable provides a mock function for the type PageMock
 func (_mock *PageMock) WaitDOMStable(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -38,28 +38,160 @@ func (_m *ParserServiceMock) EXPECT() *ParserServiceMock_Expecter {
 	return &ParserServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// GetProductsList provides a mock function for the type ParserServiceMock
-func (_mock *ParserServiceMock) GetProductsList(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	ret := _mock.Called(ctx, name, priceFrom, priceTo)
+// GetCategoryProducts provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, query)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProductsList")
+		panic("no return value specified for GetCategoryProducts")
 	}
 
 	var r0 []domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) ([]domain.Product, error)); ok {
-		return returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CategoryQuery) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) []domain.Product); ok {
-		r0 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CategoryQuery) []domain.Product); ok {
+		r0 = returnFunc(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, float64, float64) error); ok {
-		r1 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CategoryQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ParserServiceMock_GetCategoryProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryProducts'
+type ParserServiceMock_GetCategoryProducts_Call struct {
+	*mock.Call
+}
+
+// GetCategoryProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.CategoryQuery
+func (_e *ParserServiceMock_Expecter) GetCategoryProducts(ctx interface{}, query interface{}) *ParserServiceMock_GetCategoryProducts_Call {
+	return &ParserServiceMock_GetCategoryProducts_Call{Call: _e.mock.On("GetCategoryProducts", ctx, query)}
+}
+
+func (_c *ParserServiceMock_GetCategoryProducts_Call) Run(run func(ctx context.Context, query domain.CategoryQuery)) *ParserServiceMock_GetCategoryProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CategoryQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.CategoryQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ParserServiceMock_GetCategoryProducts_Call) Return(products []domain.Product, err error) *ParserServiceMock_GetCategoryProducts_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *ParserServiceMock_GetCategoryProducts_Call) RunAndReturn(run func(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error)) *ParserServiceMock_GetCategoryProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProduct provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProduct")
+	}
+
+	var r0 domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductQuery) (domain.Product, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductQuery) domain.Product); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		r0 = ret.Get(0).(domain.Product)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ParserServiceMock_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
+type ParserServiceMock_GetProduct_Call struct {
+	*mock.Call
+}
+
+// GetProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ProductQuery
+func (_e *ParserServiceMock_Expecter) GetProduct(ctx interface{}, query interface{}) *ParserServiceMock_GetProduct_Call {
+	return &ParserServiceMock_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, query)}
+}
+
+func (_c *ParserServiceMock_GetProduct_Call) Run(run func(ctx context.Context, query domain.ProductQuery)) *ParserServiceMock_GetProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ProductQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ProductQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ParserServiceMock_GetProduct_Call) Return(product domain.Product, err error) *ParserServiceMock_GetProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ParserServiceMock_GetProduct_Call) RunAndReturn(run func(ctx context.Context, query domain.ProductQuery) (domain.Product, error)) *ParserServiceMock_GetProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductsList provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) GetProductsList(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsList")
+	}
+
+	var r0 domain.SearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) (domain.SearchResult, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) domain.SearchResult); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		r0 = ret.Get(0).(domain.SearchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SearchQuery) error); ok {
+		r1 = returnFunc(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,14 +205,133 @@ type ParserServiceMock_GetProductsList_Call struct {
 
 // GetProductsList is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - priceFrom float64
-//   - priceTo float64
-func (_e *ParserServiceMock_Expecter) GetProductsList(ctx interface{}, name interface{}, priceFrom interface{}, priceTo interface{}) *ParserServiceMock_GetProductsList_Call {
-	return &ParserServiceMock_GetProductsList_Call{Call: _e.mock.On("GetProductsList", ctx, name, priceFrom, priceTo)}
+//   - query domain.SearchQuery
+func (_e *ParserServiceMock_Expecter) GetProductsList(ctx interface{}, query interface{}) *ParserServiceMock_GetProductsList_Call {
+	return &ParserServiceMock_GetProductsList_Call{Call: _e.mock.On("GetProductsList", ctx, query)}
+}
+
+func (_c *ParserServiceMock_GetProductsList_Call) Run(run func(ctx context.Context, query domain.SearchQuery)) *ParserServiceMock_GetProductsList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SearchQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.SearchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ParserServiceMock_GetProductsList_Call) Return(searchResult domain.SearchResult, err error) *ParserServiceMock_GetProductsList_Call {
+	_c.Call.Return(searchResult, err)
+	return _c
+}
+
+func (_c *ParserServiceMock_GetProductsList_Call) RunAndReturn(run func(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error)) *ParserServiceMock_GetProductsList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSourcesStatus provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) GetSourcesStatus(ctx context.Context) []domain.SourceStatus {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSourcesStatus")
+	}
+
+	var r0 []domain.SourceStatus
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.SourceStatus); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SourceStatus)
+		}
+	}
+	return r0
+}
+
+// ParserServiceMock_GetSourcesStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSourcesStatus'
+type ParserServiceMock_GetSourcesStatus_Call struct {
+	*mock.Call
+}
+
+// GetSourcesStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ParserServiceMock_Expecter) GetSourcesStatus(ctx interface{}) *ParserServiceMock_GetSourcesStatus_Call {
+	return &ParserServiceMock_GetSourcesStatus_Call{Call: _e.mock.On("GetSourcesStatus", ctx)}
 }
 
-func (_c *ParserServiceMock_GetProductsList_Call) Run(run func(ctx context.Context, name string, priceFrom float64, priceTo float64)) *ParserServiceMock_GetProductsList_Call {
+func (_c *ParserServiceMock_GetSourcesStatus_Call) Run(run func(ctx context.Context)) *ParserServiceMock_GetSourcesStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ParserServiceMock_GetSourcesStatus_Call) Return(sourceStatuss []domain.SourceStatus) *ParserServiceMock_GetSourcesStatus_Call {
+	_c.Call.Return(sourceStatuss)
+	return _c
+}
+
+func (_c *ParserServiceMock_GetSourcesStatus_Call) RunAndReturn(run func(ctx context.Context) []domain.SourceStatus) *ParserServiceMock_GetSourcesStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuggestions provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) GetSuggestions(ctx context.Context, prefix string) ([]domain.Suggestions, error) {
+	ret := _mock.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuggestions")
+	}
+
+	var r0 []domain.Suggestions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Suggestions, error)); ok {
+		return returnFunc(ctx, prefix)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Suggestions); ok {
+		r0 = returnFunc(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Suggestions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ParserServiceMock_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type ParserServiceMock_GetSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+func (_e *ParserServiceMock_Expecter) GetSuggestions(ctx interface{}, prefix interface{}) *ParserServiceMock_GetSuggestions_Call {
+	return &ParserServiceMock_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions", ctx, prefix)}
+}
+
+func (_c *ParserServiceMock_GetSuggestions_Call) Run(run func(ctx context.Context, prefix string)) *ParserServiceMock_GetSuggestions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -90,30 +341,83 @@ func (_c *ParserServiceMock_GetProductsList_Call) Run(run func(ctx context.Conte
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 float64
-		if args[2] != nil {
-			arg2 = args[2].(float64)
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ParserServiceMock_GetSuggestions_Call) Return(suggestionss []domain.Suggestions, err error) *ParserServiceMock_GetSuggestions_Call {
+	_c.Call.Return(suggestionss, err)
+	return _c
+}
+
+func (_c *ParserServiceMock_GetSuggestions_Call) RunAndReturn(run func(ctx context.Context, prefix string) ([]domain.Suggestions, error)) *ParserServiceMock_GetSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamProductsList provides a mock function for the type ParserServiceMock
+func (_mock *ParserServiceMock) StreamProductsList(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult)) error {
+	ret := _mock.Called(ctx, query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamProductsList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery, func(domain.SourceResult)) error); ok {
+		r0 = returnFunc(ctx, query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ParserServiceMock_StreamProductsList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamProductsList'
+type ParserServiceMock_StreamProductsList_Call struct {
+	*mock.Call
+}
+
+// StreamProductsList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.SearchQuery
+//   - fn func(domain.SourceResult)
+func (_e *ParserServiceMock_Expecter) StreamProductsList(ctx interface{}, query interface{}, fn interface{}) *ParserServiceMock_StreamProductsList_Call {
+	return &ParserServiceMock_StreamProductsList_Call{Call: _e.mock.On("StreamProductsList", ctx, query, fn)}
+}
+
+func (_c *ParserServiceMock_StreamProductsList_Call) Run(run func(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult))) *ParserServiceMock_StreamProductsList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SearchQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.SearchQuery)
 		}
-		var arg3 float64
-		if args[3] != nil {
-			arg3 = args[3].(float64)
+		var arg2 func(domain.SourceResult)
+		if args[2] != nil {
+			arg2 = args[2].(func(domain.SourceResult))
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *ParserServiceMock_GetProductsList_Call) Return(products []domain.Product, err error) *ParserServiceMock_GetProductsList_Call {
-	_c.Call.Return(products, err)
+func (_c *ParserServiceMock_StreamProductsList_Call) Return(err error) *ParserServiceMock_StreamProductsList_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *ParserServiceMock_GetProductsList_Call) RunAndReturn(run func(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)) *ParserServiceMock_GetProductsList_Call {
+func (_c *ParserServiceMock_StreamProductsList_Call) RunAndReturn(run func(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult)) error) *ParserServiceMock_StreamProductsList_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,8 +39,8 @@ func (_m *SearchRepositoryMock) EXPECT() *SearchRepositoryMock_Expecter {
 }
 
 // GetAllProducts provides a mock function for the type SearchRepositoryMock
-func (_mock *SearchRepositoryMock) GetAllProducts(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	ret := _mock.Called(ctx, name, priceFrom, priceTo)
+func (_mock *SearchRepositoryMock) GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllProducts")
@@ -48,18 +48,18 @@ func (_mock *SearchRepositoryMock) GetAllProducts(ctx context.Context, name stri
 
 	var r0 []domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) ([]domain.Product, error)); ok {
-		return returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, float64, float64) []domain.Product); ok {
-		r0 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SearchQuery) []domain.Product); ok {
+		r0 = returnFunc(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, float64, float64) error); ok {
-		r1 = returnFunc(ctx, name, priceFrom, priceTo)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SearchQuery) error); ok {
+		r1 = returnFunc(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,47 +73,281 @@ type SearchRepositoryMock_GetAllProducts_Call struct {
 
 // GetAllProducts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - priceFrom float64
-//   - priceTo float64
-func (_e *SearchRepositoryMock_Expecter) GetAllProducts(ctx interface{}, name interface{}, priceFrom interface{}, priceTo interface{}) *SearchRepositoryMock_GetAllProducts_Call {
-	return &SearchRepositoryMock_GetAllProducts_Call{Call: _e.mock.On("GetAllProducts", ctx, name, priceFrom, priceTo)}
+//   - query domain.SearchQuery
+func (_e *SearchRepositoryMock_Expecter) GetAllProducts(ctx interface{}, query interface{}) *SearchRepositoryMock_GetAllProducts_Call {
+	return &SearchRepositoryMock_GetAllProducts_Call{Call: _e.mock.On("GetAllProducts", ctx, query)}
 }
 
-func (_c *SearchRepositoryMock_GetAllProducts_Call) Run(run func(ctx context.Context, name string, priceFrom float64, priceTo float64)) *SearchRepositoryMock_GetAllProducts_Call {
+func (_c *SearchRepositoryMock_GetAllProducts_Call) Run(run func(ctx context.Context, query domain.SearchQuery)) *SearchRepositoryMock_GetAllProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.SearchQuery
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.SearchQuery)
 		}
-		var arg2 float64
-		if args[2] != nil {
-			arg2 = args[2].(float64)
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetAllProducts_Call) Return(products []domain.Product, err error) *SearchRepositoryMock_GetAllProducts_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetAllProducts_Call) RunAndReturn(run func(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error)) *SearchRepositoryMock_GetAllProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryProducts provides a mock function for the type SearchRepositoryMock
+func (_mock *SearchRepositoryMock) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryProducts")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CategoryQuery) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CategoryQuery) []domain.Product); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
 		}
-		var arg3 float64
-		if args[3] != nil {
-			arg3 = args[3].(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CategoryQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchRepositoryMock_GetCategoryProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryProducts'
+type SearchRepositoryMock_GetCategoryProducts_Call struct {
+	*mock.Call
+}
+
+// GetCategoryProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.CategoryQuery
+func (_e *SearchRepositoryMock_Expecter) GetCategoryProducts(ctx interface{}, query interface{}) *SearchRepositoryMock_GetCategoryProducts_Call {
+	return &SearchRepositoryMock_GetCategoryProducts_Call{Call: _e.mock.On("GetCategoryProducts", ctx, query)}
+}
+
+func (_c *SearchRepositoryMock_GetCategoryProducts_Call) Run(run func(ctx context.Context, query domain.CategoryQuery)) *SearchRepositoryMock_GetCategoryProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CategoryQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.CategoryQuery)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *SearchRepositoryMock_GetAllProducts_Call) Return(products []domain.Product, err error) *SearchRepositoryMock_GetAllProducts_Call {
+func (_c *SearchRepositoryMock_GetCategoryProducts_Call) Return(products []domain.Product, err error) *SearchRepositoryMock_GetCategoryProducts_Call {
 	_c.Call.Return(products, err)
 	return _c
 }
 
-func (_c *SearchRepositoryMock_GetAllProducts_Call) RunAndReturn(run func(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)) *SearchRepositoryMock_GetAllProducts_Call {
+func (_c *SearchRepositoryMock_GetCategoryProducts_Call) RunAndReturn(run func(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error)) *SearchRepositoryMock_GetCategoryProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProduct provides a mock function for the type SearchRepositoryMock
+func (_mock *SearchRepositoryMock) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProduct")
+	}
+
+	var r0 domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductQuery) (domain.Product, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProductQuery) domain.Product); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		r0 = ret.Get(0).(domain.Product)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProductQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchRepositoryMock_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
+type SearchRepositoryMock_GetProduct_Call struct {
+	*mock.Call
+}
+
+// GetProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ProductQuery
+func (_e *SearchRepositoryMock_Expecter) GetProduct(ctx interface{}, query interface{}) *SearchRepositoryMock_GetProduct_Call {
+	return &SearchRepositoryMock_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, query)}
+}
+
+func (_c *SearchRepositoryMock_GetProduct_Call) Run(run func(ctx context.Context, query domain.ProductQuery)) *SearchRepositoryMock_GetProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ProductQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ProductQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetProduct_Call) Return(product domain.Product, err error) *SearchRepositoryMock_GetProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetProduct_Call) RunAndReturn(run func(ctx context.Context, query domain.ProductQuery) (domain.Product, error)) *SearchRepositoryMock_GetProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuggestions provides a mock function for the type SearchRepositoryMock
+func (_mock *SearchRepositoryMock) GetSuggestions(ctx context.Context, prefix string) ([]string, error) {
+	ret := _mock.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuggestions")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, prefix)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchRepositoryMock_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type SearchRepositoryMock_GetSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+func (_e *SearchRepositoryMock_Expecter) GetSuggestions(ctx interface{}, prefix interface{}) *SearchRepositoryMock_GetSuggestions_Call {
+	return &SearchRepositoryMock_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions", ctx, prefix)}
+}
+
+func (_c *SearchRepositoryMock_GetSuggestions_Call) Run(run func(ctx context.Context, prefix string)) *SearchRepositoryMock_GetSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetSuggestions_Call) Return(ss []string, err error) *SearchRepositoryMock_GetSuggestions_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *SearchRepositoryMock_GetSuggestions_Call) RunAndReturn(run func(ctx context.Context, prefix string) ([]string, error)) *SearchRepositoryMock_GetSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Marketplace provides a mock function for the type SearchRepositoryMock
+func (_mock *SearchRepositoryMock) Marketplace() domain.Marketplace {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Marketplace")
+	}
+
+	var r0 domain.Marketplace
+	if returnFunc, ok := ret.Get(0).(func() domain.Marketplace); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(domain.Marketplace)
+	}
+	return r0
+}
+
+// SearchRepositoryMock_Marketplace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Marketplace'
+type SearchRepositoryMock_Marketplace_Call struct {
+	*mock.Call
+}
+
+// Marketplace is a helper method to define mock.On call
+func (_e *SearchRepositoryMock_Expecter) Marketplace() *SearchRepositoryMock_Marketplace_Call {
+	return &SearchRepositoryMock_Marketplace_Call{Call: _e.mock.On("Marketplace")}
+}
+
+func (_c *SearchRepositoryMock_Marketplace_Call) Run(run func()) *SearchRepositoryMock_Marketplace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SearchRepositoryMock_Marketplace_Call) Return(marketplace domain.Marketplace) *SearchRepositoryMock_Marketplace_Call {
+	_c.Call.Return(marketplace)
+	return _c
+}
+
+func (_c *SearchRepositoryMock_Marketplace_Call) RunAndReturn(run func() domain.Marketplace) *SearchRepositoryMock_Marketplace_Call {
 	_c.Call.Return(run)
 	return _c
 }
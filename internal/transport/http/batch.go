@@ -0,0 +1,148 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+const (
+	SearchBatchPath = "/api/v1/marketplace-parser-service/products/search/batch"
+
+	// maxBatchRequestSize limits the body of the batch request read by SearchBatchStream.
+	maxBatchRequestSize = 10 << 20
+)
+
+func (h *Handler) APIV1MarketplaceParserServiceProductsSearchBatchPost(ctx context.Context, req *httpgen.SearchBatchRequest) (httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostRes, error) {
+	res := &httpgen.SearchBatchResponse{Results: make(httpgen.SearchBatchResponseResults, len(req.Queries))}
+
+	err := h.batchSrv.SearchBatch(ctx, toBatchQueries(req), func(r domain.BatchResult) {
+		res.Results[r.Key] = h.toBatchQueryResult(ctx, r)
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSearchBatchErrResp(), nil
+	}
+
+	return res, nil
+}
+
+// SearchBatchStream runs the batch search like the batch endpoint, but writes the result of every query
+// as a JSON line as soon as the query is finished. The errors before the first line are returned as JSON.
+func (h *Handler) SearchBatchStream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeSearchBatchRequest(http.MaxBytesReader(w, r.Body, maxBatchRequestSize))
+	if err != nil {
+		httpErr := &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+		h.LogHTTPError(ctx, err, httpErr)
+		writeErrorResponse(w, httpErr)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	started := false
+	err = h.batchSrv.SearchBatch(ctx, toBatchQueries(req), func(res domain.BatchResult) {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+		}
+
+		line := h.toBatchQueryResult(ctx, res)
+		b, err := line.MarshalJSON()
+		if err == nil {
+			_, err = w.Write(append(b, '\n'))
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			h.logger.Warn("write batch result", "key", res.Key, "err", err)
+		}
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		if !started {
+			writeErrorResponse(w, httpErr)
+		}
+	}
+}
+
+func decodeSearchBatchRequest(body io.Reader) (*httpgen.SearchBatchRequest, error) {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	req := &httpgen.SearchBatchRequest{}
+	if err := req.UnmarshalJSON(b); err != nil {
+		return nil, fmt.Errorf("decode body: %w", err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("validate body: %w", err)
+	}
+
+	return req, nil
+}
+
+// acceptsNDJSON reports whether the client accepts the streamed NDJSON response.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == "application/x-ndjson" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toBatchQueries(req *httpgen.SearchBatchRequest) []domain.BatchQuery {
+	res := make([]domain.BatchQuery, 0, len(req.Queries))
+	for _, q := range req.Queries {
+		query := domain.BatchQuery{
+			Key: q.Key.Or(q.Name),
+			Query: domain.SearchQuery{
+				Name:        q.Name,
+				PriceFrom:   q.PriceFrom.Value,
+				PriceTo:     q.PriceTo.Value,
+				InStockOnly: q.InStockOnly.Value,
+				Region:      q.Region.Value,
+			},
+		}
+		for _, m := range q.Marketplaces {
+			query.Query.Marketplaces = append(query.Query.Marketplaces, domain.Marketplace(m))
+		}
+		res = append(res, query)
+	}
+
+	return res
+}
+
+func (h *Handler) toBatchQueryResult(ctx context.Context, r domain.BatchResult) httpgen.BatchQueryResult {
+	res := httpgen.BatchQueryResult{Key: r.Key, Products: make([]httpgen.Product, 0, len(r.Result.Products))}
+	if r.Err != nil {
+		httpErr := MapError(r.Err)
+		h.LogHTTPError(ctx, r.Err, httpErr)
+		res.Error = httpgen.NewOptErrorResponse(httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status, ArtifactId: httpErr.optArtifactID()})
+		return res
+	}
+
+	for _, p := range r.Result.Products {
+		res.Products = append(res.Products, toProductResp(p))
+	}
+	for _, m := range r.Result.TimedOut {
+		res.TimedOutSources = append(res.TimedOutSources, httpgen.Marketplace(m))
+	}
+
+	return res
+}
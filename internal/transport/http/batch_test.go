@@ -0,0 +1,130 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsSearchBatchPost(t *testing.T) {
+	queries := []domain.BatchQuery{
+		{Key: "juicer", Query: domain.SearchQuery{Name: "juicer", PriceTo: 500.0, Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}}},
+		{Key: "kettle-cheap", Query: domain.SearchQuery{Name: "kettle", PriceTo: 100.0}},
+	}
+	req := &httpgen.SearchBatchRequest{Queries: []httpgen.BatchQuery{
+		{Name: "juicer", PriceTo: httpgen.NewOptFloat64(500.0), Marketplaces: []httpgen.Marketplace{httpgen.MarketplaceOzon}},
+		{Key: httpgen.NewOptString("kettle-cheap"), Name: "kettle", PriceTo: httpgen.NewOptFloat64(100.0)},
+	}}
+
+	t.Run("results", func(t *testing.T) {
+		batchSrvMock := &mocks.BatchServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Batch: batchSrvMock}, time.Second*30)
+
+		batchSrvMock.On("SearchBatch", mock.Anything, queries, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.BatchResult))
+			fn(domain.BatchResult{Key: "juicer", Result: domain.SearchResult{Products: []domain.Product{{Name: "juicer", Link: "link1", Price: 400.0}}}})
+			fn(domain.BatchResult{Key: "kettle-cheap", Err: domain.ErrGatewayTimeout})
+		}).Return(nil).Once()
+		loggerMock.On("Error", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceProductsSearchBatchPost(context.Background(), req)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchBatchResponse)
+		assert.True(t, ok)
+		assert.Len(t, resp.Results["juicer"].Products, 1)
+		assert.False(t, resp.Results["juicer"].Error.IsSet())
+		assert.Equal(t, http.StatusGatewayTimeout, resp.Results["kettle-cheap"].Error.Value.Status)
+
+		batchSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		batchSrvMock := &mocks.BatchServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Batch: batchSrvMock}, time.Second*30)
+
+		batchSrvMock.On("SearchBatch", mock.Anything, queries, mock.Anything).Return(domain.ErrDuplicateBatchKey).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceProductsSearchBatchPost(context.Background(), req)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostBadRequest)
+		assert.True(t, ok)
+
+		batchSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_SearchBatchStream(t *testing.T) {
+	body := `{"queries":[{"name":"juicer","priceTo":500},{"key":"kettle-cheap","name":"kettle"}]}`
+
+	t.Run("ndjson", func(t *testing.T) {
+		batchSrvMock := &mocks.BatchServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Batch: batchSrvMock}, time.Second*30)
+
+		queries := []domain.BatchQuery{
+			{Key: "juicer", Query: domain.SearchQuery{Name: "juicer", PriceTo: 500.0}},
+			{Key: "kettle-cheap", Query: domain.SearchQuery{Name: "kettle"}},
+		}
+		batchSrvMock.On("SearchBatch", mock.Anything, queries, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.BatchResult))
+			fn(domain.BatchResult{Key: "kettle-cheap", Result: domain.SearchResult{TimedOut: []domain.Marketplace{domain.MarketplaceOzon}}})
+			fn(domain.BatchResult{Key: "juicer", Result: domain.SearchResult{Products: []domain.Product{{Name: "juicer", Link: "link1", Price: 400.0}}}})
+		}).Return(nil).Once()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, ht.SearchBatchPath, strings.NewReader(body))
+		req.Header.Set("Accept", "application/x-ndjson")
+		handler.Routes(http.NotFoundHandler()).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `{"key":"kettle-cheap","products":[],"timedOutSources":["ozon"]}`+"\n"+
+			`{"key":"juicer","products":[{"name":"juicer","link":"link1","price":400,"rating":0,"reviewsCount":0,"inStock":false}]}`+"\n", rec.Body.String())
+
+		batchSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		batchSrvMock := &mocks.BatchServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Batch: batchSrvMock}, time.Second*30)
+
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, ht.SearchBatchPath, strings.NewReader(`{"queries":[{"name":"juicer","marketplaces":["yandex"]}]}`))
+		req.Header.Set("Accept", "application/x-ndjson")
+		handler.Routes(http.NotFoundHandler()).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		batchSrvMock.AssertNotCalled(t, "SearchBatch", mock.Anything, mock.Anything, mock.Anything)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Batch: &mocks.BatchServiceMock{}}, time.Second*30)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, ht.SearchBatchPath, strings.NewReader(body))
+		handler.Routes(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+	})
+}
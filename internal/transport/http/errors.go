@@ -2,6 +2,7 @@ package http
 
 import (
 	"errors"
+	"math"
 	"net/http"
 
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
@@ -17,11 +18,19 @@ var (
 	ErrClientClosedRequest = errors.New("client closed request")
 	ErrGatewayTimeout      = errors.New("gateway timeout")
 	ErrInternalServerError = errors.New("internal server error")
+	ErrServiceUnavailable  = errors.New("service unavailable")
+	ErrTooManyRequests     = errors.New("too many requests")
+	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
 )
 
 type HTTPError struct {
 	Message string
 	Status  int
+	// RetryAfter is the number of seconds the client should wait before retrying, zero if unknown.
+	RetryAfter int
+	// ArtifactID is the id of the debug artifact captured on the failure, empty if there is none.
+	ArtifactID string
 }
 
 func (e *HTTPError) Error() string {
@@ -31,17 +40,253 @@ func (e *HTTPError) Error() string {
 func (e *HTTPError) ToSearchProductErrResp() httpgen.APIV1MarketplaceParserServiceProductsSearchGetRes {
 	switch e.Status {
 	case http.StatusBadRequest:
-		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetBadRequest{Message: e.Message, Status: e.Status}
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetBadRequest{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
 	case StatusClientClosedRequest:
-		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetCode499{Message: e.Message, Status: e.Status}
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetCode499{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case http.StatusTooManyRequests:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSearchGetTooManyRequests)(e.toErrorResponseHeaders())
+	case http.StatusServiceUnavailable:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSearchGetServiceUnavailable)(e.toErrorResponseHeaders())
 	case http.StatusGatewayTimeout:
-		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetGatewayTimeout{Message: e.Message, Status: e.Status}
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetGatewayTimeout{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
 	default:
-		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetInternalServerError{Message: e.Message, Status: e.Status}
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchGetInternalServerError{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
 	}
 }
 
+func (e *HTTPError) ToSearchStreamErrResp() httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetBadRequest{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetCode499{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case http.StatusTooManyRequests:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetTooManyRequests)(e.toErrorResponseHeaders())
+	case http.StatusServiceUnavailable:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetServiceUnavailable)(e.toErrorResponseHeaders())
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetGatewayTimeout{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetInternalServerError{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	}
+}
+
+func (e *HTTPError) ToCategoryProductErrResp() httpgen.APIV1MarketplaceParserServiceProductsCategoryGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsCategoryGetBadRequest{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsCategoryGetCode499{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case http.StatusTooManyRequests:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetTooManyRequests)(e.toErrorResponseHeaders())
+	case http.StatusServiceUnavailable:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetServiceUnavailable)(e.toErrorResponseHeaders())
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketplaceParserServiceProductsCategoryGetGatewayTimeout{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceProductsCategoryGetInternalServerError{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	}
+}
+
+func (e *HTTPError) ToSuggestionsErrResp() httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetBadRequest{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetCode499{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	case http.StatusTooManyRequests:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetTooManyRequests)(e.toErrorResponseHeaders())
+	case http.StatusServiceUnavailable:
+		return (*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetServiceUnavailable)(e.toErrorResponseHeaders())
+	case http.StatusGatewayTimeout:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetGatewayTimeout{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetInternalServerError{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}
+	}
+}
+
+func (e *HTTPError) ToResetSessionsErrResp() httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteBadRequest{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToSearchBatchErrResp() httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostBadRequest{Message: e.Message, Status: e.Status}
+	case StatusClientClosedRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostCode499{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceProductsSearchBatchPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCreateSearchJobErrResp() httpgen.APIV1MarketplaceParserServiceSearchJobsPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsPostBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusTooManyRequests:
+		return e.toErrorResponseHeaders()
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToGetSearchJobErrResp() httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCancelSearchJobErrResp() httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToPriceHistoryErrResp() httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCreateWatchErrResp() httpgen.APIV1MarketplaceParserServiceWatchesPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesPostBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesPostNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToListWatchesErrResp() httpgen.APIV1MarketplaceParserServiceWatchesGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToGetWatchErrResp() httpgen.APIV1MarketplaceParserServiceWatchesIDGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToUpdateWatchErrResp() httpgen.APIV1MarketplaceParserServiceWatchesIDPutRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDPutBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDPutNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDPutInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDeleteWatchErrResp() httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToListDeliveriesErrResp() httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToListSchedulesErrResp() httpgen.APIV1MarketplaceParserServiceAdminSchedulesGetRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesGetNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesGetInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToCreateScheduleErrResp() httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostBadRequest{Message: e.Message, Status: e.Status}
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostNotFound{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) ToDeleteScheduleErrResp() httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes {
+	switch e.Status {
+	case http.StatusNotFound:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNotFound{Message: e.Message, Status: e.Status}
+	case http.StatusConflict:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteConflict{Message: e.Message, Status: e.Status}
+	default:
+		return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteInternalServerError{Message: e.Message, Status: e.Status}
+	}
+}
+
+func (e *HTTPError) optArtifactID() httpgen.OptString {
+	if e.ArtifactID == "" {
+		return httpgen.OptString{}
+	}
+
+	return httpgen.NewOptString(e.ArtifactID)
+}
+
+func (e *HTTPError) toErrorResponseHeaders() *httpgen.ErrorResponseHeaders {
+	res := &httpgen.ErrorResponseHeaders{Response: httpgen.ErrorResponse{Message: e.Message, Status: e.Status, ArtifactId: e.optArtifactID()}}
+	if e.RetryAfter > 0 {
+		res.RetryAfter = httpgen.NewOptInt(e.RetryAfter)
+	}
+
+	return res
+}
+
+// MapError converts the error to the HTTP error, the debug artifact id of the error is kept.
 func MapError(err error) *HTTPError {
+	httpErr := mapError(err)
+
+	var artifactErr *domain.ArtifactError
+	if errors.As(err, &artifactErr) {
+		httpErr.ArtifactID = artifactErr.ArtifactID
+	}
+
+	return httpErr
+}
+
+func mapError(err error) *HTTPError {
+	var blockedErr *domain.SourceBlockedError
+	var unavailableErr *domain.SourceUnavailableError
+	var tooManyErr *domain.TooManyRequestsError
 	switch {
 	case errors.Is(err, domain.ErrEmptyProductName):
 		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
@@ -53,6 +298,66 @@ func MapError(err error) *HTTPError {
 		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
 	case errors.Is(err, domain.ErrPriceToBelowZero):
 		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrEmptyCategory):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidCategory):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrUnknownMarketplace):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrEmptyPrefix):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrEmptyBatch):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrBatchTooLarge):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrDuplicateBatchKey):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrEmptyProductID):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidHistoryRange):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidInterval):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrJobNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrHistoryDisabled):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrWatchNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrWatchesDisabled):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrInvalidWatchTarget):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidTargetPrice):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrInvalidWebhookURL):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrScheduleNotFound):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrSchedulerDisabled):
+		return &HTTPError{Message: ErrNotFound.Error(), Status: http.StatusNotFound}
+	case errors.Is(err, domain.ErrInvalidCron):
+		return &HTTPError{Message: ErrBadRequest.Error(), Status: http.StatusBadRequest}
+	case errors.Is(err, domain.ErrScheduleFromConfig):
+		return &HTTPError{Message: ErrConflict.Error(), Status: http.StatusConflict}
+	case errors.As(err, &blockedErr):
+		return &HTTPError{
+			Message:    ErrServiceUnavailable.Error(),
+			Status:     http.StatusServiceUnavailable,
+			RetryAfter: int(math.Ceil(blockedErr.RetryAfter.Seconds())),
+		}
+	case errors.As(err, &unavailableErr):
+		return &HTTPError{
+			Message:    unavailableErr.Error(),
+			Status:     http.StatusServiceUnavailable,
+			RetryAfter: int(math.Ceil(unavailableErr.RetryAfter.Seconds())),
+		}
+	case errors.As(err, &tooManyErr):
+		return &HTTPError{
+			Message:    ErrTooManyRequests.Error(),
+			Status:     http.StatusTooManyRequests,
+			RetryAfter: int(math.Ceil(tooManyErr.RetryAfter.Seconds())),
+		}
 	case errors.Is(err, domain.ErrGatewayTimeout):
 		return &HTTPError{Message: ErrGatewayTimeout.Error(), Status: http.StatusGatewayTimeout}
 	default:
@@ -1,10 +1,13 @@
 package http_test
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
 	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
 )
@@ -38,6 +41,14 @@ func TestErrors_ToSearchProductErrResp(t *testing.T) {
 			name:    "Gateway Timeout",
 			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusGatewayTimeout},
 		},
+		{
+			name:    "Too Many Requests",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusTooManyRequests, RetryAfter: 5},
+		},
+		{
+			name:    "Service Unavailable",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusServiceUnavailable, RetryAfter: 300},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -55,6 +66,14 @@ func TestErrors_ToSearchProductErrResp(t *testing.T) {
 			case http.StatusInternalServerError:
 				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetInternalServerError)
 				assert.True(t, ok)
+			case http.StatusTooManyRequests:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetTooManyRequests)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(5), resp.RetryAfter)
+			case http.StatusServiceUnavailable:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetServiceUnavailable)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(300), resp.RetryAfter)
 			case http.StatusGatewayTimeout:
 				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetGatewayTimeout)
 				assert.True(t, ok)
@@ -62,3 +81,169 @@ func TestErrors_ToSearchProductErrResp(t *testing.T) {
 		})
 	}
 }
+
+func TestErrors_ToCategoryProductErrResp(t *testing.T) {
+	testCases := []struct {
+		name    string
+		httpErr *ht.HTTPError
+	}{
+		{
+			name:    "Bad Request",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusBadRequest},
+		},
+		{
+			name:    "Client Closed Request",
+			httpErr: &ht.HTTPError{Message: "msg", Status: ht.StatusClientClosedRequest},
+		},
+		{
+			name:    "Internal Server Error",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusInternalServerError},
+		},
+		{
+			name:    "Gateway Timeout",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusGatewayTimeout},
+		},
+		{
+			name:    "Too Many Requests",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusTooManyRequests, RetryAfter: 5},
+		},
+		{
+			name:    "Service Unavailable",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusServiceUnavailable, RetryAfter: 300},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.httpErr.ToCategoryProductErrResp()
+			assert.NotNil(t, res)
+
+			switch tc.httpErr.Status {
+			case http.StatusBadRequest:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetBadRequest)
+				assert.True(t, ok)
+			case ht.StatusClientClosedRequest:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetCode499)
+				assert.True(t, ok)
+			case http.StatusInternalServerError:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetInternalServerError)
+				assert.True(t, ok)
+			case http.StatusTooManyRequests:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetTooManyRequests)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(5), resp.RetryAfter)
+			case http.StatusServiceUnavailable:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetServiceUnavailable)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(300), resp.RetryAfter)
+			case http.StatusGatewayTimeout:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetGatewayTimeout)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestErrors_ToSuggestionsErrResp(t *testing.T) {
+	testCases := []struct {
+		name    string
+		httpErr *ht.HTTPError
+	}{
+		{
+			name:    "Bad Request",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusBadRequest},
+		},
+		{
+			name:    "Client Closed Request",
+			httpErr: &ht.HTTPError{Message: "msg", Status: ht.StatusClientClosedRequest},
+		},
+		{
+			name:    "Internal Server Error",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusInternalServerError},
+		},
+		{
+			name:    "Gateway Timeout",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusGatewayTimeout},
+		},
+		{
+			name:    "Too Many Requests",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusTooManyRequests, RetryAfter: 5},
+		},
+		{
+			name:    "Service Unavailable",
+			httpErr: &ht.HTTPError{Message: "msg", Status: http.StatusServiceUnavailable, RetryAfter: 300},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.httpErr.ToSuggestionsErrResp()
+			assert.NotNil(t, res)
+
+			switch tc.httpErr.Status {
+			case http.StatusBadRequest:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetBadRequest)
+				assert.True(t, ok)
+			case ht.StatusClientClosedRequest:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetCode499)
+				assert.True(t, ok)
+			case http.StatusInternalServerError:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetInternalServerError)
+				assert.True(t, ok)
+			case http.StatusTooManyRequests:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetTooManyRequests)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(5), resp.RetryAfter)
+			case http.StatusServiceUnavailable:
+				resp, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetServiceUnavailable)
+				assert.True(t, ok)
+				assert.Equal(t, httpgen.NewOptInt(300), resp.RetryAfter)
+			case http.StatusGatewayTimeout:
+				_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetGatewayTimeout)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestErrors_MapError(t *testing.T) {
+	t.Run("source blocked", func(t *testing.T) {
+		err := fmt.Errorf("get products: %w", &domain.SourceBlockedError{Marketplace: domain.MarketplaceOzon, RetryAfter: 90500 * time.Millisecond})
+
+		httpErr := ht.MapError(err)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Status)
+		assert.Equal(t, ht.ErrServiceUnavailable.Error(), httpErr.Message)
+		assert.Equal(t, 91, httpErr.RetryAfter)
+	})
+
+	t.Run("source unavailable", func(t *testing.T) {
+		httpErr := ht.MapError(&domain.SourceUnavailableError{Marketplace: domain.MarketplaceOzon, RetryAfter: 30 * time.Second})
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Status)
+		assert.Equal(t, "source unavailable: ozon", httpErr.Message)
+		assert.Equal(t, 30, httpErr.RetryAfter)
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		httpErr := ht.MapError(&domain.TooManyRequestsError{Scope: "global", RetryAfter: 4500 * time.Millisecond})
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.Status)
+		assert.Equal(t, ht.ErrTooManyRequests.Error(), httpErr.Message)
+		assert.Equal(t, 5, httpErr.RetryAfter)
+	})
+
+	t.Run("gateway timeout", func(t *testing.T) {
+		httpErr := ht.MapError(domain.ErrGatewayTimeout)
+		assert.Equal(t, http.StatusGatewayTimeout, httpErr.Status)
+		assert.Zero(t, httpErr.RetryAfter)
+		assert.Empty(t, httpErr.ArtifactID)
+	})
+
+	t.Run("debug artifact", func(t *testing.T) {
+		httpErr := ht.MapError(&domain.ArtifactError{ArtifactID: "artifact-id", Err: domain.ErrGatewayTimeout})
+		assert.Equal(t, http.StatusGatewayTimeout, httpErr.Status)
+		assert.Equal(t, "artifact-id", httpErr.ArtifactID)
+
+		res, ok := httpErr.ToSearchProductErrResp().(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetGatewayTimeout)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.NewOptString("artifact-id"), res.ArtifactId)
+	})
+}
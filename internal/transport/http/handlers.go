@@ -2,9 +2,12 @@ package http
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
 	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
@@ -14,50 +17,251 @@ type Handler struct {
 	logger         logger.Logger
 	router         *http.ServeMux
 	parserSrv      usecase.ParserService
+	browserSrv     usecase.BrowserService
+	jobSrv         usecase.JobService
+	batchSrv       usecase.BatchService
+	historySrv     usecase.HistoryService
+	watchSrv       usecase.WatchService
+	schedulerSrv   usecase.SchedulerService
 	requestTimeout time.Duration
 }
 
-func NewHandler(logger logger.Logger, parserSrv usecase.ParserService, requestTimeout time.Duration) *Handler {
-	return &Handler{logger: logger, router: http.NewServeMux(), parserSrv: parserSrv, requestTimeout: requestTimeout}
+// Services are the use cases served by the handler, the services of the disabled features are nil.
+type Services struct {
+	Parser    usecase.ParserService
+	Browser   usecase.BrowserService
+	Jobs      usecase.JobService
+	Batch     usecase.BatchService
+	History   usecase.HistoryService
+	Watches   usecase.WatchService
+	Scheduler usecase.SchedulerService
+}
+
+func NewHandler(logger logger.Logger, services Services, requestTimeout time.Duration) *Handler {
+	return &Handler{
+		logger:         logger,
+		router:         http.NewServeMux(),
+		parserSrv:      services.Parser,
+		browserSrv:     services.Browser,
+		jobSrv:         services.Jobs,
+		batchSrv:       services.Batch,
+		historySrv:     services.History,
+		watchSrv:       services.Watches,
+		schedulerSrv:   services.Scheduler,
+		requestTimeout: requestTimeout,
+	}
 }
 
 func (h *Handler) APIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams) (httpgen.APIV1MarketplaceParserServiceProductsSearchGetRes, error) {
-	prods, err := h.parserSrv.GetProductsList(ctx, params.Name, params.PriceFrom.Value, params.PriceTo.Value)
+	result, err := h.parserSrv.GetProductsList(ctx, domain.SearchQuery{
+		Name:        params.Name,
+		PriceFrom:   params.PriceFrom.Value,
+		PriceTo:     params.PriceTo.Value,
+		InStockOnly: params.InStockOnly.Value,
+		Region:      params.Region.Value,
+		Debug:       params.Debug.Value,
+		NoCache:     noCache(params.CacheControl.Value),
+	})
 	if err != nil {
 		httpErr := MapError(err)
 		h.LogHTTPError(ctx, err, httpErr)
 		return httpErr.ToSearchProductErrResp(), nil
 	}
+	res := &httpgen.SearchProductsResponseHeaders{
+		Response: make(httpgen.SearchProductsResponse, 0, len(result.Products)),
+	}
+
+	for _, p := range result.Products {
+		res.Response = append(res.Response, toProductResp(p))
+	}
+	for _, m := range result.TimedOut {
+		res.XTimedOutSources = append(res.XTimedOutSources, httpgen.Marketplace(m))
+	}
+	if result.CachedAt.IsZero() {
+		res.XCache = httpgen.NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache(httpgen.APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS)
+	} else {
+		res.XCache = httpgen.NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache(httpgen.APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT)
+		res.Age = httpgen.NewOptInt(int(time.Since(result.CachedAt).Seconds()))
+	}
+
+	return res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceProductsCategoryGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceProductsCategoryGetParams) (httpgen.APIV1MarketplaceParserServiceProductsCategoryGetRes, error) {
+	prods, err := h.parserSrv.GetCategoryProducts(ctx, domain.CategoryQuery{
+		Marketplace: domain.Marketplace(params.Marketplace),
+		Category:    params.Category,
+		PriceFrom:   params.PriceFrom.Value,
+		PriceTo:     params.PriceTo.Value,
+		InStockOnly: params.InStockOnly.Value,
+		Region:      params.Region.Value,
+		Debug:       params.Debug.Value,
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCategoryProductErrResp(), nil
+	}
 	res := make(httpgen.SearchProductsResponse, 0, len(prods))
 
 	for _, p := range prods {
-		res = append(res, httpgen.Product{
-			Name:         p.Name,
-			Link:         p.Link,
-			Price:        p.Price,
-			Rating:       p.Rating,
-			ReviewsCount: p.ReviewsCount,
+		res = append(res, toProductResp(p))
+	}
+
+	return &res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceProductsSuggestionsGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetParams) (httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetRes, error) {
+	suggestions, err := h.parserSrv.GetSuggestions(ctx, params.Prefix)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToSuggestionsErrResp(), nil
+	}
+	res := make(httpgen.SuggestionsResponse, 0, len(suggestions))
+
+	for _, s := range suggestions {
+		res = append(res, httpgen.Suggestions{
+			Marketplace: httpgen.Marketplace(s.Marketplace),
+			Queries:     s.Queries,
 		})
 	}
 
 	return &res, nil
 }
 
+func (h *Handler) APIV1MarketplaceParserServiceBrowserNodesGet(ctx context.Context) (httpgen.BrowserNodesResponse, error) {
+	nodes := h.browserSrv.GetNodes(ctx)
+	res := make(httpgen.BrowserNodesResponse, 0, len(nodes))
+
+	for _, n := range nodes {
+		node := httpgen.BrowserNode{
+			URL:         n.URL,
+			Healthy:     n.Healthy,
+			Connections: n.Connections,
+			Pages:       n.Pages,
+			Failures:    n.Failures,
+		}
+		if n.LastError != "" {
+			node.LastError = httpgen.NewOptString(n.LastError)
+		}
+		if !n.CheckedAt.IsZero() {
+			node.CheckedAt = httpgen.NewOptDateTime(n.CheckedAt)
+		}
+		res = append(res, node)
+	}
+
+	return res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceAdminSessionsDelete(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteParams) (httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteRes, error) {
+	var marketplace domain.Marketplace
+	if m, ok := params.Marketplace.Get(); ok {
+		marketplace = domain.Marketplace(m)
+	}
+
+	if err := h.browserSrv.ResetSessions(ctx, marketplace); err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToResetSessionsErrResp(), nil
+	}
+
+	return &httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteNoContent{}, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceAdminSourcesGet(ctx context.Context) (httpgen.SourcesStatusResponse, error) {
+	statuses := h.parserSrv.GetSourcesStatus(ctx)
+	res := make(httpgen.SourcesStatusResponse, 0, len(statuses))
+
+	for _, st := range statuses {
+		status := httpgen.SourceStatus{
+			Marketplace: httpgen.Marketplace(st.Marketplace),
+			State:       httpgen.SourceStatusState(st.State),
+			Failures:    st.Failures,
+			Opens:       st.Opens,
+		}
+		if st.LastError != "" {
+			status.LastError = httpgen.NewOptString(st.LastError)
+		}
+		if !st.OpenedAt.IsZero() {
+			status.OpenedAt = httpgen.NewOptDateTime(st.OpenedAt)
+		}
+		if !st.RetryAt.IsZero() {
+			status.RetryAt = httpgen.NewOptDateTime(st.RetryAt)
+		}
+		res = append(res, status)
+	}
+
+	return res, nil
+}
+
+// noCache reports whether the Cache-Control header has the no-cache directive.
+func noCache(cacheControl string) bool {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toProductResp(p domain.Product) httpgen.Product {
+	prod := httpgen.Product{
+		Name:         p.Name,
+		Link:         p.Link,
+		Price:        p.Price,
+		Rating:       p.Rating,
+		ReviewsCount: p.ReviewsCount,
+		InStock:      p.InStock,
+	}
+	if p.ID != "" {
+		prod.ID = httpgen.NewOptString(p.ID)
+	}
+	if p.StockQuantity > 0 {
+		prod.StockQuantity = httpgen.NewOptInt(p.StockQuantity)
+	}
+	if p.DeliveryText != "" {
+		prod.DeliveryText = httpgen.NewOptString(p.DeliveryText)
+	}
+	if !p.DeliveryDate.IsZero() {
+		prod.DeliveryDate = httpgen.NewOptDate(p.DeliveryDate)
+	}
+
+	return prod
+}
+
 func (h *Handler) LogHTTPError(ctx context.Context, err error, httpErr *HTTPError) {
 	attrs := []any{
 		"error", err,
 		"status", httpErr.Status,
 		"message", httpErr.Message,
 	}
+	if httpErr.ArtifactID != "" {
+		attrs = append(attrs, "artifact_id", httpErr.ArtifactID)
+	}
+	// The HAR file is on the server, so its path is only logged
+	var artifactErr *domain.ArtifactError
+	if errors.As(err, &artifactErr) && artifactErr.HARPath != "" {
+		attrs = append(attrs, "har_path", artifactErr.HARPath)
+	}
 
 	switch {
 	case httpErr.Status >= 500:
 		switch httpErr.Status {
 		case http.StatusGatewayTimeout:
 			h.logger.Error("http_request_failed", append(attrs, "reason", "dependency_timeout")...)
+		case http.StatusServiceUnavailable:
+			reason := "source_blocked"
+			if errors.Is(err, domain.ErrSourceUnavailable) {
+				reason = "source_unavailable"
+			}
+			h.logger.Error("http_request_failed", append(attrs, "reason", reason)...)
 		default:
 			h.logger.Error("http_request_failed", append(attrs, "reason", "internal_server_error")...)
 		}
+	case httpErr.Status == http.StatusTooManyRequests:
+		h.logger.Warn("http_request_failed", append(attrs, "reason", "too_many_requests")...)
 	case httpErr.Status >= 400:
 		h.logger.Warn("http_request_failed", append(attrs, "reason", "client_error")...)
 	}
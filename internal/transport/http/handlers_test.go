@@ -3,6 +3,7 @@ package http_test
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -95,10 +96,10 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 			parserSrvMock := &mocks.ParserServiceMock{}
 			loggerMock := &mocks.LoggerMock{}
 
-			handler := ht.NewHandler(loggerMock, parserSrvMock, timeout)
+			handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, timeout)
 			if tc.expErr {
 				if errors.Is(tc.errUsecase, domain.ErrEmptyProductName) || errors.Is(tc.errUsecase, domain.ErrPriceFromBelowZero) || errors.Is(tc.errUsecase, domain.ErrPriceFromAbovePriceTo) || errors.Is(tc.errUsecase, domain.ErrPriceToBelowZero) {
-					parserSrvMock.On("GetProductsList", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(nil, tc.errUsecase).Once()
+					parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(domain.SearchResult{}, tc.errUsecase).Once()
 					loggerMock.On("Warn", mock.Anything, mock.Anything).Once()
 					res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
 						Name:      tc.prodName,
@@ -113,7 +114,7 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 
 					parserSrvMock.AssertExpectations(t)
 				} else if errors.Is(tc.errUsecase, domain.ErrGatewayTimeout) {
-					parserSrvMock.On("GetProductsList", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(nil, tc.errUsecase).Once()
+					parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(domain.SearchResult{}, tc.errUsecase).Once()
 					loggerMock.On("Error", mock.Anything, mock.Anything).Once()
 					res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
 						Name:      tc.prodName,
@@ -126,7 +127,7 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 					_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSearchGetGatewayTimeout)
 					assert.True(t, ok)
 				} else if errors.Is(tc.errUsecase, domain.ErrClientClosedRequest) {
-					parserSrvMock.On("GetProductsList", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(nil, tc.errUsecase).Once()
+					parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(domain.SearchResult{}, tc.errUsecase).Once()
 					loggerMock.On("Warn", mock.Anything, mock.Anything).Once()
 					res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
 						Name:      tc.prodName,
@@ -141,7 +142,7 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 
 					parserSrvMock.AssertExpectations(t)
 				} else {
-					parserSrvMock.On("GetProductsList", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(nil, tc.errUsecase).Once()
+					parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(domain.SearchResult{}, tc.errUsecase).Once()
 					loggerMock.On("Error", mock.Anything, mock.Anything).Once()
 					res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
 						Name:      tc.prodName,
@@ -167,7 +168,7 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 					},
 				}
 
-				parserSrvMock.On("GetProductsList", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(prods, nil).Once()
+				parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(domain.SearchResult{Products: prods}, nil).Once()
 				res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
 					Name:      tc.prodName,
 					PriceFrom: httpgen.NewOptFloat64(tc.priceFrom),
@@ -182,3 +183,313 @@ func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGet(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGetTimedOut(t *testing.T) {
+	parserSrvMock := &mocks.ParserServiceMock{}
+	loggerMock := &mocks.LoggerMock{}
+	handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+	result := domain.SearchResult{
+		Products: []domain.Product{{Name: "prod", Link: "link1", Price: 500.0}},
+		TimedOut: []domain.Marketplace{domain.MarketplaceOzon},
+	}
+
+	parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: "prod", PriceTo: 500.0}).Return(result, nil).Once()
+	res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
+		Name:    "prod",
+		PriceTo: httpgen.NewOptFloat64(500.0),
+	})
+	assert.NoError(t, err)
+	resp, ok := res.(*httpgen.SearchProductsResponseHeaders)
+	assert.True(t, ok)
+	assert.Len(t, resp.Response, 1)
+	assert.Equal(t, []httpgen.Marketplace{httpgen.MarketplaceOzon}, resp.XTimedOutSources)
+
+	parserSrvMock.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsSearchGetCache(t *testing.T) {
+	t.Run("hit", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		result := domain.SearchResult{
+			Products: []domain.Product{{Name: "prod", Link: "link1", Price: 500.0}},
+			CachedAt: time.Now().Add(-90 * time.Second),
+		}
+
+		parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: "prod", PriceTo: 500.0}).Return(result, nil).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
+			Name:    "prod",
+			PriceTo: httpgen.NewOptFloat64(500.0),
+		})
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchProductsResponseHeaders)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache(httpgen.APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT), resp.XCache)
+		assert.Equal(t, httpgen.NewOptInt(90), resp.Age)
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("no cache", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: "prod", PriceTo: 500.0, NoCache: true}).Return(domain.SearchResult{}, nil).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsSearchGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSearchGetParams{
+			Name:         "prod",
+			PriceTo:      httpgen.NewOptFloat64(500.0),
+			CacheControl: httpgen.NewOptString("max-age=0, No-Cache"),
+		})
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchProductsResponseHeaders)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache(httpgen.APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS), resp.XCache)
+		assert.False(t, resp.Age.IsSet())
+
+		parserSrvMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsCategoryGet(t *testing.T) {
+	params := httpgen.APIV1MarketplaceParserServiceProductsCategoryGetParams{
+		Marketplace: httpgen.MarketplaceWildberries,
+		Category:    "catalog/elektronika",
+		PriceTo:     httpgen.NewOptFloat64(500.0),
+	}
+	query := domain.CategoryQuery{
+		Marketplace: domain.MarketplaceWildberries,
+		Category:    "catalog/elektronika",
+		PriceTo:     500.0,
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		prods := []domain.Product{
+			{
+				Name:         "prod",
+				Link:         "link1",
+				Price:        500.0,
+				Rating:       5.0,
+				ReviewsCount: 253,
+				InStock:      true,
+			},
+		}
+
+		parserSrvMock.On("GetCategoryProducts", mock.Anything, query).Return(prods, nil).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsCategoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchProductsResponse)
+		assert.True(t, ok)
+		assert.Len(t, *resp, 1)
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid category", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("GetCategoryProducts", mock.Anything, query).Return(nil, domain.ErrInvalidCategory).Once()
+		loggerMock.On("Warn", mock.Anything, mock.Anything).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsCategoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetBadRequest)
+		assert.True(t, ok)
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("gateway timeout", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("GetCategoryProducts", mock.Anything, query).Return(nil, domain.ErrGatewayTimeout).Once()
+		loggerMock.On("Error", mock.Anything, mock.Anything).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsCategoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsCategoryGetGatewayTimeout)
+		assert.True(t, ok)
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsSuggestionsGet(t *testing.T) {
+	params := httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetParams{Prefix: "соков"}
+
+	t.Run("valid", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		suggestions := []domain.Suggestions{
+			{Marketplace: domain.MarketplaceOzon, Queries: []string{"соковыжималка", "соковарка"}},
+			{Marketplace: domain.MarketplaceWildberries, Queries: []string{"соковыжималка шнековая"}},
+		}
+
+		parserSrvMock.On("GetSuggestions", mock.Anything, params.Prefix).Return(suggestions, nil).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsSuggestionsGet(context.Background(), params)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SuggestionsResponse)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.SuggestionsResponse{
+			{Marketplace: httpgen.MarketplaceOzon, Queries: []string{"соковыжималка", "соковарка"}},
+			{Marketplace: httpgen.MarketplaceWildberries, Queries: []string{"соковыжималка шнековая"}},
+		}, *resp)
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("GetSuggestions", mock.Anything, "").Return(nil, domain.ErrEmptyPrefix).Once()
+		loggerMock.On("Warn", mock.Anything, mock.Anything).Once()
+		res, err := handler.APIV1MarketplaceParserServiceProductsSuggestionsGet(context.Background(), httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetParams{})
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsSuggestionsGetBadRequest)
+		assert.True(t, ok)
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceBrowserNodesGet(t *testing.T) {
+	browserSrvMock := &mocks.BrowserServiceMock{}
+	loggerMock := &mocks.LoggerMock{}
+	handler := ht.NewHandler(loggerMock, ht.Services{Browser: browserSrvMock}, time.Second*30)
+
+	checkedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	nodes := []domain.BrowserNode{
+		{URL: "ws://chromium-1:7317", Healthy: true, Connections: 1, Pages: 2, CheckedAt: checkedAt},
+		{URL: "ws://chromium-2:7317", Healthy: false, Failures: 3, LastError: "connection refused", CheckedAt: checkedAt},
+	}
+
+	browserSrvMock.On("GetNodes", mock.Anything).Return(nodes).Once()
+	res, err := handler.APIV1MarketplaceParserServiceBrowserNodesGet(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, httpgen.BrowserNodesResponse{
+		{
+			URL:         "ws://chromium-1:7317",
+			Healthy:     true,
+			Connections: 1,
+			Pages:       2,
+			CheckedAt:   httpgen.NewOptDateTime(checkedAt),
+		},
+		{
+			URL:       "ws://chromium-2:7317",
+			Failures:  3,
+			LastError: httpgen.NewOptString("connection refused"),
+			CheckedAt: httpgen.NewOptDateTime(checkedAt),
+		},
+	}, res)
+
+	browserSrvMock.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceAdminSessionsDelete(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		browserSrvMock := &mocks.BrowserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Browser: browserSrvMock}, time.Second*30)
+
+		browserSrvMock.On("ResetSessions", mock.Anything, domain.MarketplaceOzon).Return(nil).Once()
+		res, err := handler.APIV1MarketplaceParserServiceAdminSessionsDelete(context.Background(), httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteParams{
+			Marketplace: httpgen.NewOptMarketplace(httpgen.MarketplaceOzon),
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, &httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteNoContent{}, res)
+
+		browserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		browserSrvMock := &mocks.BrowserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Browser: browserSrvMock}, time.Second*30)
+
+		browserSrvMock.On("ResetSessions", mock.Anything, domain.Marketplace("")).Return(errors.New("permission denied")).Once()
+		loggerMock.On("Error", mock.Anything, mock.Anything).Maybe()
+		res, err := handler.APIV1MarketplaceParserServiceAdminSessionsDelete(context.Background(), httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteParams{})
+		assert.NoError(t, err)
+		assert.IsType(t, &httpgen.APIV1MarketplaceParserServiceAdminSessionsDeleteInternalServerError{}, res)
+
+		browserSrvMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceAdminSourcesGet(t *testing.T) {
+	parserSrvMock := &mocks.ParserServiceMock{}
+	loggerMock := &mocks.LoggerMock{}
+	handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+	openedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	statuses := []domain.SourceStatus{
+		{Marketplace: domain.MarketplaceWildberries, State: domain.CircuitClosed},
+		{
+			Marketplace: domain.MarketplaceOzon,
+			State:       domain.CircuitOpen,
+			Failures:    5,
+			Opens:       1,
+			LastError:   "source blocked",
+			OpenedAt:    openedAt,
+			RetryAt:     openedAt.Add(time.Minute),
+		},
+	}
+
+	parserSrvMock.On("GetSourcesStatus", mock.Anything).Return(statuses).Once()
+	res, err := handler.APIV1MarketplaceParserServiceAdminSourcesGet(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, httpgen.SourcesStatusResponse{
+		{Marketplace: httpgen.MarketplaceWildberries, State: httpgen.SourceStatusStateClosed},
+		{
+			Marketplace: httpgen.MarketplaceOzon,
+			State:       httpgen.SourceStatusStateOpen,
+			Failures:    5,
+			Opens:       1,
+			LastError:   httpgen.NewOptString("source blocked"),
+			OpenedAt:    httpgen.NewOptDateTime(openedAt),
+			RetryAt:     httpgen.NewOptDateTime(openedAt.Add(time.Minute)),
+		},
+	}, res)
+
+	parserSrvMock.AssertExpectations(t)
+}
+
+func TestHandlers_MetricsGet(t *testing.T) {
+	parserSrvMock := &mocks.ParserServiceMock{}
+	loggerMock := &mocks.LoggerMock{}
+	handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+	statuses := []domain.SourceStatus{
+		{Marketplace: domain.MarketplaceWildberries, State: domain.CircuitClosed},
+		{Marketplace: domain.MarketplaceOzon, State: domain.CircuitOpen, Failures: 5, Opens: 2},
+	}
+
+	parserSrvMock.On("GetSourcesStatus", mock.Anything).Return(statuses).Once()
+	res, err := handler.MetricsGet(context.Background())
+	assert.NoError(t, err)
+	body, err := io.ReadAll(res.Data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "# TYPE marketplace_source_circuit_state gauge\n")
+	assert.Contains(t, string(body), `marketplace_source_circuit_state{marketplace="wildberries"} 0`+"\n")
+	assert.Contains(t, string(body), `marketplace_source_circuit_state{marketplace="ozon"} 1`+"\n")
+	assert.Contains(t, string(body), `marketplace_source_failures{marketplace="ozon"} 5`+"\n")
+	assert.Contains(t, string(body), `marketplace_source_circuit_opens_total{marketplace="ozon"} 2`+"\n")
+
+	parserSrvMock.AssertExpectations(t)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func (h *Handler) APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) (httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes, error) {
+	if h.historySrv == nil {
+		err := domain.ErrHistoryDisabled
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToPriceHistoryErrResp(), nil
+	}
+
+	points, err := h.historySrv.GetPriceHistory(ctx, domain.HistoryQuery{
+		Marketplace: domain.Marketplace(params.Marketplace),
+		ProductID:   params.ID,
+		From:        params.From.Value,
+		To:          params.To.Value,
+		Interval:    params.Interval.Value,
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToPriceHistoryErrResp(), nil
+	}
+
+	res := &httpgen.PriceHistoryResponse{
+		Marketplace: params.Marketplace,
+		ID:          params.ID,
+		Points:      make([]httpgen.PricePoint, 0, len(points)),
+	}
+	for _, p := range points {
+		res.Points = append(res.Points, httpgen.PricePoint{
+			Time:         p.Time,
+			Price:        p.Price,
+			MinPrice:     p.MinPrice,
+			MaxPrice:     p.MaxPrice,
+			Rating:       p.Rating,
+			ReviewsCount: p.ReviewsCount,
+			InStock:      p.InStock,
+			Samples:      p.Samples,
+		})
+	}
+
+	return res, nil
+}
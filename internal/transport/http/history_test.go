@@ -0,0 +1,71 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func TestHandlers_APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(t *testing.T) {
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	params := httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams{
+		Marketplace: httpgen.MarketplaceOzon,
+		ID:          "1",
+		From:        httpgen.NewOptDateTime(from),
+		Interval:    httpgen.NewOptDuration(24 * time.Hour),
+	}
+	query := domain.HistoryQuery{Marketplace: domain.MarketplaceOzon, ProductID: "1", From: from, Interval: 24 * time.Hour}
+
+	t.Run("success", func(t *testing.T) {
+		historySrvMock := &mocks.HistoryServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{History: historySrvMock}, time.Second*30)
+
+		points := []domain.PricePoint{{Time: from, Price: 90, MinPrice: 80, MaxPrice: 100, Rating: 4.6, ReviewsCount: 12, InStock: true, Samples: 2}}
+		historySrvMock.On("GetPriceHistory", mock.Anything, query).Return(points, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		assert.Equal(t, &httpgen.PriceHistoryResponse{
+			Marketplace: httpgen.MarketplaceOzon,
+			ID:          "1",
+			Points:      []httpgen.PricePoint{{Time: from, Price: 90, MinPrice: 80, MaxPrice: 100, Rating: 4.6, ReviewsCount: 12, InStock: true, Samples: 2}},
+		}, res)
+
+		historySrvMock.AssertExpectations(t)
+	})
+
+	t.Run("bad request", func(t *testing.T) {
+		historySrvMock := &mocks.HistoryServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{History: historySrvMock}, time.Second*30)
+
+		historySrvMock.On("GetPriceHistory", mock.Anything, query).Return(nil, domain.ErrInvalidHistoryRange).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetBadRequest)
+		assert.True(t, ok)
+
+		historySrvMock.AssertExpectations(t)
+	})
+
+	t.Run("history is disabled", func(t *testing.T) {
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{}, time.Second*30)
+
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(context.Background(), params)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetNotFound)
+		assert.True(t, ok)
+	})
+}
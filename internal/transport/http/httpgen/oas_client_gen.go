@@ -26,12 +26,157 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// APIV1MarketplaceParserServiceAdminSchedulesGet invokes GET /api/v1/marketplace-parser-service/admin/schedules operation.
+	//
+	// Get the recurring searches with the status and duration of their last runs and the planned next
+	// runs.
+	//
+	// GET /api/v1/marketplace-parser-service/admin/schedules
+	APIV1MarketplaceParserServiceAdminSchedulesGet(ctx context.Context) (APIV1MarketplaceParserServiceAdminSchedulesGetRes, error)
+	// APIV1MarketplaceParserServiceAdminSchedulesIDDelete invokes DELETE /api/v1/marketplace-parser-service/admin/schedules/{id} operation.
+	//
+	// Remove the schedule created via the API, its running search is finished.
+	//
+	// DELETE /api/v1/marketplace-parser-service/admin/schedules/{id}
+	APIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) (APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes, error)
+	// APIV1MarketplaceParserServiceAdminSchedulesPost invokes POST /api/v1/marketplace-parser-service/admin/schedules operation.
+	//
+	// Run the search on the cron schedule. A run starts up to the configured jitter after its scheduled
+	// time, the results are stored by the configured sink: the log, a JSON Lines file or an SQLite
+	// database.
+	//
+	// POST /api/v1/marketplace-parser-service/admin/schedules
+	APIV1MarketplaceParserServiceAdminSchedulesPost(ctx context.Context, request *ScheduleRequest) (APIV1MarketplaceParserServiceAdminSchedulesPostRes, error)
+	// APIV1MarketplaceParserServiceAdminSessionsDelete invokes DELETE /api/v1/marketplace-parser-service/admin/sessions operation.
+	//
+	// Remove the stored cookies and localStorage of the marketplace, of all marketplaces if it's not set.
+	//
+	// DELETE /api/v1/marketplace-parser-service/admin/sessions
+	APIV1MarketplaceParserServiceAdminSessionsDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSessionsDeleteParams) (APIV1MarketplaceParserServiceAdminSessionsDeleteRes, error)
+	// APIV1MarketplaceParserServiceAdminSourcesGet invokes GET /api/v1/marketplace-parser-service/admin/sources operation.
+	//
+	// Get the state of the circuit breakers of the marketplaces. The calls of a marketplace with an open
+	// circuit fail fast with 503 until the cool-down ends.
+	//
+	// GET /api/v1/marketplace-parser-service/admin/sources
+	APIV1MarketplaceParserServiceAdminSourcesGet(ctx context.Context) (SourcesStatusResponse, error)
+	// APIV1MarketplaceParserServiceBrowserNodesGet invokes GET /api/v1/marketplace-parser-service/browser/nodes operation.
+	//
+	// Get the state of the chromium nodes the parser pages are distributed over.
+	//
+	// GET /api/v1/marketplace-parser-service/browser/nodes
+	APIV1MarketplaceParserServiceBrowserNodesGet(ctx context.Context) (BrowserNodesResponse, error)
+	// APIV1MarketplaceParserServiceProductsCategoryGet invokes GET /api/v1/marketplace-parser-service/products/category operation.
+	//
+	// List products of a marketplace catalog category by its path or URL.
+	//
+	// GET /api/v1/marketplace-parser-service/products/category
+	APIV1MarketplaceParserServiceProductsCategoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsCategoryGetParams) (APIV1MarketplaceParserServiceProductsCategoryGetRes, error)
+	// APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet invokes GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history operation.
+	//
+	// Get the prices, ratings and reviews of the product recorded every time it was found by a search, a
+	// category listing, a job or a batch. The points are ordered by time.
+	//
+	// GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history
+	APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) (APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes, error)
+	// APIV1MarketplaceParserServiceProductsSearchBatchPost invokes POST /api/v1/marketplace-parser-service/products/search/batch operation.
+	//
+	// Search for products by many queries at once, at most batch.parallelism queries run at the same
+	// time. A failed query doesn't fail the others, its error is returned in its result. With "Accept:
+	// application/x-ndjson" the result of every query is streamed as a JSON line as soon as the query is
+	// finished.
+	//
+	// POST /api/v1/marketplace-parser-service/products/search/batch
+	APIV1MarketplaceParserServiceProductsSearchBatchPost(ctx context.Context, request *SearchBatchRequest) (APIV1MarketplaceParserServiceProductsSearchBatchPostRes, error)
 	// APIV1MarketplaceParserServiceProductsSearchGet invokes GET /api/v1/marketplace-parser-service/products/search operation.
 	//
 	// Search for products by name and price range.
 	//
 	// GET /api/v1/marketplace-parser-service/products/search
 	APIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (APIV1MarketplaceParserServiceProductsSearchGetRes, error)
+	// APIV1MarketplaceParserServiceProductsSearchStreamGet invokes GET /api/v1/marketplace-parser-service/products/search/stream operation.
+	//
+	// Search for products like the search endpoint, but stream the result of every marketplace as a
+	// Server-Sent Event as soon as it's finished: a products event with the found products, a
+	// source_error event for a failed or timed out marketplace. The stream always ends with a done event
+	// or, if the search fails after the first event, an error event. The errors before the first event
+	// are returned as JSON.
+	//
+	// GET /api/v1/marketplace-parser-service/products/search/stream
+	APIV1MarketplaceParserServiceProductsSearchStreamGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchStreamGetParams) (APIV1MarketplaceParserServiceProductsSearchStreamGetRes, error)
+	// APIV1MarketplaceParserServiceProductsSuggestionsGet invokes GET /api/v1/marketplace-parser-service/products/suggestions operation.
+	//
+	// Get the search queries suggested by every marketplace for the typed prefix.
+	//
+	// GET /api/v1/marketplace-parser-service/products/suggestions
+	APIV1MarketplaceParserServiceProductsSuggestionsGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSuggestionsGetParams) (APIV1MarketplaceParserServiceProductsSuggestionsGetRes, error)
+	// APIV1MarketplaceParserServiceSearchJobsIDDelete invokes DELETE /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+	//
+	// Cancel the queued or running job. A finished job is returned unchanged.
+	//
+	// DELETE /api/v1/marketplace-parser-service/search-jobs/{id}
+	APIV1MarketplaceParserServiceSearchJobsIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) (APIV1MarketplaceParserServiceSearchJobsIDDeleteRes, error)
+	// APIV1MarketplaceParserServiceSearchJobsIDGet invokes GET /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+	//
+	// Get the status of the job, the progress of every marketplace and the products once the job is
+	// succeeded. Finished jobs are kept for a limited time.
+	//
+	// GET /api/v1/marketplace-parser-service/search-jobs/{id}
+	APIV1MarketplaceParserServiceSearchJobsIDGet(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDGetParams) (APIV1MarketplaceParserServiceSearchJobsIDGetRes, error)
+	// APIV1MarketplaceParserServiceSearchJobsPost invokes POST /api/v1/marketplace-parser-service/search-jobs operation.
+	//
+	// Queue a search that runs in the background. Poll the job by its id for the progress and the
+	// products.
+	//
+	// POST /api/v1/marketplace-parser-service/search-jobs
+	APIV1MarketplaceParserServiceSearchJobsPost(ctx context.Context, request *SearchJobRequest) (APIV1MarketplaceParserServiceSearchJobsPostRes, error)
+	// APIV1MarketplaceParserServiceWatchesGet invokes GET /api/v1/marketplace-parser-service/watches operation.
+	//
+	// List watches.
+	//
+	// GET /api/v1/marketplace-parser-service/watches
+	APIV1MarketplaceParserServiceWatchesGet(ctx context.Context) (APIV1MarketplaceParserServiceWatchesGetRes, error)
+	// APIV1MarketplaceParserServiceWatchesIDDelete invokes DELETE /api/v1/marketplace-parser-service/watches/{id} operation.
+	//
+	// Delete the watch and its delivery log.
+	//
+	// DELETE /api/v1/marketplace-parser-service/watches/{id}
+	APIV1MarketplaceParserServiceWatchesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeleteParams) (APIV1MarketplaceParserServiceWatchesIDDeleteRes, error)
+	// APIV1MarketplaceParserServiceWatchesIDDeliveriesGet invokes GET /api/v1/marketplace-parser-service/watches/{id}/deliveries operation.
+	//
+	// Get the last webhook deliveries of the watch, the newest first.
+	//
+	// GET /api/v1/marketplace-parser-service/watches/{id}/deliveries
+	APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) (APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes, error)
+	// APIV1MarketplaceParserServiceWatchesIDGet invokes GET /api/v1/marketplace-parser-service/watches/{id} operation.
+	//
+	// Get the watch and the result of its last check.
+	//
+	// GET /api/v1/marketplace-parser-service/watches/{id}
+	APIV1MarketplaceParserServiceWatchesIDGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDGetParams) (APIV1MarketplaceParserServiceWatchesIDGetRes, error)
+	// APIV1MarketplaceParserServiceWatchesIDPut invokes PUT /api/v1/marketplace-parser-service/watches/{id} operation.
+	//
+	// Replace the watch, its secret is kept. The result of the last check is reset if the product, the
+	// query or the target price is changed.
+	//
+	// PUT /api/v1/marketplace-parser-service/watches/{id}
+	APIV1MarketplaceParserServiceWatchesIDPut(ctx context.Context, request *WatchRequest, params APIV1MarketplaceParserServiceWatchesIDPutParams) (APIV1MarketplaceParserServiceWatchesIDPutRes, error)
+	// APIV1MarketplaceParserServiceWatchesPost invokes POST /api/v1/marketplace-parser-service/watches operation.
+	//
+	// Watch a product or the cheapest product of a search query. The target is searched every interval
+	// and its webhook is notified with a signed JSON POST when the price drops below targetPrice or,
+	// with notifyStock, the stock of the product changes. The signature is in X-Webhook-Signature:
+	// "sha256=" and the hex HMAC-SHA256 of X-Webhook-Timestamp, "." and the body keyed by the secret
+	// returned by this request only. Failed deliveries are retried with backoff.
+	//
+	// POST /api/v1/marketplace-parser-service/watches
+	APIV1MarketplaceParserServiceWatchesPost(ctx context.Context, request *WatchRequest) (APIV1MarketplaceParserServiceWatchesPostRes, error)
+	// MetricsGet invokes GET /metrics operation.
+	//
+	// Get the service metrics in the Prometheus text format.
+	//
+	// GET /metrics
+	MetricsGet(ctx context.Context) (MetricsGetOK, error)
 }
 
 // Client implements OAS client.
@@ -77,20 +222,21 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// APIV1MarketplaceParserServiceProductsSearchGet invokes GET /api/v1/marketplace-parser-service/products/search operation.
+// APIV1MarketplaceParserServiceAdminSchedulesGet invokes GET /api/v1/marketplace-parser-service/admin/schedules operation.
 //
-// Search for products by name and price range.
+// Get the recurring searches with the status and duration of their last runs and the planned next
+// runs.
 //
-// GET /api/v1/marketplace-parser-service/products/search
-func (c *Client) APIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (APIV1MarketplaceParserServiceProductsSearchGetRes, error) {
-	res, err := c.sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx, params)
+// GET /api/v1/marketplace-parser-service/admin/schedules
+func (c *Client) APIV1MarketplaceParserServiceAdminSchedulesGet(ctx context.Context) (APIV1MarketplaceParserServiceAdminSchedulesGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceAdminSchedulesGet(ctx)
 	return res, err
 }
 
-func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (res APIV1MarketplaceParserServiceProductsSearchGetRes, err error) {
+func (c *Client) sendAPIV1MarketplaceParserServiceAdminSchedulesGet(ctx context.Context) (res APIV1MarketplaceParserServiceAdminSchedulesGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/search"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/admin/schedules"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -106,7 +252,7 @@ func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx context.
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsSearchGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceAdminSchedulesGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -124,63 +270,101 @@ func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx context.
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/marketplace-parser-service/products/search"
+	pathParts[0] = "/api/v1/marketplace-parser-service/admin/schedules"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "price_from" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "price_from",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	defer resp.Body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PriceFrom.Get(); ok {
-				return e.EncodeValue(conv.Float64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceAdminSchedulesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	{
-		// Encode "price_to" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "price_to",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceAdminSchedulesIDDelete invokes DELETE /api/v1/marketplace-parser-service/admin/schedules/{id} operation.
+//
+// Remove the schedule created via the API, its running search is finished.
+//
+// DELETE /api/v1/marketplace-parser-service/admin/schedules/{id}
+func (c *Client) APIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) (APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) (res APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/admin/schedules/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceAdminSchedulesIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PriceTo.Get(); ok {
-				return e.EncodeValue(conv.Float64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/admin/schedules/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -193,7 +377,2061 @@ func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx context.
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAPIV1MarketplaceParserServiceProductsSearchGetResponse(resp)
+	result, err := decodeAPIV1MarketplaceParserServiceAdminSchedulesIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceAdminSchedulesPost invokes POST /api/v1/marketplace-parser-service/admin/schedules operation.
+//
+// Run the search on the cron schedule. A run starts up to the configured jitter after its scheduled
+// time, the results are stored by the configured sink: the log, a JSON Lines file or an SQLite
+// database.
+//
+// POST /api/v1/marketplace-parser-service/admin/schedules
+func (c *Client) APIV1MarketplaceParserServiceAdminSchedulesPost(ctx context.Context, request *ScheduleRequest) (APIV1MarketplaceParserServiceAdminSchedulesPostRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceAdminSchedulesPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceAdminSchedulesPost(ctx context.Context, request *ScheduleRequest) (res APIV1MarketplaceParserServiceAdminSchedulesPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/admin/schedules"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceAdminSchedulesPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/admin/schedules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1MarketplaceParserServiceAdminSchedulesPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceAdminSchedulesPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceAdminSessionsDelete invokes DELETE /api/v1/marketplace-parser-service/admin/sessions operation.
+//
+// Remove the stored cookies and localStorage of the marketplace, of all marketplaces if it's not set.
+//
+// DELETE /api/v1/marketplace-parser-service/admin/sessions
+func (c *Client) APIV1MarketplaceParserServiceAdminSessionsDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSessionsDeleteParams) (APIV1MarketplaceParserServiceAdminSessionsDeleteRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceAdminSessionsDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceAdminSessionsDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSessionsDeleteParams) (res APIV1MarketplaceParserServiceAdminSessionsDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/admin/sessions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceAdminSessionsDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/admin/sessions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "marketplace" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "marketplace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Marketplace.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceAdminSessionsDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceAdminSourcesGet invokes GET /api/v1/marketplace-parser-service/admin/sources operation.
+//
+// Get the state of the circuit breakers of the marketplaces. The calls of a marketplace with an open
+// circuit fail fast with 503 until the cool-down ends.
+//
+// GET /api/v1/marketplace-parser-service/admin/sources
+func (c *Client) APIV1MarketplaceParserServiceAdminSourcesGet(ctx context.Context) (SourcesStatusResponse, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceAdminSourcesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceAdminSourcesGet(ctx context.Context) (res SourcesStatusResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/admin/sources"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceAdminSourcesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/admin/sources"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceAdminSourcesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceBrowserNodesGet invokes GET /api/v1/marketplace-parser-service/browser/nodes operation.
+//
+// Get the state of the chromium nodes the parser pages are distributed over.
+//
+// GET /api/v1/marketplace-parser-service/browser/nodes
+func (c *Client) APIV1MarketplaceParserServiceBrowserNodesGet(ctx context.Context) (BrowserNodesResponse, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceBrowserNodesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceBrowserNodesGet(ctx context.Context) (res BrowserNodesResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/browser/nodes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceBrowserNodesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/browser/nodes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceBrowserNodesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsCategoryGet invokes GET /api/v1/marketplace-parser-service/products/category operation.
+//
+// List products of a marketplace catalog category by its path or URL.
+//
+// GET /api/v1/marketplace-parser-service/products/category
+func (c *Client) APIV1MarketplaceParserServiceProductsCategoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsCategoryGetParams) (APIV1MarketplaceParserServiceProductsCategoryGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsCategoryGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsCategoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsCategoryGetParams) (res APIV1MarketplaceParserServiceProductsCategoryGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/category"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsCategoryGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/category"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "marketplace" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "marketplace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(string(params.Marketplace)))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "category" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Category))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceFrom.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceTo.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "in_stock_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.InStockOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "region" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Region.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "debug" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Debug.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsCategoryGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet invokes GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history operation.
+//
+// Get the prices, ratings and reviews of the product recorded every time it was found by a search, a
+// category listing, a job or a batch. The points are ordered by time.
+//
+// GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history
+func (c *Client) APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) (APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) (res APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/{marketplace}/{id}/history"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/"
+	{
+		// Encode "marketplace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "marketplace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.Marketplace)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Interval.Get(); ok {
+				return e.EncodeValue(conv.DurationToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSearchBatchPost invokes POST /api/v1/marketplace-parser-service/products/search/batch operation.
+//
+// Search for products by many queries at once, at most batch.parallelism queries run at the same
+// time. A failed query doesn't fail the others, its error is returned in its result. With "Accept:
+// application/x-ndjson" the result of every query is streamed as a JSON line as soon as the query is
+// finished.
+//
+// POST /api/v1/marketplace-parser-service/products/search/batch
+func (c *Client) APIV1MarketplaceParserServiceProductsSearchBatchPost(ctx context.Context, request *SearchBatchRequest) (APIV1MarketplaceParserServiceProductsSearchBatchPostRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsSearchBatchPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchBatchPost(ctx context.Context, request *SearchBatchRequest) (res APIV1MarketplaceParserServiceProductsSearchBatchPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/search/batch"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsSearchBatchPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/search/batch"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1MarketplaceParserServiceProductsSearchBatchPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsSearchBatchPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSearchGet invokes GET /api/v1/marketplace-parser-service/products/search operation.
+//
+// Search for products by name and price range.
+//
+// GET /api/v1/marketplace-parser-service/products/search
+func (c *Client) APIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (APIV1MarketplaceParserServiceProductsSearchGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (res APIV1MarketplaceParserServiceProductsSearchGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsSearchGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceFrom.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceTo.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "in_stock_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.InStockOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "region" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Region.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "debug" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Debug.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Cache-Control",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CacheControl.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsSearchGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSearchStreamGet invokes GET /api/v1/marketplace-parser-service/products/search/stream operation.
+//
+// Search for products like the search endpoint, but stream the result of every marketplace as a
+// Server-Sent Event as soon as it's finished: a products event with the found products, a
+// source_error event for a failed or timed out marketplace. The stream always ends with a done event
+// or, if the search fails after the first event, an error event. The errors before the first event
+// are returned as JSON.
+//
+// GET /api/v1/marketplace-parser-service/products/search/stream
+func (c *Client) APIV1MarketplaceParserServiceProductsSearchStreamGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchStreamGetParams) (APIV1MarketplaceParserServiceProductsSearchStreamGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsSearchStreamGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsSearchStreamGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchStreamGetParams) (res APIV1MarketplaceParserServiceProductsSearchStreamGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/search/stream"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsSearchStreamGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/search/stream"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceFrom.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "price_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "price_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PriceTo.Get(); ok {
+				return e.EncodeValue(conv.Float64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "in_stock_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.InStockOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "region" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Region.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "debug" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Debug.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsSearchStreamGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSuggestionsGet invokes GET /api/v1/marketplace-parser-service/products/suggestions operation.
+//
+// Get the search queries suggested by every marketplace for the typed prefix.
+//
+// GET /api/v1/marketplace-parser-service/products/suggestions
+func (c *Client) APIV1MarketplaceParserServiceProductsSuggestionsGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSuggestionsGetParams) (APIV1MarketplaceParserServiceProductsSuggestionsGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceProductsSuggestionsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceProductsSuggestionsGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSuggestionsGetParams) (res APIV1MarketplaceParserServiceProductsSuggestionsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/products/suggestions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceProductsSuggestionsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/products/suggestions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "prefix" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "prefix",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Prefix))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceProductsSuggestionsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDDelete invokes DELETE /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+//
+// Cancel the queued or running job. A finished job is returned unchanged.
+//
+// DELETE /api/v1/marketplace-parser-service/search-jobs/{id}
+func (c *Client) APIV1MarketplaceParserServiceSearchJobsIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) (APIV1MarketplaceParserServiceSearchJobsIDDeleteRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceSearchJobsIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceSearchJobsIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) (res APIV1MarketplaceParserServiceSearchJobsIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/search-jobs/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceSearchJobsIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/search-jobs/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceSearchJobsIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDGet invokes GET /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+//
+// Get the status of the job, the progress of every marketplace and the products once the job is
+// succeeded. Finished jobs are kept for a limited time.
+//
+// GET /api/v1/marketplace-parser-service/search-jobs/{id}
+func (c *Client) APIV1MarketplaceParserServiceSearchJobsIDGet(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDGetParams) (APIV1MarketplaceParserServiceSearchJobsIDGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceSearchJobsIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceSearchJobsIDGet(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDGetParams) (res APIV1MarketplaceParserServiceSearchJobsIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/search-jobs/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceSearchJobsIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/search-jobs/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceSearchJobsIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceSearchJobsPost invokes POST /api/v1/marketplace-parser-service/search-jobs operation.
+//
+// Queue a search that runs in the background. Poll the job by its id for the progress and the
+// products.
+//
+// POST /api/v1/marketplace-parser-service/search-jobs
+func (c *Client) APIV1MarketplaceParserServiceSearchJobsPost(ctx context.Context, request *SearchJobRequest) (APIV1MarketplaceParserServiceSearchJobsPostRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceSearchJobsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceSearchJobsPost(ctx context.Context, request *SearchJobRequest) (res APIV1MarketplaceParserServiceSearchJobsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/search-jobs"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceSearchJobsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/search-jobs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1MarketplaceParserServiceSearchJobsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceSearchJobsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesGet invokes GET /api/v1/marketplace-parser-service/watches operation.
+//
+// List watches.
+//
+// GET /api/v1/marketplace-parser-service/watches
+func (c *Client) APIV1MarketplaceParserServiceWatchesGet(ctx context.Context) (APIV1MarketplaceParserServiceWatchesGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesGet(ctx context.Context) (res APIV1MarketplaceParserServiceWatchesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDelete invokes DELETE /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Delete the watch and its delivery log.
+//
+// DELETE /api/v1/marketplace-parser-service/watches/{id}
+func (c *Client) APIV1MarketplaceParserServiceWatchesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeleteParams) (APIV1MarketplaceParserServiceWatchesIDDeleteRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeleteParams) (res APIV1MarketplaceParserServiceWatchesIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDeliveriesGet invokes GET /api/v1/marketplace-parser-service/watches/{id}/deliveries operation.
+//
+// Get the last webhook deliveries of the watch, the newest first.
+//
+// GET /api/v1/marketplace-parser-service/watches/{id}/deliveries
+func (c *Client) APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) (APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) (res APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches/{id}/deliveries"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesIDDeliveriesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deliveries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesIDDeliveriesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDGet invokes GET /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Get the watch and the result of its last check.
+//
+// GET /api/v1/marketplace-parser-service/watches/{id}
+func (c *Client) APIV1MarketplaceParserServiceWatchesIDGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDGetParams) (APIV1MarketplaceParserServiceWatchesIDGetRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesIDGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDGetParams) (res APIV1MarketplaceParserServiceWatchesIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDPut invokes PUT /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Replace the watch, its secret is kept. The result of the last check is reset if the product, the
+// query or the target price is changed.
+//
+// PUT /api/v1/marketplace-parser-service/watches/{id}
+func (c *Client) APIV1MarketplaceParserServiceWatchesIDPut(ctx context.Context, request *WatchRequest, params APIV1MarketplaceParserServiceWatchesIDPutParams) (APIV1MarketplaceParserServiceWatchesIDPutRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesIDPut(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesIDPut(ctx context.Context, request *WatchRequest, params APIV1MarketplaceParserServiceWatchesIDPutParams) (res APIV1MarketplaceParserServiceWatchesIDPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesIDPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1MarketplaceParserServiceWatchesIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesPost invokes POST /api/v1/marketplace-parser-service/watches operation.
+//
+// Watch a product or the cheapest product of a search query. The target is searched every interval
+// and its webhook is notified with a signed JSON POST when the price drops below targetPrice or,
+// with notifyStock, the stock of the product changes. The signature is in X-Webhook-Signature:
+// "sha256=" and the hex HMAC-SHA256 of X-Webhook-Timestamp, "." and the body keyed by the secret
+// returned by this request only. Failed deliveries are retried with backoff.
+//
+// POST /api/v1/marketplace-parser-service/watches
+func (c *Client) APIV1MarketplaceParserServiceWatchesPost(ctx context.Context, request *WatchRequest) (APIV1MarketplaceParserServiceWatchesPostRes, error) {
+	res, err := c.sendAPIV1MarketplaceParserServiceWatchesPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1MarketplaceParserServiceWatchesPost(ctx context.Context, request *WatchRequest) (res APIV1MarketplaceParserServiceWatchesPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/marketplace-parser-service/watches"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1MarketplaceParserServiceWatchesPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/marketplace-parser-service/watches"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1MarketplaceParserServiceWatchesPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1MarketplaceParserServiceWatchesPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MetricsGet invokes GET /metrics operation.
+//
+// Get the service metrics in the Prometheus text format.
+//
+// GET /metrics
+func (c *Client) MetricsGet(ctx context.Context) (MetricsGetOK, error) {
+	res, err := c.sendMetricsGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendMetricsGet(ctx context.Context) (res MetricsGetOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/metrics"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MetricsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/metrics"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMetricsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package httpgen
+
+// setDefaults set default value of fields.
+func (s *BatchQuery) setDefaults() {
+	{
+		val := bool(false)
+		s.InStockOnly.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ScheduleRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.InStockOnly.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *SearchJobRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.InStockOnly.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *WatchRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.NotifyStock.SetTo(val)
+	}
+}
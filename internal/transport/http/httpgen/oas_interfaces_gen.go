@@ -1,6 +1,78 @@
 // Code generated by ogen, DO NOT EDIT.
 package httpgen
 
+type APIV1MarketplaceParserServiceAdminSchedulesGetRes interface {
+	aPIV1MarketplaceParserServiceAdminSchedulesGetRes()
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes interface {
+	aPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes()
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesPostRes interface {
+	aPIV1MarketplaceParserServiceAdminSchedulesPostRes()
+}
+
+type APIV1MarketplaceParserServiceAdminSessionsDeleteRes interface {
+	aPIV1MarketplaceParserServiceAdminSessionsDeleteRes()
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetRes interface {
+	aPIV1MarketplaceParserServiceProductsCategoryGetRes()
+}
+
+type APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes interface {
+	aPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes()
+}
+
+type APIV1MarketplaceParserServiceProductsSearchBatchPostRes interface {
+	aPIV1MarketplaceParserServiceProductsSearchBatchPostRes()
+}
+
 type APIV1MarketplaceParserServiceProductsSearchGetRes interface {
 	aPIV1MarketplaceParserServiceProductsSearchGetRes()
 }
+
+type APIV1MarketplaceParserServiceProductsSearchStreamGetRes interface {
+	aPIV1MarketplaceParserServiceProductsSearchStreamGetRes()
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetRes interface {
+	aPIV1MarketplaceParserServiceProductsSuggestionsGetRes()
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDDeleteRes interface {
+	aPIV1MarketplaceParserServiceSearchJobsIDDeleteRes()
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDGetRes interface {
+	aPIV1MarketplaceParserServiceSearchJobsIDGetRes()
+}
+
+type APIV1MarketplaceParserServiceSearchJobsPostRes interface {
+	aPIV1MarketplaceParserServiceSearchJobsPostRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesGetRes interface {
+	aPIV1MarketplaceParserServiceWatchesGetRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeleteRes interface {
+	aPIV1MarketplaceParserServiceWatchesIDDeleteRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes interface {
+	aPIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesIDGetRes interface {
+	aPIV1MarketplaceParserServiceWatchesIDGetRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesIDPutRes interface {
+	aPIV1MarketplaceParserServiceWatchesIDPutRes()
+}
+
+type APIV1MarketplaceParserServiceWatchesPostRes interface {
+	aPIV1MarketplaceParserServiceWatchesPostRes()
+}
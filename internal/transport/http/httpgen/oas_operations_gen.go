@@ -6,5 +6,26 @@ package httpgen
 type OperationName = string
 
 const (
-	APIV1MarketplaceParserServiceProductsSearchGetOperation OperationName = "APIV1MarketplaceParserServiceProductsSearchGet"
+	APIV1MarketplaceParserServiceAdminSchedulesGetOperation               OperationName = "APIV1MarketplaceParserServiceAdminSchedulesGet"
+	APIV1MarketplaceParserServiceAdminSchedulesIDDeleteOperation          OperationName = "APIV1MarketplaceParserServiceAdminSchedulesIDDelete"
+	APIV1MarketplaceParserServiceAdminSchedulesPostOperation              OperationName = "APIV1MarketplaceParserServiceAdminSchedulesPost"
+	APIV1MarketplaceParserServiceAdminSessionsDeleteOperation             OperationName = "APIV1MarketplaceParserServiceAdminSessionsDelete"
+	APIV1MarketplaceParserServiceAdminSourcesGetOperation                 OperationName = "APIV1MarketplaceParserServiceAdminSourcesGet"
+	APIV1MarketplaceParserServiceBrowserNodesGetOperation                 OperationName = "APIV1MarketplaceParserServiceBrowserNodesGet"
+	APIV1MarketplaceParserServiceProductsCategoryGetOperation             OperationName = "APIV1MarketplaceParserServiceProductsCategoryGet"
+	APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetOperation OperationName = "APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet"
+	APIV1MarketplaceParserServiceProductsSearchBatchPostOperation         OperationName = "APIV1MarketplaceParserServiceProductsSearchBatchPost"
+	APIV1MarketplaceParserServiceProductsSearchGetOperation               OperationName = "APIV1MarketplaceParserServiceProductsSearchGet"
+	APIV1MarketplaceParserServiceProductsSearchStreamGetOperation         OperationName = "APIV1MarketplaceParserServiceProductsSearchStreamGet"
+	APIV1MarketplaceParserServiceProductsSuggestionsGetOperation          OperationName = "APIV1MarketplaceParserServiceProductsSuggestionsGet"
+	APIV1MarketplaceParserServiceSearchJobsIDDeleteOperation              OperationName = "APIV1MarketplaceParserServiceSearchJobsIDDelete"
+	APIV1MarketplaceParserServiceSearchJobsIDGetOperation                 OperationName = "APIV1MarketplaceParserServiceSearchJobsIDGet"
+	APIV1MarketplaceParserServiceSearchJobsPostOperation                  OperationName = "APIV1MarketplaceParserServiceSearchJobsPost"
+	APIV1MarketplaceParserServiceWatchesGetOperation                      OperationName = "APIV1MarketplaceParserServiceWatchesGet"
+	APIV1MarketplaceParserServiceWatchesIDDeleteOperation                 OperationName = "APIV1MarketplaceParserServiceWatchesIDDelete"
+	APIV1MarketplaceParserServiceWatchesIDDeliveriesGetOperation          OperationName = "APIV1MarketplaceParserServiceWatchesIDDeliveriesGet"
+	APIV1MarketplaceParserServiceWatchesIDGetOperation                    OperationName = "APIV1MarketplaceParserServiceWatchesIDGet"
+	APIV1MarketplaceParserServiceWatchesIDPutOperation                    OperationName = "APIV1MarketplaceParserServiceWatchesIDPut"
+	APIV1MarketplaceParserServiceWatchesPostOperation                     OperationName = "APIV1MarketplaceParserServiceWatchesPost"
+	MetricsGetOperation                                                   OperationName = "MetricsGet"
 )
@@ -4,6 +4,8 @@ package httpgen
 
 import (
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
@@ -13,23 +15,183 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// APIV1MarketplaceParserServiceProductsSearchGetParams is parameters of GET /api/v1/marketplace-parser-service/products/search operation.
-type APIV1MarketplaceParserServiceProductsSearchGetParams struct {
-	// Full or partial name of the product being searched for.
-	Name string
+// APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams is parameters of DELETE /api/v1/marketplace-parser-service/admin/schedules/{id} operation.
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceAdminSessionsDeleteParams is parameters of DELETE /api/v1/marketplace-parser-service/admin/sessions operation.
+type APIV1MarketplaceParserServiceAdminSessionsDeleteParams struct {
+	Marketplace OptMarketplace `json:",omitempty,omitzero"`
+}
+
+func unpackAPIV1MarketplaceParserServiceAdminSessionsDeleteParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceAdminSessionsDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "marketplace",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Marketplace = v.(OptMarketplace)
+		}
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceAdminSessionsDeleteParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceAdminSessionsDeleteParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: marketplace.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "marketplace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMarketplaceVal Marketplace
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMarketplaceVal = Marketplace(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Marketplace.SetTo(paramsDotMarketplaceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Marketplace.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "marketplace",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceProductsCategoryGetParams is parameters of GET /api/v1/marketplace-parser-service/products/category operation.
+type APIV1MarketplaceParserServiceProductsCategoryGetParams struct {
+	// Marketplace the category belongs to.
+	Marketplace Marketplace
+	// Catalog path relative to the marketplace site or a full catalog URL.
+	Category string
 	// Lower price limit in rubles.
 	PriceFrom OptFloat64 `json:",omitempty,omitzero"`
 	// Upper price limit in rubles.
 	PriceTo OptFloat64 `json:",omitempty,omitzero"`
+	// Return only products that are available for order.
+	InStockOnly OptBool `json:",omitempty,omitzero"`
+	// Delivery region: a city name or a region preset name from the service config. Prices and
+	// availability depend on it.
+	Region OptString `json:",omitempty,omitzero"`
+	// Capture debug artifacts of the marketplace pages even if the request succeeds.
+	Debug OptBool `json:",omitempty,omitzero"`
 }
 
-func unpackAPIV1MarketplaceParserServiceProductsSearchGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsSearchGetParams) {
+func unpackAPIV1MarketplaceParserServiceProductsCategoryGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsCategoryGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "name",
+			Name: "marketplace",
 			In:   "query",
 		}
-		params.Name = packed[key].(string)
+		params.Marketplace = packed[key].(Marketplace)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "category",
+			In:   "query",
+		}
+		params.Category = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -49,15 +211,42 @@ func unpackAPIV1MarketplaceParserServiceProductsSearchGetParams(packed middlewar
 			params.PriceTo = v.(OptFloat64)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "in_stock_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.InStockOnly = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "region",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Region = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "debug",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Debug = v.(OptBool)
+		}
+	}
 	return params
 }
 
-func decodeAPIV1MarketplaceParserServiceProductsSearchGetParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsSearchGetParams, _ error) {
+func decodeAPIV1MarketplaceParserServiceProductsCategoryGetParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsCategoryGetParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: name.
+	// Decode query: marketplace.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "name",
+			Name:    "marketplace",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -74,18 +263,62 @@ func decodeAPIV1MarketplaceParserServiceProductsSearchGetParams(args [0]string,
 					return err
 				}
 
-				params.Name = c
+				params.Marketplace = Marketplace(c)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if err := params.Marketplace.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		} else {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
+			Name: "marketplace",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: category.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Category = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "category",
 			In:   "query",
 			Err:  err,
 		}
@@ -202,5 +435,1640 @@ func decodeAPIV1MarketplaceParserServiceProductsSearchGetParams(args [0]string,
 			Err:  err,
 		}
 	}
+	// Set default value for query: in_stock_only.
+	{
+		val := bool(false)
+		params.InStockOnly.SetTo(val)
+	}
+	// Decode query: in_stock_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInStockOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInStockOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.InStockOnly.SetTo(paramsDotInStockOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "in_stock_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: region.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRegionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRegionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Region.SetTo(paramsDotRegionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "region",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: debug.
+	{
+		val := bool(false)
+		params.Debug.SetTo(val)
+	}
+	// Decode query: debug.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDebugVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDebugVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Debug.SetTo(paramsDotDebugVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "debug",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams is parameters of GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history operation.
+type APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams struct {
+	Marketplace Marketplace
+	// Product id in the marketplace, the "id" of the found product.
+	ID string
+	// Return the observations made at or after the time.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Return the observations made at or before the time.
+	To OptDateTime `json:",omitempty,omitzero"`
+	// Downsample the observations to buckets of the interval like "1h" or "24h", every observation is
+	// returned if it's not set.
+	Interval OptDuration `json:",omitempty,omitzero"`
+}
+
+func unpackAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "marketplace",
+			In:   "path",
+		}
+		params.Marketplace = packed[key].(Marketplace)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Interval = v.(OptDuration)
+		}
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams(args [2]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: marketplace.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "marketplace",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Marketplace = Marketplace(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Marketplace.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "marketplace",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIntervalVal time.Duration
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDuration(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIntervalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Interval.SetTo(paramsDotIntervalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSearchGetParams is parameters of GET /api/v1/marketplace-parser-service/products/search operation.
+type APIV1MarketplaceParserServiceProductsSearchGetParams struct {
+	// Full or partial name of the product being searched for.
+	Name string
+	// Lower price limit in rubles.
+	PriceFrom OptFloat64 `json:",omitempty,omitzero"`
+	// Upper price limit in rubles.
+	PriceTo OptFloat64 `json:",omitempty,omitzero"`
+	// Return only products that are available for order.
+	InStockOnly OptBool `json:",omitempty,omitzero"`
+	// Delivery region: a city name or a region preset name from the service config. Prices and
+	// availability depend on it.
+	Region OptString `json:",omitempty,omitzero"`
+	// Capture debug artifacts of the marketplace pages even if the request succeeds.
+	Debug OptBool `json:",omitempty,omitzero"`
+	// "no-cache" searches the marketplaces even if the result of the same search is cached.
+	CacheControl OptString `json:",omitempty,omitzero"`
+}
+
+func unpackAPIV1MarketplaceParserServiceProductsSearchGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsSearchGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		params.Name = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "price_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PriceFrom = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "price_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PriceTo = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "in_stock_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.InStockOnly = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "region",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Region = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "debug",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Debug = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Cache-Control",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.CacheControl = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceProductsSearchGetParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsSearchGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Name = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: price_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "price_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPriceFromVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPriceFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PriceFrom.SetTo(paramsDotPriceFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PriceFrom.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "price_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: price_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "price_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPriceToVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPriceToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PriceTo.SetTo(paramsDotPriceToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PriceTo.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "price_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: in_stock_only.
+	{
+		val := bool(false)
+		params.InStockOnly.SetTo(val)
+	}
+	// Decode query: in_stock_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInStockOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInStockOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.InStockOnly.SetTo(paramsDotInStockOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "in_stock_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: region.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRegionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRegionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Region.SetTo(paramsDotRegionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "region",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: debug.
+	{
+		val := bool(false)
+		params.Debug.SetTo(val)
+	}
+	// Decode query: debug.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDebugVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDebugVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Debug.SetTo(paramsDotDebugVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "debug",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Cache-Control.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Cache-Control",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCacheControlVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCacheControlVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CacheControl.SetTo(paramsDotCacheControlVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Cache-Control",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSearchStreamGetParams is parameters of GET /api/v1/marketplace-parser-service/products/search/stream operation.
+type APIV1MarketplaceParserServiceProductsSearchStreamGetParams struct {
+	// Full or partial name of the product being searched for.
+	Name string
+	// Lower price limit in rubles.
+	PriceFrom OptFloat64 `json:",omitempty,omitzero"`
+	// Upper price limit in rubles.
+	PriceTo OptFloat64 `json:",omitempty,omitzero"`
+	// Return only products that are available for order.
+	InStockOnly OptBool `json:",omitempty,omitzero"`
+	// Delivery region: a city name or a region preset name from the service config. Prices and
+	// availability depend on it.
+	Region OptString `json:",omitempty,omitzero"`
+	// Capture debug artifacts of the marketplace pages even if the request succeeds.
+	Debug OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackAPIV1MarketplaceParserServiceProductsSearchStreamGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsSearchStreamGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		params.Name = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "price_from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PriceFrom = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "price_to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PriceTo = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "in_stock_only",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.InStockOnly = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "region",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Region = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "debug",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Debug = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceProductsSearchStreamGetParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsSearchStreamGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Name = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: price_from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "price_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPriceFromVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPriceFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PriceFrom.SetTo(paramsDotPriceFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PriceFrom.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "price_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: price_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "price_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPriceToVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPriceToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PriceTo.SetTo(paramsDotPriceToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PriceTo.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "price_to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: in_stock_only.
+	{
+		val := bool(false)
+		params.InStockOnly.SetTo(val)
+	}
+	// Decode query: in_stock_only.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "in_stock_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotInStockOnlyVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInStockOnlyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.InStockOnly.SetTo(paramsDotInStockOnlyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "in_stock_only",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: region.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "region",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRegionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRegionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Region.SetTo(paramsDotRegionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "region",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: debug.
+	{
+		val := bool(false)
+		params.Debug.SetTo(val)
+	}
+	// Decode query: debug.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "debug",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDebugVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDebugVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Debug.SetTo(paramsDotDebugVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "debug",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceProductsSuggestionsGetParams is parameters of GET /api/v1/marketplace-parser-service/products/suggestions operation.
+type APIV1MarketplaceParserServiceProductsSuggestionsGetParams struct {
+	// Beginning of the search query.
+	Prefix string
+}
+
+func unpackAPIV1MarketplaceParserServiceProductsSuggestionsGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceProductsSuggestionsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "prefix",
+			In:   "query",
+		}
+		params.Prefix = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceProductsSuggestionsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceProductsSuggestionsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: prefix.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prefix",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Prefix = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prefix",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDDeleteParams is parameters of DELETE /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+type APIV1MarketplaceParserServiceSearchJobsIDDeleteParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceSearchJobsIDDeleteParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceSearchJobsIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDGetParams is parameters of GET /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+type APIV1MarketplaceParserServiceSearchJobsIDGetParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceSearchJobsIDGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceSearchJobsIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceSearchJobsIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceSearchJobsIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDeleteParams is parameters of DELETE /api/v1/marketplace-parser-service/watches/{id} operation.
+type APIV1MarketplaceParserServiceWatchesIDDeleteParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceWatchesIDDeleteParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceWatchesIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceWatchesIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceWatchesIDDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams is parameters of GET /api/v1/marketplace-parser-service/watches/{id}/deliveries operation.
+type APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDGetParams is parameters of GET /api/v1/marketplace-parser-service/watches/{id} operation.
+type APIV1MarketplaceParserServiceWatchesIDGetParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceWatchesIDGetParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceWatchesIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceWatchesIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceWatchesIDGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// APIV1MarketplaceParserServiceWatchesIDPutParams is parameters of PUT /api/v1/marketplace-parser-service/watches/{id} operation.
+type APIV1MarketplaceParserServiceWatchesIDPutParams struct {
+	ID string
+}
+
+func unpackAPIV1MarketplaceParserServiceWatchesIDPutParams(packed middleware.Parameters) (params APIV1MarketplaceParserServiceWatchesIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAPIV1MarketplaceParserServiceWatchesIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params APIV1MarketplaceParserServiceWatchesIDPutParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,24 +49,526 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/marketplace-parser-service/products/search"
+		case '/': // Prefix: "/"
 
-			if l := len("/api/v1/marketplace-parser-service/products/search"); len(elem) >= l && elem[0:l] == "/api/v1/marketplace-parser-service/products/search" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch r.Method {
-				case "GET":
-					s.handleAPIV1MarketplaceParserServiceProductsSearchGetRequest([0]string{}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "GET")
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "api/v1/marketplace-parser-service/"
+
+				if l := len("api/v1/marketplace-parser-service/"); len(elem) >= l && elem[0:l] == "api/v1/marketplace-parser-service/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "admin/s"
+
+					if l := len("admin/s"); len(elem) >= l && elem[0:l] == "admin/s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "chedules"
+
+						if l := len("chedules"); len(elem) >= l && elem[0:l] == "chedules" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1MarketplaceParserServiceAdminSchedulesGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAPIV1MarketplaceParserServiceAdminSchedulesPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleAPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						}
+
+					case 'e': // Prefix: "essions"
+
+						if l := len("essions"); len(elem) >= l && elem[0:l] == "essions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleAPIV1MarketplaceParserServiceAdminSessionsDeleteRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
+					case 'o': // Prefix: "ources"
+
+						if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1MarketplaceParserServiceAdminSourcesGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'b': // Prefix: "browser/nodes"
+
+					if l := len("browser/nodes"); len(elem) >= l && elem[0:l] == "browser/nodes" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketplaceParserServiceBrowserNodesGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'p': // Prefix: "products/"
+
+					if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "category"
+						origElem := elem
+						if l := len("category"); len(elem) >= l && elem[0:l] == "category" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1MarketplaceParserServiceProductsCategoryGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 's': // Prefix: "s"
+						origElem := elem
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "earch"
+
+							if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleAPIV1MarketplaceParserServiceProductsSearchGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "batch"
+
+									if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAPIV1MarketplaceParserServiceProductsSearchBatchPostRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "stream"
+
+									if l := len("stream"); len(elem) >= l && elem[0:l] == "stream" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAPIV1MarketplaceParserServiceProductsSearchStreamGetRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						case 'u': // Prefix: "uggestions"
+
+							if l := len("uggestions"); len(elem) >= l && elem[0:l] == "uggestions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAPIV1MarketplaceParserServiceProductsSuggestionsGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+						elem = origElem
+					}
+					// Param: "marketplace"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[1] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/history"
+
+							if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRequest([2]string{
+										args[0],
+										args[1],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "search-jobs"
+
+					if l := len("search-jobs"); len(elem) >= l && elem[0:l] == "search-jobs" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleAPIV1MarketplaceParserServiceSearchJobsPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleAPIV1MarketplaceParserServiceSearchJobsIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleAPIV1MarketplaceParserServiceSearchJobsIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'w': // Prefix: "watches"
+
+					if l := len("watches"); len(elem) >= l && elem[0:l] == "watches" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAPIV1MarketplaceParserServiceWatchesGetRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleAPIV1MarketplaceParserServiceWatchesPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleAPIV1MarketplaceParserServiceWatchesIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleAPIV1MarketplaceParserServiceWatchesIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleAPIV1MarketplaceParserServiceWatchesIDPutRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deliveries"
+
+							if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAPIV1MarketplaceParserServiceWatchesIDDeliveriesGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'm': // Prefix: "metrics"
+
+				if l := len("metrics"); len(elem) >= l && elem[0:l] == "metrics" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleMetricsGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
 				}
 
-				return
 			}
 
 		}
@@ -81,7 +584,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [2]string
 }
 
 // Name returns ogen operation name.
@@ -154,29 +657,629 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/marketplace-parser-service/products/search"
+		case '/': // Prefix: "/"
 
-			if l := len("/api/v1/marketplace-parser-service/products/search"); len(elem) >= l && elem[0:l] == "/api/v1/marketplace-parser-service/products/search" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch method {
-				case "GET":
-					r.name = APIV1MarketplaceParserServiceProductsSearchGetOperation
-					r.summary = "Search products."
-					r.operationID = ""
-					r.operationGroup = ""
-					r.pathPattern = "/api/v1/marketplace-parser-service/products/search"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "api/v1/marketplace-parser-service/"
+
+				if l := len("api/v1/marketplace-parser-service/"); len(elem) >= l && elem[0:l] == "api/v1/marketplace-parser-service/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
 				}
+				switch elem[0] {
+				case 'a': // Prefix: "admin/s"
+
+					if l := len("admin/s"); len(elem) >= l && elem[0:l] == "admin/s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "chedules"
+
+						if l := len("chedules"); len(elem) >= l && elem[0:l] == "chedules" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = APIV1MarketplaceParserServiceAdminSchedulesGetOperation
+								r.summary = "Scheduled searches."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/admin/schedules"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = APIV1MarketplaceParserServiceAdminSchedulesPostOperation
+								r.summary = "Create a schedule."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/admin/schedules"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = APIV1MarketplaceParserServiceAdminSchedulesIDDeleteOperation
+									r.summary = "Delete a schedule."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/marketplace-parser-service/admin/schedules/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'e': // Prefix: "essions"
+
+						if l := len("essions"); len(elem) >= l && elem[0:l] == "essions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = APIV1MarketplaceParserServiceAdminSessionsDeleteOperation
+								r.summary = "Reset sessions."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/admin/sessions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'o': // Prefix: "ources"
+
+						if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1MarketplaceParserServiceAdminSourcesGetOperation
+								r.summary = "Sources status."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/admin/sources"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'b': // Prefix: "browser/nodes"
+
+					if l := len("browser/nodes"); len(elem) >= l && elem[0:l] == "browser/nodes" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = APIV1MarketplaceParserServiceBrowserNodesGetOperation
+							r.summary = "Browser nodes."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/marketplace-parser-service/browser/nodes"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'p': // Prefix: "products/"
+
+					if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "category"
+						origElem := elem
+						if l := len("category"); len(elem) >= l && elem[0:l] == "category" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1MarketplaceParserServiceProductsCategoryGetOperation
+								r.summary = "List category products."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/products/category"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 's': // Prefix: "s"
+						origElem := elem
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "earch"
+
+							if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = APIV1MarketplaceParserServiceProductsSearchGetOperation
+									r.summary = "Search products."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/marketplace-parser-service/products/search"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "batch"
+
+									if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = APIV1MarketplaceParserServiceProductsSearchBatchPostOperation
+											r.summary = "Batch search."
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/marketplace-parser-service/products/search/batch"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "stream"
+
+									if l := len("stream"); len(elem) >= l && elem[0:l] == "stream" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = APIV1MarketplaceParserServiceProductsSearchStreamGetOperation
+											r.summary = "Stream searched products."
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/marketplace-parser-service/products/search/stream"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'u': // Prefix: "uggestions"
+
+							if l := len("uggestions"); len(elem) >= l && elem[0:l] == "uggestions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = APIV1MarketplaceParserServiceProductsSuggestionsGetOperation
+									r.summary = "Search suggestions."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/marketplace-parser-service/products/suggestions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+						elem = origElem
+					}
+					// Param: "marketplace"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[1] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/history"
+
+							if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetOperation
+									r.summary = "Price history."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/marketplace-parser-service/products/{marketplace}/{id}/history"
+									r.args = args
+									r.count = 2
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "search-jobs"
+
+					if l := len("search-jobs"); len(elem) >= l && elem[0:l] == "search-jobs" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = APIV1MarketplaceParserServiceSearchJobsPostOperation
+							r.summary = "Create a search job."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/marketplace-parser-service/search-jobs"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = APIV1MarketplaceParserServiceSearchJobsIDDeleteOperation
+								r.summary = "Cancel a search job."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/search-jobs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = APIV1MarketplaceParserServiceSearchJobsIDGetOperation
+								r.summary = "Get a search job."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/search-jobs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'w': // Prefix: "watches"
+
+					if l := len("watches"); len(elem) >= l && elem[0:l] == "watches" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = APIV1MarketplaceParserServiceWatchesGetOperation
+							r.summary = "List watches."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/marketplace-parser-service/watches"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = APIV1MarketplaceParserServiceWatchesPostOperation
+							r.summary = "Create a watch."
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/marketplace-parser-service/watches"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = APIV1MarketplaceParserServiceWatchesIDDeleteOperation
+								r.summary = "Delete a watch."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/watches/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = APIV1MarketplaceParserServiceWatchesIDGetOperation
+								r.summary = "Get a watch."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/watches/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = APIV1MarketplaceParserServiceWatchesIDPutOperation
+								r.summary = "Update a watch."
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/marketplace-parser-service/watches/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deliveries"
+
+							if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = APIV1MarketplaceParserServiceWatchesIDDeliveriesGetOperation
+									r.summary = "Watch deliveries."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/marketplace-parser-service/watches/{id}/deliveries"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'm': // Prefix: "metrics"
+
+				if l := len("metrics"); len(elem) >= l && elem[0:l] == "metrics" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = MetricsGetOperation
+						r.summary = "Metrics."
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/metrics"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
 			}
 
 		}
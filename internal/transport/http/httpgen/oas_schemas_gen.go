@@ -2,6 +2,135 @@
 
 package httpgen
 
+import (
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+type APIV1MarketplaceParserServiceAdminSchedulesGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesGetInternalServerError) aPIV1MarketplaceParserServiceAdminSchedulesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesGetNotFound) aPIV1MarketplaceParserServiceAdminSchedulesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteConflict ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesIDDeleteConflict) aPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesIDDeleteInternalServerError) aPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes() {
+}
+
+// APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNoContent is response for APIV1MarketplaceParserServiceAdminSchedulesIDDelete operation.
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNoContent struct{}
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNoContent) aPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNotFound) aPIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesPostBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesPostBadRequest) aPIV1MarketplaceParserServiceAdminSchedulesPostRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesPostInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesPostInternalServerError) aPIV1MarketplaceParserServiceAdminSchedulesPostRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSchedulesPostNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSchedulesPostNotFound) aPIV1MarketplaceParserServiceAdminSchedulesPostRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSessionsDeleteBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSessionsDeleteBadRequest) aPIV1MarketplaceParserServiceAdminSessionsDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceAdminSessionsDeleteInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceAdminSessionsDeleteInternalServerError) aPIV1MarketplaceParserServiceAdminSessionsDeleteRes() {
+}
+
+// APIV1MarketplaceParserServiceAdminSessionsDeleteNoContent is response for APIV1MarketplaceParserServiceAdminSessionsDelete operation.
+type APIV1MarketplaceParserServiceAdminSessionsDeleteNoContent struct{}
+
+func (*APIV1MarketplaceParserServiceAdminSessionsDeleteNoContent) aPIV1MarketplaceParserServiceAdminSessionsDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetBadRequest) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetCode499 ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetCode499) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetGatewayTimeout) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetInternalServerError) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetServiceUnavailable ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetServiceUnavailable) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsCategoryGetTooManyRequests ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsCategoryGetTooManyRequests) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetBadRequest) aPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetInternalServerError) aPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetNotFound) aPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSearchBatchPostBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchBatchPostBadRequest) aPIV1MarketplaceParserServiceProductsSearchBatchPostRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSearchBatchPostCode499 ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchBatchPostCode499) aPIV1MarketplaceParserServiceProductsSearchBatchPostRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSearchBatchPostInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchBatchPostInternalServerError) aPIV1MarketplaceParserServiceProductsSearchBatchPostRes() {
+}
+
 type APIV1MarketplaceParserServiceProductsSearchGetBadRequest ErrorResponse
 
 func (*APIV1MarketplaceParserServiceProductsSearchGetBadRequest) aPIV1MarketplaceParserServiceProductsSearchGetRes() {
@@ -22,137 +151,3099 @@ type APIV1MarketplaceParserServiceProductsSearchGetInternalServerError ErrorResp
 func (*APIV1MarketplaceParserServiceProductsSearchGetInternalServerError) aPIV1MarketplaceParserServiceProductsSearchGetRes() {
 }
 
-// Ref: #/components/schemas/ErrorResponse
-type ErrorResponse struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
+type APIV1MarketplaceParserServiceProductsSearchGetOKXCache string
+
+const (
+	APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT  APIV1MarketplaceParserServiceProductsSearchGetOKXCache = "HIT"
+	APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS APIV1MarketplaceParserServiceProductsSearchGetOKXCache = "MISS"
+)
+
+// AllValues returns all APIV1MarketplaceParserServiceProductsSearchGetOKXCache values.
+func (APIV1MarketplaceParserServiceProductsSearchGetOKXCache) AllValues() []APIV1MarketplaceParserServiceProductsSearchGetOKXCache {
+	return []APIV1MarketplaceParserServiceProductsSearchGetOKXCache{
+		APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT,
+		APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS,
+	}
 }
 
-// GetStatus returns the value of Status.
-func (s *ErrorResponse) GetStatus() int {
-	return s.Status
+// MarshalText implements encoding.TextMarshaler.
+func (s APIV1MarketplaceParserServiceProductsSearchGetOKXCache) MarshalText() ([]byte, error) {
+	switch s {
+	case APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT:
+		return []byte(s), nil
+	case APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorResponse) GetMessage() string {
-	return s.Message
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIV1MarketplaceParserServiceProductsSearchGetOKXCache) UnmarshalText(data []byte) error {
+	switch APIV1MarketplaceParserServiceProductsSearchGetOKXCache(data) {
+	case APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT:
+		*s = APIV1MarketplaceParserServiceProductsSearchGetOKXCacheHIT
+		return nil
+	case APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS:
+		*s = APIV1MarketplaceParserServiceProductsSearchGetOKXCacheMISS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetStatus sets the value of Status.
-func (s *ErrorResponse) SetStatus(val int) {
-	s.Status = val
+type APIV1MarketplaceParserServiceProductsSearchGetServiceUnavailable ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSearchGetServiceUnavailable) aPIV1MarketplaceParserServiceProductsSearchGetRes() {
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorResponse) SetMessage(val string) {
-	s.Message = val
+type APIV1MarketplaceParserServiceProductsSearchGetTooManyRequests ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSearchGetTooManyRequests) aPIV1MarketplaceParserServiceProductsSearchGetRes() {
 }
 
-// NewOptFloat64 returns new OptFloat64 with value set to v.
-func NewOptFloat64(v float64) OptFloat64 {
-	return OptFloat64{
-		Value: v,
-		Set:   true,
-	}
+type APIV1MarketplaceParserServiceProductsSearchStreamGetBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetBadRequest) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
 }
 
-// OptFloat64 is optional float64.
-type OptFloat64 struct {
-	Value float64
-	Set   bool
+type APIV1MarketplaceParserServiceProductsSearchStreamGetCode499 ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetCode499) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
 }
 
-// IsSet returns true if OptFloat64 was set.
-func (o OptFloat64) IsSet() bool { return o.Set }
+type APIV1MarketplaceParserServiceProductsSearchStreamGetGatewayTimeout ErrorResponse
 
-// Reset unsets value.
-func (o *OptFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetGatewayTimeout) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
 }
 
-// SetTo sets value to v.
-func (o *OptFloat64) SetTo(v float64) {
-	o.Set = true
-	o.Value = v
+type APIV1MarketplaceParserServiceProductsSearchStreamGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetInternalServerError) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat64) Get() (v float64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type APIV1MarketplaceParserServiceProductsSearchStreamGetOK struct {
+	Data io.Reader
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s APIV1MarketplaceParserServiceProductsSearchStreamGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return d
+	return s.Data.Read(p)
 }
 
-// Ref: #/components/schemas/Product
-type Product struct {
-	Name         string  `json:"name"`
-	Link         string  `json:"link"`
-	Price        float64 `json:"price"`
-	Rating       float64 `json:"rating"`
-	ReviewsCount int     `json:"reviewsCount"`
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetOK) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSearchStreamGetServiceUnavailable ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetServiceUnavailable) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSearchStreamGetTooManyRequests ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSearchStreamGetTooManyRequests) aPIV1MarketplaceParserServiceProductsSearchStreamGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetBadRequest) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetCode499 ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetCode499) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetGatewayTimeout ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetGatewayTimeout) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetInternalServerError) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetServiceUnavailable ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetServiceUnavailable) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceProductsSuggestionsGetTooManyRequests ErrorResponseHeaders
+
+func (*APIV1MarketplaceParserServiceProductsSuggestionsGetTooManyRequests) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsIDDeleteInternalServerError) aPIV1MarketplaceParserServiceSearchJobsIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDDeleteNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsIDDeleteNotFound) aPIV1MarketplaceParserServiceSearchJobsIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsIDGetInternalServerError) aPIV1MarketplaceParserServiceSearchJobsIDGetRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsIDGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsIDGetNotFound) aPIV1MarketplaceParserServiceSearchJobsIDGetRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsPostBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsPostBadRequest) aPIV1MarketplaceParserServiceSearchJobsPostRes() {
+}
+
+type APIV1MarketplaceParserServiceSearchJobsPostInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceSearchJobsPostInternalServerError) aPIV1MarketplaceParserServiceSearchJobsPostRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesGetInternalServerError) aPIV1MarketplaceParserServiceWatchesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesGetNotFound) aPIV1MarketplaceParserServiceWatchesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDDeleteInternalServerError) aPIV1MarketplaceParserServiceWatchesIDDeleteRes() {
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDeleteNoContent is response for APIV1MarketplaceParserServiceWatchesIDDelete operation.
+type APIV1MarketplaceParserServiceWatchesIDDeleteNoContent struct{}
+
+func (*APIV1MarketplaceParserServiceWatchesIDDeleteNoContent) aPIV1MarketplaceParserServiceWatchesIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeleteNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDDeleteNotFound) aPIV1MarketplaceParserServiceWatchesIDDeleteRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeliveriesGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDDeliveriesGetInternalServerError) aPIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDDeliveriesGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDDeliveriesGetNotFound) aPIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDGetInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDGetInternalServerError) aPIV1MarketplaceParserServiceWatchesIDGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDGetNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDGetNotFound) aPIV1MarketplaceParserServiceWatchesIDGetRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDPutBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDPutBadRequest) aPIV1MarketplaceParserServiceWatchesIDPutRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDPutInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDPutInternalServerError) aPIV1MarketplaceParserServiceWatchesIDPutRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesIDPutNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesIDPutNotFound) aPIV1MarketplaceParserServiceWatchesIDPutRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesPostBadRequest ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesPostBadRequest) aPIV1MarketplaceParserServiceWatchesPostRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesPostInternalServerError ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesPostInternalServerError) aPIV1MarketplaceParserServiceWatchesPostRes() {
+}
+
+type APIV1MarketplaceParserServiceWatchesPostNotFound ErrorResponse
+
+func (*APIV1MarketplaceParserServiceWatchesPostNotFound) aPIV1MarketplaceParserServiceWatchesPostRes() {
+}
+
+// Ref: #/components/schemas/BatchQuery
+type BatchQuery struct {
+	// Key of the query result, the name is used if it's not set. The keys must be unique within the
+	// batch.
+	Key OptString `json:"key"`
+	// Full or partial name of the product being searched for.
+	Name        string     `json:"name"`
+	PriceFrom   OptFloat64 `json:"priceFrom"`
+	PriceTo     OptFloat64 `json:"priceTo"`
+	InStockOnly OptBool    `json:"inStockOnly"`
+	Region      OptString  `json:"region"`
+	// Marketplaces to search in, all of them if it's not set.
+	Marketplaces []Marketplace `json:"marketplaces"`
+}
+
+// GetKey returns the value of Key.
+func (s *BatchQuery) GetKey() OptString {
+	return s.Key
 }
 
 // GetName returns the value of Name.
-func (s *Product) GetName() string {
+func (s *BatchQuery) GetName() string {
 	return s.Name
 }
 
-// GetLink returns the value of Link.
-func (s *Product) GetLink() string {
-	return s.Link
+// GetPriceFrom returns the value of PriceFrom.
+func (s *BatchQuery) GetPriceFrom() OptFloat64 {
+	return s.PriceFrom
 }
 
-// GetPrice returns the value of Price.
-func (s *Product) GetPrice() float64 {
-	return s.Price
+// GetPriceTo returns the value of PriceTo.
+func (s *BatchQuery) GetPriceTo() OptFloat64 {
+	return s.PriceTo
 }
 
-// GetRating returns the value of Rating.
-func (s *Product) GetRating() float64 {
-	return s.Rating
+// GetInStockOnly returns the value of InStockOnly.
+func (s *BatchQuery) GetInStockOnly() OptBool {
+	return s.InStockOnly
 }
 
-// GetReviewsCount returns the value of ReviewsCount.
-func (s *Product) GetReviewsCount() int {
-	return s.ReviewsCount
+// GetRegion returns the value of Region.
+func (s *BatchQuery) GetRegion() OptString {
+	return s.Region
+}
+
+// GetMarketplaces returns the value of Marketplaces.
+func (s *BatchQuery) GetMarketplaces() []Marketplace {
+	return s.Marketplaces
+}
+
+// SetKey sets the value of Key.
+func (s *BatchQuery) SetKey(val OptString) {
+	s.Key = val
 }
 
 // SetName sets the value of Name.
-func (s *Product) SetName(val string) {
+func (s *BatchQuery) SetName(val string) {
 	s.Name = val
 }
 
-// SetLink sets the value of Link.
-func (s *Product) SetLink(val string) {
-	s.Link = val
+// SetPriceFrom sets the value of PriceFrom.
+func (s *BatchQuery) SetPriceFrom(val OptFloat64) {
+	s.PriceFrom = val
 }
 
-// SetPrice sets the value of Price.
-func (s *Product) SetPrice(val float64) {
-	s.Price = val
+// SetPriceTo sets the value of PriceTo.
+func (s *BatchQuery) SetPriceTo(val OptFloat64) {
+	s.PriceTo = val
 }
 
-// SetRating sets the value of Rating.
-func (s *Product) SetRating(val float64) {
-	s.Rating = val
+// SetInStockOnly sets the value of InStockOnly.
+func (s *BatchQuery) SetInStockOnly(val OptBool) {
+	s.InStockOnly = val
 }
 
-// SetReviewsCount sets the value of ReviewsCount.
-func (s *Product) SetReviewsCount(val int) {
-	s.ReviewsCount = val
+// SetRegion sets the value of Region.
+func (s *BatchQuery) SetRegion(val OptString) {
+	s.Region = val
 }
 
-type SearchProductsResponse []Product
+// SetMarketplaces sets the value of Marketplaces.
+func (s *BatchQuery) SetMarketplaces(val []Marketplace) {
+	s.Marketplaces = val
+}
+
+// Ref: #/components/schemas/BatchQueryResult
+type BatchQueryResult struct {
+	Key             string           `json:"key"`
+	Products        []Product        `json:"products"`
+	TimedOutSources []Marketplace    `json:"timedOutSources"`
+	Error           OptErrorResponse `json:"error"`
+}
+
+// GetKey returns the value of Key.
+func (s *BatchQueryResult) GetKey() string {
+	return s.Key
+}
+
+// GetProducts returns the value of Products.
+func (s *BatchQueryResult) GetProducts() []Product {
+	return s.Products
+}
+
+// GetTimedOutSources returns the value of TimedOutSources.
+func (s *BatchQueryResult) GetTimedOutSources() []Marketplace {
+	return s.TimedOutSources
+}
+
+// GetError returns the value of Error.
+func (s *BatchQueryResult) GetError() OptErrorResponse {
+	return s.Error
+}
+
+// SetKey sets the value of Key.
+func (s *BatchQueryResult) SetKey(val string) {
+	s.Key = val
+}
+
+// SetProducts sets the value of Products.
+func (s *BatchQueryResult) SetProducts(val []Product) {
+	s.Products = val
+}
+
+// SetTimedOutSources sets the value of TimedOutSources.
+func (s *BatchQueryResult) SetTimedOutSources(val []Marketplace) {
+	s.TimedOutSources = val
+}
+
+// SetError sets the value of Error.
+func (s *BatchQueryResult) SetError(val OptErrorResponse) {
+	s.Error = val
+}
+
+// Ref: #/components/schemas/BrowserNode
+type BrowserNode struct {
+	URL string `json:"url"`
+	// False if the node is ejected after a failed connecting or health check.
+	Healthy bool `json:"healthy"`
+	// Number of opened connections to the node.
+	Connections int `json:"connections"`
+	// Number of pages opened on the node.
+	Pages int `json:"pages"`
+	// Number of failures in a row.
+	Failures  int       `json:"failures"`
+	LastError OptString `json:"lastError"`
+	// Time of the last connecting or health check.
+	CheckedAt OptDateTime `json:"checkedAt"`
+}
+
+// GetURL returns the value of URL.
+func (s *BrowserNode) GetURL() string {
+	return s.URL
+}
+
+// GetHealthy returns the value of Healthy.
+func (s *BrowserNode) GetHealthy() bool {
+	return s.Healthy
+}
+
+// GetConnections returns the value of Connections.
+func (s *BrowserNode) GetConnections() int {
+	return s.Connections
+}
+
+// GetPages returns the value of Pages.
+func (s *BrowserNode) GetPages() int {
+	return s.Pages
+}
+
+// GetFailures returns the value of Failures.
+func (s *BrowserNode) GetFailures() int {
+	return s.Failures
+}
+
+// GetLastError returns the value of LastError.
+func (s *BrowserNode) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCheckedAt returns the value of CheckedAt.
+func (s *BrowserNode) GetCheckedAt() OptDateTime {
+	return s.CheckedAt
+}
+
+// SetURL sets the value of URL.
+func (s *BrowserNode) SetURL(val string) {
+	s.URL = val
+}
+
+// SetHealthy sets the value of Healthy.
+func (s *BrowserNode) SetHealthy(val bool) {
+	s.Healthy = val
+}
+
+// SetConnections sets the value of Connections.
+func (s *BrowserNode) SetConnections(val int) {
+	s.Connections = val
+}
+
+// SetPages sets the value of Pages.
+func (s *BrowserNode) SetPages(val int) {
+	s.Pages = val
+}
+
+// SetFailures sets the value of Failures.
+func (s *BrowserNode) SetFailures(val int) {
+	s.Failures = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *BrowserNode) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCheckedAt sets the value of CheckedAt.
+func (s *BrowserNode) SetCheckedAt(val OptDateTime) {
+	s.CheckedAt = val
+}
+
+type BrowserNodesResponse []BrowserNode
+
+type DeliveriesResponse []Delivery
+
+func (*DeliveriesResponse) aPIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes() {}
+
+// Ref: #/components/schemas/Delivery
+type Delivery struct {
+	// Delivery id, sent in X-Webhook-Id.
+	ID       string         `json:"id"`
+	Event    DeliveryEvent  `json:"event"`
+	Product  Product        `json:"product"`
+	Status   DeliveryStatus `json:"status"`
+	Attempts int            `json:"attempts"`
+	// HTTP status of the last attempt, absent if the webhook didn't respond.
+	ResponseStatus OptInt `json:"responseStatus"`
+	// Error of the last failed attempt.
+	Error     OptString `json:"error"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Time of the next attempt of the pending delivery.
+	NextAttemptAt OptDateTime `json:"nextAttemptAt"`
+	DeliveredAt   OptDateTime `json:"deliveredAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Delivery) GetID() string {
+	return s.ID
+}
+
+// GetEvent returns the value of Event.
+func (s *Delivery) GetEvent() DeliveryEvent {
+	return s.Event
+}
+
+// GetProduct returns the value of Product.
+func (s *Delivery) GetProduct() Product {
+	return s.Product
+}
+
+// GetStatus returns the value of Status.
+func (s *Delivery) GetStatus() DeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *Delivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetResponseStatus returns the value of ResponseStatus.
+func (s *Delivery) GetResponseStatus() OptInt {
+	return s.ResponseStatus
+}
+
+// GetError returns the value of Error.
+func (s *Delivery) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Delivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *Delivery) GetNextAttemptAt() OptDateTime {
+	return s.NextAttemptAt
+}
+
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *Delivery) GetDeliveredAt() OptDateTime {
+	return s.DeliveredAt
+}
+
+// SetID sets the value of ID.
+func (s *Delivery) SetID(val string) {
+	s.ID = val
+}
+
+// SetEvent sets the value of Event.
+func (s *Delivery) SetEvent(val DeliveryEvent) {
+	s.Event = val
+}
+
+// SetProduct sets the value of Product.
+func (s *Delivery) SetProduct(val Product) {
+	s.Product = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Delivery) SetStatus(val DeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *Delivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetResponseStatus sets the value of ResponseStatus.
+func (s *Delivery) SetResponseStatus(val OptInt) {
+	s.ResponseStatus = val
+}
+
+// SetError sets the value of Error.
+func (s *Delivery) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Delivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *Delivery) SetNextAttemptAt(val OptDateTime) {
+	s.NextAttemptAt = val
+}
+
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *Delivery) SetDeliveredAt(val OptDateTime) {
+	s.DeliveredAt = val
+}
+
+type DeliveryEvent string
+
+const (
+	DeliveryEventPriceDrop   DeliveryEvent = "price_drop"
+	DeliveryEventStockChange DeliveryEvent = "stock_change"
+)
+
+// AllValues returns all DeliveryEvent values.
+func (DeliveryEvent) AllValues() []DeliveryEvent {
+	return []DeliveryEvent{
+		DeliveryEventPriceDrop,
+		DeliveryEventStockChange,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DeliveryEvent) MarshalText() ([]byte, error) {
+	switch s {
+	case DeliveryEventPriceDrop:
+		return []byte(s), nil
+	case DeliveryEventStockChange:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeliveryEvent) UnmarshalText(data []byte) error {
+	switch DeliveryEvent(data) {
+	case DeliveryEventPriceDrop:
+		*s = DeliveryEventPriceDrop
+		return nil
+	case DeliveryEventStockChange:
+		*s = DeliveryEventStockChange
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// AllValues returns all DeliveryStatus values.
+func (DeliveryStatus) AllValues() []DeliveryStatus {
+	return []DeliveryStatus{
+		DeliveryStatusPending,
+		DeliveryStatusDelivered,
+		DeliveryStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case DeliveryStatusPending:
+		return []byte(s), nil
+	case DeliveryStatusDelivered:
+		return []byte(s), nil
+	case DeliveryStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DeliveryStatus) UnmarshalText(data []byte) error {
+	switch DeliveryStatus(data) {
+	case DeliveryStatusPending:
+		*s = DeliveryStatusPending
+		return nil
+	case DeliveryStatusDelivered:
+		*s = DeliveryStatusDelivered
+		return nil
+	case DeliveryStatusFailed:
+		*s = DeliveryStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ErrorResponse
+type ErrorResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	// Id of the debug artifact captured on the failure.
+	ArtifactId OptString `json:"artifactId"`
+}
+
+// GetStatus returns the value of Status.
+func (s *ErrorResponse) GetStatus() int {
+	return s.Status
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorResponse) GetMessage() string {
+	return s.Message
+}
+
+// GetArtifactId returns the value of ArtifactId.
+func (s *ErrorResponse) GetArtifactId() OptString {
+	return s.ArtifactId
+}
+
+// SetStatus sets the value of Status.
+func (s *ErrorResponse) SetStatus(val int) {
+	s.Status = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetArtifactId sets the value of ArtifactId.
+func (s *ErrorResponse) SetArtifactId(val OptString) {
+	s.ArtifactId = val
+}
+
+// ErrorResponseHeaders wraps ErrorResponse with response headers.
+type ErrorResponseHeaders struct {
+	RetryAfter OptInt
+	Response   ErrorResponse
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *ErrorResponseHeaders) GetRetryAfter() OptInt {
+	return s.RetryAfter
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *ErrorResponseHeaders) SetRetryAfter(val OptInt) {
+	s.RetryAfter = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+func (*ErrorResponseHeaders) aPIV1MarketplaceParserServiceSearchJobsPostRes() {}
+
+// Ref: #/components/schemas/Marketplace
+type Marketplace string
+
+const (
+	MarketplaceWildberries Marketplace = "wildberries"
+	MarketplaceOzon        Marketplace = "ozon"
+)
+
+// AllValues returns all Marketplace values.
+func (Marketplace) AllValues() []Marketplace {
+	return []Marketplace{
+		MarketplaceWildberries,
+		MarketplaceOzon,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Marketplace) MarshalText() ([]byte, error) {
+	switch s {
+	case MarketplaceWildberries:
+		return []byte(s), nil
+	case MarketplaceOzon:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Marketplace) UnmarshalText(data []byte) error {
+	switch Marketplace(data) {
+	case MarketplaceWildberries:
+		*s = MarketplaceWildberries
+		return nil
+	case MarketplaceOzon:
+		*s = MarketplaceOzon
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type MetricsGetOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s MetricsGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache returns new OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache with value set to v.
+func NewOptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache(v APIV1MarketplaceParserServiceProductsSearchGetOKXCache) OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache {
+	return OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache is optional APIV1MarketplaceParserServiceProductsSearchGetOKXCache.
+type OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache struct {
+	Value APIV1MarketplaceParserServiceProductsSearchGetOKXCache
+	Set   bool
+}
+
+// IsSet returns true if OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache was set.
+func (o OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) Reset() {
+	var v APIV1MarketplaceParserServiceProductsSearchGetOKXCache
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) SetTo(v APIV1MarketplaceParserServiceProductsSearchGetOKXCache) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) Get() (v APIV1MarketplaceParserServiceProductsSearchGetOKXCache, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) Or(d APIV1MarketplaceParserServiceProductsSearchGetOKXCache) APIV1MarketplaceParserServiceProductsSearchGetOKXCache {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDuration returns new OptDuration with value set to v.
+func NewOptDuration(v time.Duration) OptDuration {
+	return OptDuration{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDuration is optional time.Duration.
+type OptDuration struct {
+	Value time.Duration
+	Set   bool
+}
+
+// IsSet returns true if OptDuration was set.
+func (o OptDuration) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDuration) Reset() {
+	var v time.Duration
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDuration) SetTo(v time.Duration) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDuration) Get() (v time.Duration, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDuration) Or(d time.Duration) time.Duration {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptErrorResponse returns new OptErrorResponse with value set to v.
+func NewOptErrorResponse(v ErrorResponse) OptErrorResponse {
+	return OptErrorResponse{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptErrorResponse is optional ErrorResponse.
+type OptErrorResponse struct {
+	Value ErrorResponse
+	Set   bool
+}
+
+// IsSet returns true if OptErrorResponse was set.
+func (o OptErrorResponse) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptErrorResponse) Reset() {
+	var v ErrorResponse
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptErrorResponse) SetTo(v ErrorResponse) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorResponse) Get() (v ErrorResponse, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorResponse) Or(d ErrorResponse) ErrorResponse {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMarketplace returns new OptMarketplace with value set to v.
+func NewOptMarketplace(v Marketplace) OptMarketplace {
+	return OptMarketplace{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMarketplace is optional Marketplace.
+type OptMarketplace struct {
+	Value Marketplace
+	Set   bool
+}
+
+// IsSet returns true if OptMarketplace was set.
+func (o OptMarketplace) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMarketplace) Reset() {
+	var v Marketplace
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMarketplace) SetTo(v Marketplace) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMarketplace) Get() (v Marketplace, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMarketplace) Or(d Marketplace) Marketplace {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProduct returns new OptProduct with value set to v.
+func NewOptProduct(v Product) OptProduct {
+	return OptProduct{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProduct is optional Product.
+type OptProduct struct {
+	Value Product
+	Set   bool
+}
+
+// IsSet returns true if OptProduct was set.
+func (o OptProduct) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProduct) Reset() {
+	var v Product
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProduct) SetTo(v Product) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProduct) Get() (v Product, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProduct) Or(d Product) Product {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScheduleRun returns new OptScheduleRun with value set to v.
+func NewOptScheduleRun(v ScheduleRun) OptScheduleRun {
+	return OptScheduleRun{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScheduleRun is optional ScheduleRun.
+type OptScheduleRun struct {
+	Value ScheduleRun
+	Set   bool
+}
+
+// IsSet returns true if OptScheduleRun was set.
+func (o OptScheduleRun) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScheduleRun) Reset() {
+	var v ScheduleRun
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScheduleRun) SetTo(v ScheduleRun) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScheduleRun) Get() (v ScheduleRun, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScheduleRun) Or(d ScheduleRun) ScheduleRun {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWatchProduct returns new OptWatchProduct with value set to v.
+func NewOptWatchProduct(v WatchProduct) OptWatchProduct {
+	return OptWatchProduct{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWatchProduct is optional WatchProduct.
+type OptWatchProduct struct {
+	Value WatchProduct
+	Set   bool
+}
+
+// IsSet returns true if OptWatchProduct was set.
+func (o OptWatchProduct) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWatchProduct) Reset() {
+	var v WatchProduct
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWatchProduct) SetTo(v WatchProduct) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWatchProduct) Get() (v WatchProduct, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWatchProduct) Or(d WatchProduct) WatchProduct {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWatchQuery returns new OptWatchQuery with value set to v.
+func NewOptWatchQuery(v WatchQuery) OptWatchQuery {
+	return OptWatchQuery{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWatchQuery is optional WatchQuery.
+type OptWatchQuery struct {
+	Value WatchQuery
+	Set   bool
+}
+
+// IsSet returns true if OptWatchQuery was set.
+func (o OptWatchQuery) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWatchQuery) Reset() {
+	var v WatchQuery
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWatchQuery) SetTo(v WatchQuery) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWatchQuery) Get() (v WatchQuery, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWatchQuery) Or(d WatchQuery) WatchQuery {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWatchState returns new OptWatchState with value set to v.
+func NewOptWatchState(v WatchState) OptWatchState {
+	return OptWatchState{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWatchState is optional WatchState.
+type OptWatchState struct {
+	Value WatchState
+	Set   bool
+}
+
+// IsSet returns true if OptWatchState was set.
+func (o OptWatchState) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWatchState) Reset() {
+	var v WatchState
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWatchState) SetTo(v WatchState) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWatchState) Get() (v WatchState, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWatchState) Or(d WatchState) WatchState {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/PriceHistoryResponse
+type PriceHistoryResponse struct {
+	Marketplace Marketplace  `json:"marketplace"`
+	ID          string       `json:"id"`
+	Points      []PricePoint `json:"points"`
+}
+
+// GetMarketplace returns the value of Marketplace.
+func (s *PriceHistoryResponse) GetMarketplace() Marketplace {
+	return s.Marketplace
+}
+
+// GetID returns the value of ID.
+func (s *PriceHistoryResponse) GetID() string {
+	return s.ID
+}
+
+// GetPoints returns the value of Points.
+func (s *PriceHistoryResponse) GetPoints() []PricePoint {
+	return s.Points
+}
+
+// SetMarketplace sets the value of Marketplace.
+func (s *PriceHistoryResponse) SetMarketplace(val Marketplace) {
+	s.Marketplace = val
+}
+
+// SetID sets the value of ID.
+func (s *PriceHistoryResponse) SetID(val string) {
+	s.ID = val
+}
+
+// SetPoints sets the value of Points.
+func (s *PriceHistoryResponse) SetPoints(val []PricePoint) {
+	s.Points = val
+}
+
+func (*PriceHistoryResponse) aPIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes() {}
+
+// Ref: #/components/schemas/PricePoint
+type PricePoint struct {
+	// Start of the bucket, the time of the observation without downsampling.
+	Time time.Time `json:"time"`
+	// Average price within the bucket.
+	Price    float64 `json:"price"`
+	MinPrice float64 `json:"minPrice"`
+	MaxPrice float64 `json:"maxPrice"`
+	// Rating of the last observation within the bucket.
+	Rating float64 `json:"rating"`
+	// Number of reviews of the last observation within the bucket.
+	ReviewsCount int `json:"reviewsCount"`
+	// Availability of the last observation within the bucket.
+	InStock bool `json:"inStock"`
+	// Number of observations within the bucket.
+	Samples int `json:"samples"`
+}
+
+// GetTime returns the value of Time.
+func (s *PricePoint) GetTime() time.Time {
+	return s.Time
+}
+
+// GetPrice returns the value of Price.
+func (s *PricePoint) GetPrice() float64 {
+	return s.Price
+}
+
+// GetMinPrice returns the value of MinPrice.
+func (s *PricePoint) GetMinPrice() float64 {
+	return s.MinPrice
+}
+
+// GetMaxPrice returns the value of MaxPrice.
+func (s *PricePoint) GetMaxPrice() float64 {
+	return s.MaxPrice
+}
+
+// GetRating returns the value of Rating.
+func (s *PricePoint) GetRating() float64 {
+	return s.Rating
+}
+
+// GetReviewsCount returns the value of ReviewsCount.
+func (s *PricePoint) GetReviewsCount() int {
+	return s.ReviewsCount
+}
+
+// GetInStock returns the value of InStock.
+func (s *PricePoint) GetInStock() bool {
+	return s.InStock
+}
+
+// GetSamples returns the value of Samples.
+func (s *PricePoint) GetSamples() int {
+	return s.Samples
+}
+
+// SetTime sets the value of Time.
+func (s *PricePoint) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetPrice sets the value of Price.
+func (s *PricePoint) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetMinPrice sets the value of MinPrice.
+func (s *PricePoint) SetMinPrice(val float64) {
+	s.MinPrice = val
+}
+
+// SetMaxPrice sets the value of MaxPrice.
+func (s *PricePoint) SetMaxPrice(val float64) {
+	s.MaxPrice = val
+}
+
+// SetRating sets the value of Rating.
+func (s *PricePoint) SetRating(val float64) {
+	s.Rating = val
+}
+
+// SetReviewsCount sets the value of ReviewsCount.
+func (s *PricePoint) SetReviewsCount(val int) {
+	s.ReviewsCount = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *PricePoint) SetInStock(val bool) {
+	s.InStock = val
+}
+
+// SetSamples sets the value of Samples.
+func (s *PricePoint) SetSamples(val int) {
+	s.Samples = val
+}
+
+// Ref: #/components/schemas/Product
+type Product struct {
+	// Product id in the marketplace, present if it's parsed from the link.
+	ID           OptString `json:"id"`
+	Name         string    `json:"name"`
+	Link         string    `json:"link"`
+	Price        float64   `json:"price"`
+	Rating       float64   `json:"rating"`
+	ReviewsCount int       `json:"reviewsCount"`
+	InStock      bool      `json:"inStock"`
+	// Number of items left, present only if the marketplace shows it.
+	StockQuantity OptInt `json:"stockQuantity"`
+	// Delivery information as shown on the product card.
+	DeliveryText OptString `json:"deliveryText"`
+	// Nearest delivery date parsed from deliveryText.
+	DeliveryDate OptDate `json:"deliveryDate"`
+}
+
+// GetID returns the value of ID.
+func (s *Product) GetID() OptString {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Product) GetName() string {
+	return s.Name
+}
+
+// GetLink returns the value of Link.
+func (s *Product) GetLink() string {
+	return s.Link
+}
+
+// GetPrice returns the value of Price.
+func (s *Product) GetPrice() float64 {
+	return s.Price
+}
+
+// GetRating returns the value of Rating.
+func (s *Product) GetRating() float64 {
+	return s.Rating
+}
+
+// GetReviewsCount returns the value of ReviewsCount.
+func (s *Product) GetReviewsCount() int {
+	return s.ReviewsCount
+}
+
+// GetInStock returns the value of InStock.
+func (s *Product) GetInStock() bool {
+	return s.InStock
+}
+
+// GetStockQuantity returns the value of StockQuantity.
+func (s *Product) GetStockQuantity() OptInt {
+	return s.StockQuantity
+}
+
+// GetDeliveryText returns the value of DeliveryText.
+func (s *Product) GetDeliveryText() OptString {
+	return s.DeliveryText
+}
+
+// GetDeliveryDate returns the value of DeliveryDate.
+func (s *Product) GetDeliveryDate() OptDate {
+	return s.DeliveryDate
+}
+
+// SetID sets the value of ID.
+func (s *Product) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Product) SetName(val string) {
+	s.Name = val
+}
+
+// SetLink sets the value of Link.
+func (s *Product) SetLink(val string) {
+	s.Link = val
+}
+
+// SetPrice sets the value of Price.
+func (s *Product) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetRating sets the value of Rating.
+func (s *Product) SetRating(val float64) {
+	s.Rating = val
+}
+
+// SetReviewsCount sets the value of ReviewsCount.
+func (s *Product) SetReviewsCount(val int) {
+	s.ReviewsCount = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *Product) SetInStock(val bool) {
+	s.InStock = val
+}
+
+// SetStockQuantity sets the value of StockQuantity.
+func (s *Product) SetStockQuantity(val OptInt) {
+	s.StockQuantity = val
+}
+
+// SetDeliveryText sets the value of DeliveryText.
+func (s *Product) SetDeliveryText(val OptString) {
+	s.DeliveryText = val
+}
+
+// SetDeliveryDate sets the value of DeliveryDate.
+func (s *Product) SetDeliveryDate(val OptDate) {
+	s.DeliveryDate = val
+}
+
+// Ref: #/components/schemas/Schedule
+type Schedule struct {
+	ID           string        `json:"id"`
+	Cron         string        `json:"cron"`
+	Name         string        `json:"name"`
+	PriceFrom    OptFloat64    `json:"priceFrom"`
+	PriceTo      OptFloat64    `json:"priceTo"`
+	InStockOnly  bool          `json:"inStockOnly"`
+	Region       OptString     `json:"region"`
+	Marketplaces []Marketplace `json:"marketplaces"`
+	// The schedule is defined in the config, it can't be removed via the API.
+	FromConfig bool           `json:"fromConfig"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	LastRun    OptScheduleRun `json:"lastRun"`
+	// Planned time of the next run including the jitter.
+	NextRunAt OptDateTime `json:"nextRunAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Schedule) GetID() string {
+	return s.ID
+}
+
+// GetCron returns the value of Cron.
+func (s *Schedule) GetCron() string {
+	return s.Cron
+}
+
+// GetName returns the value of Name.
+func (s *Schedule) GetName() string {
+	return s.Name
+}
+
+// GetPriceFrom returns the value of PriceFrom.
+func (s *Schedule) GetPriceFrom() OptFloat64 {
+	return s.PriceFrom
+}
+
+// GetPriceTo returns the value of PriceTo.
+func (s *Schedule) GetPriceTo() OptFloat64 {
+	return s.PriceTo
+}
+
+// GetInStockOnly returns the value of InStockOnly.
+func (s *Schedule) GetInStockOnly() bool {
+	return s.InStockOnly
+}
+
+// GetRegion returns the value of Region.
+func (s *Schedule) GetRegion() OptString {
+	return s.Region
+}
+
+// GetMarketplaces returns the value of Marketplaces.
+func (s *Schedule) GetMarketplaces() []Marketplace {
+	return s.Marketplaces
+}
+
+// GetFromConfig returns the value of FromConfig.
+func (s *Schedule) GetFromConfig() bool {
+	return s.FromConfig
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Schedule) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastRun returns the value of LastRun.
+func (s *Schedule) GetLastRun() OptScheduleRun {
+	return s.LastRun
+}
+
+// GetNextRunAt returns the value of NextRunAt.
+func (s *Schedule) GetNextRunAt() OptDateTime {
+	return s.NextRunAt
+}
+
+// SetID sets the value of ID.
+func (s *Schedule) SetID(val string) {
+	s.ID = val
+}
+
+// SetCron sets the value of Cron.
+func (s *Schedule) SetCron(val string) {
+	s.Cron = val
+}
+
+// SetName sets the value of Name.
+func (s *Schedule) SetName(val string) {
+	s.Name = val
+}
+
+// SetPriceFrom sets the value of PriceFrom.
+func (s *Schedule) SetPriceFrom(val OptFloat64) {
+	s.PriceFrom = val
+}
+
+// SetPriceTo sets the value of PriceTo.
+func (s *Schedule) SetPriceTo(val OptFloat64) {
+	s.PriceTo = val
+}
+
+// SetInStockOnly sets the value of InStockOnly.
+func (s *Schedule) SetInStockOnly(val bool) {
+	s.InStockOnly = val
+}
+
+// SetRegion sets the value of Region.
+func (s *Schedule) SetRegion(val OptString) {
+	s.Region = val
+}
+
+// SetMarketplaces sets the value of Marketplaces.
+func (s *Schedule) SetMarketplaces(val []Marketplace) {
+	s.Marketplaces = val
+}
+
+// SetFromConfig sets the value of FromConfig.
+func (s *Schedule) SetFromConfig(val bool) {
+	s.FromConfig = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Schedule) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastRun sets the value of LastRun.
+func (s *Schedule) SetLastRun(val OptScheduleRun) {
+	s.LastRun = val
+}
+
+// SetNextRunAt sets the value of NextRunAt.
+func (s *Schedule) SetNextRunAt(val OptDateTime) {
+	s.NextRunAt = val
+}
+
+func (*Schedule) aPIV1MarketplaceParserServiceAdminSchedulesPostRes() {}
+
+// Ref: #/components/schemas/ScheduleRequest
+type ScheduleRequest struct {
+	// Standard 5-field cron expression or a descriptor like "@hourly", in the local time of the service
+	// unless it's prefixed with "CRON_TZ=<zone> ". Its runs must be at least the configured minimum
+	// interval apart.
+	Cron string `json:"cron"`
+	// Full or partial name of the product being searched for.
+	Name        string     `json:"name"`
+	PriceFrom   OptFloat64 `json:"priceFrom"`
+	PriceTo     OptFloat64 `json:"priceTo"`
+	InStockOnly OptBool    `json:"inStockOnly"`
+	Region      OptString  `json:"region"`
+	// Marketplaces to search in, all of them if it's not set.
+	Marketplaces []Marketplace `json:"marketplaces"`
+}
+
+// GetCron returns the value of Cron.
+func (s *ScheduleRequest) GetCron() string {
+	return s.Cron
+}
+
+// GetName returns the value of Name.
+func (s *ScheduleRequest) GetName() string {
+	return s.Name
+}
+
+// GetPriceFrom returns the value of PriceFrom.
+func (s *ScheduleRequest) GetPriceFrom() OptFloat64 {
+	return s.PriceFrom
+}
+
+// GetPriceTo returns the value of PriceTo.
+func (s *ScheduleRequest) GetPriceTo() OptFloat64 {
+	return s.PriceTo
+}
+
+// GetInStockOnly returns the value of InStockOnly.
+func (s *ScheduleRequest) GetInStockOnly() OptBool {
+	return s.InStockOnly
+}
+
+// GetRegion returns the value of Region.
+func (s *ScheduleRequest) GetRegion() OptString {
+	return s.Region
+}
+
+// GetMarketplaces returns the value of Marketplaces.
+func (s *ScheduleRequest) GetMarketplaces() []Marketplace {
+	return s.Marketplaces
+}
+
+// SetCron sets the value of Cron.
+func (s *ScheduleRequest) SetCron(val string) {
+	s.Cron = val
+}
+
+// SetName sets the value of Name.
+func (s *ScheduleRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetPriceFrom sets the value of PriceFrom.
+func (s *ScheduleRequest) SetPriceFrom(val OptFloat64) {
+	s.PriceFrom = val
+}
+
+// SetPriceTo sets the value of PriceTo.
+func (s *ScheduleRequest) SetPriceTo(val OptFloat64) {
+	s.PriceTo = val
+}
+
+// SetInStockOnly sets the value of InStockOnly.
+func (s *ScheduleRequest) SetInStockOnly(val OptBool) {
+	s.InStockOnly = val
+}
+
+// SetRegion sets the value of Region.
+func (s *ScheduleRequest) SetRegion(val OptString) {
+	s.Region = val
+}
+
+// SetMarketplaces sets the value of Marketplaces.
+func (s *ScheduleRequest) SetMarketplaces(val []Marketplace) {
+	s.Marketplaces = val
+}
+
+// Ref: #/components/schemas/ScheduleRun
+type ScheduleRun struct {
+	Status     ScheduleRunStatus `json:"status"`
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt OptDateTime       `json:"finishedAt"`
+	// Duration of the finished run.
+	Duration OptDuration `json:"duration"`
+	// Number of the found products.
+	Products        int           `json:"products"`
+	TimedOutSources []Marketplace `json:"timedOutSources"`
+	// Error the failed run failed with.
+	Error OptString `json:"error"`
+}
+
+// GetStatus returns the value of Status.
+func (s *ScheduleRun) GetStatus() ScheduleRunStatus {
+	return s.Status
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *ScheduleRun) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ScheduleRun) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// GetDuration returns the value of Duration.
+func (s *ScheduleRun) GetDuration() OptDuration {
+	return s.Duration
+}
+
+// GetProducts returns the value of Products.
+func (s *ScheduleRun) GetProducts() int {
+	return s.Products
+}
+
+// GetTimedOutSources returns the value of TimedOutSources.
+func (s *ScheduleRun) GetTimedOutSources() []Marketplace {
+	return s.TimedOutSources
+}
+
+// GetError returns the value of Error.
+func (s *ScheduleRun) GetError() OptString {
+	return s.Error
+}
+
+// SetStatus sets the value of Status.
+func (s *ScheduleRun) SetStatus(val ScheduleRunStatus) {
+	s.Status = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *ScheduleRun) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ScheduleRun) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *ScheduleRun) SetDuration(val OptDuration) {
+	s.Duration = val
+}
+
+// SetProducts sets the value of Products.
+func (s *ScheduleRun) SetProducts(val int) {
+	s.Products = val
+}
+
+// SetTimedOutSources sets the value of TimedOutSources.
+func (s *ScheduleRun) SetTimedOutSources(val []Marketplace) {
+	s.TimedOutSources = val
+}
+
+// SetError sets the value of Error.
+func (s *ScheduleRun) SetError(val OptString) {
+	s.Error = val
+}
+
+type ScheduleRunStatus string
+
+const (
+	ScheduleRunStatusRunning   ScheduleRunStatus = "running"
+	ScheduleRunStatusSucceeded ScheduleRunStatus = "succeeded"
+	ScheduleRunStatusFailed    ScheduleRunStatus = "failed"
+	ScheduleRunStatusCanceled  ScheduleRunStatus = "canceled"
+)
+
+// AllValues returns all ScheduleRunStatus values.
+func (ScheduleRunStatus) AllValues() []ScheduleRunStatus {
+	return []ScheduleRunStatus{
+		ScheduleRunStatusRunning,
+		ScheduleRunStatusSucceeded,
+		ScheduleRunStatusFailed,
+		ScheduleRunStatusCanceled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ScheduleRunStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ScheduleRunStatusRunning:
+		return []byte(s), nil
+	case ScheduleRunStatusSucceeded:
+		return []byte(s), nil
+	case ScheduleRunStatusFailed:
+		return []byte(s), nil
+	case ScheduleRunStatusCanceled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ScheduleRunStatus) UnmarshalText(data []byte) error {
+	switch ScheduleRunStatus(data) {
+	case ScheduleRunStatusRunning:
+		*s = ScheduleRunStatusRunning
+		return nil
+	case ScheduleRunStatusSucceeded:
+		*s = ScheduleRunStatusSucceeded
+		return nil
+	case ScheduleRunStatusFailed:
+		*s = ScheduleRunStatusFailed
+		return nil
+	case ScheduleRunStatusCanceled:
+		*s = ScheduleRunStatusCanceled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SchedulesResponse []Schedule
+
+func (*SchedulesResponse) aPIV1MarketplaceParserServiceAdminSchedulesGetRes() {}
+
+// Ref: #/components/schemas/SearchBatchRequest
+type SearchBatchRequest struct {
+	Queries []BatchQuery `json:"queries"`
+}
+
+// GetQueries returns the value of Queries.
+func (s *SearchBatchRequest) GetQueries() []BatchQuery {
+	return s.Queries
+}
+
+// SetQueries sets the value of Queries.
+func (s *SearchBatchRequest) SetQueries(val []BatchQuery) {
+	s.Queries = val
+}
+
+// Ref: #/components/schemas/SearchBatchResponse
+type SearchBatchResponse struct {
+	// Results of the queries by their keys.
+	Results SearchBatchResponseResults `json:"results"`
+}
+
+// GetResults returns the value of Results.
+func (s *SearchBatchResponse) GetResults() SearchBatchResponseResults {
+	return s.Results
+}
+
+// SetResults sets the value of Results.
+func (s *SearchBatchResponse) SetResults(val SearchBatchResponseResults) {
+	s.Results = val
+}
+
+func (*SearchBatchResponse) aPIV1MarketplaceParserServiceProductsSearchBatchPostRes() {}
+
+// Results of the queries by their keys.
+type SearchBatchResponseResults map[string]BatchQueryResult
+
+func (s *SearchBatchResponseResults) init() SearchBatchResponseResults {
+	m := *s
+	if m == nil {
+		m = map[string]BatchQueryResult{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/SearchJob
+type SearchJob struct {
+	ID      string           `json:"id"`
+	Status  SearchJobStatus  `json:"status"`
+	Query   SearchJobRequest `json:"query"`
+	Sources []SourceProgress `json:"sources"`
+	// Products of the succeeded job, the products found so far by the running job.
+	Products []Product `json:"products"`
+	// Marketplaces that exceeded their time budget in the succeeded or the running job.
+	TimedOutSources []Marketplace `json:"timedOutSources"`
+	// Error the job failed with.
+	Error      OptString   `json:"error"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	StartedAt  OptDateTime `json:"startedAt"`
+	FinishedAt OptDateTime `json:"finishedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *SearchJob) GetID() string {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *SearchJob) GetStatus() SearchJobStatus {
+	return s.Status
+}
+
+// GetQuery returns the value of Query.
+func (s *SearchJob) GetQuery() SearchJobRequest {
+	return s.Query
+}
+
+// GetSources returns the value of Sources.
+func (s *SearchJob) GetSources() []SourceProgress {
+	return s.Sources
+}
+
+// GetProducts returns the value of Products.
+func (s *SearchJob) GetProducts() []Product {
+	return s.Products
+}
+
+// GetTimedOutSources returns the value of TimedOutSources.
+func (s *SearchJob) GetTimedOutSources() []Marketplace {
+	return s.TimedOutSources
+}
+
+// GetError returns the value of Error.
+func (s *SearchJob) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SearchJob) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *SearchJob) GetStartedAt() OptDateTime {
+	return s.StartedAt
+}
+
+// GetFinishedAt returns the value of FinishedAt.
+func (s *SearchJob) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
+
+// SetID sets the value of ID.
+func (s *SearchJob) SetID(val string) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *SearchJob) SetStatus(val SearchJobStatus) {
+	s.Status = val
+}
+
+// SetQuery sets the value of Query.
+func (s *SearchJob) SetQuery(val SearchJobRequest) {
+	s.Query = val
+}
+
+// SetSources sets the value of Sources.
+func (s *SearchJob) SetSources(val []SourceProgress) {
+	s.Sources = val
+}
+
+// SetProducts sets the value of Products.
+func (s *SearchJob) SetProducts(val []Product) {
+	s.Products = val
+}
+
+// SetTimedOutSources sets the value of TimedOutSources.
+func (s *SearchJob) SetTimedOutSources(val []Marketplace) {
+	s.TimedOutSources = val
+}
+
+// SetError sets the value of Error.
+func (s *SearchJob) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SearchJob) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *SearchJob) SetStartedAt(val OptDateTime) {
+	s.StartedAt = val
+}
+
+// SetFinishedAt sets the value of FinishedAt.
+func (s *SearchJob) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
+}
+
+func (*SearchJob) aPIV1MarketplaceParserServiceSearchJobsIDDeleteRes() {}
+func (*SearchJob) aPIV1MarketplaceParserServiceSearchJobsIDGetRes()    {}
+func (*SearchJob) aPIV1MarketplaceParserServiceSearchJobsPostRes()     {}
+
+// Ref: #/components/schemas/SearchJobRequest
+type SearchJobRequest struct {
+	// Full or partial name of the product being searched for.
+	Name string `json:"name"`
+	// Lower price limit in rubles.
+	PriceFrom OptFloat64 `json:"priceFrom"`
+	// Upper price limit in rubles.
+	PriceTo     OptFloat64 `json:"priceTo"`
+	InStockOnly OptBool    `json:"inStockOnly"`
+	// Delivery region: a city name or a region preset name from the service config.
+	Region OptString `json:"region"`
+}
+
+// GetName returns the value of Name.
+func (s *SearchJobRequest) GetName() string {
+	return s.Name
+}
+
+// GetPriceFrom returns the value of PriceFrom.
+func (s *SearchJobRequest) GetPriceFrom() OptFloat64 {
+	return s.PriceFrom
+}
+
+// GetPriceTo returns the value of PriceTo.
+func (s *SearchJobRequest) GetPriceTo() OptFloat64 {
+	return s.PriceTo
+}
+
+// GetInStockOnly returns the value of InStockOnly.
+func (s *SearchJobRequest) GetInStockOnly() OptBool {
+	return s.InStockOnly
+}
+
+// GetRegion returns the value of Region.
+func (s *SearchJobRequest) GetRegion() OptString {
+	return s.Region
+}
+
+// SetName sets the value of Name.
+func (s *SearchJobRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetPriceFrom sets the value of PriceFrom.
+func (s *SearchJobRequest) SetPriceFrom(val OptFloat64) {
+	s.PriceFrom = val
+}
+
+// SetPriceTo sets the value of PriceTo.
+func (s *SearchJobRequest) SetPriceTo(val OptFloat64) {
+	s.PriceTo = val
+}
+
+// SetInStockOnly sets the value of InStockOnly.
+func (s *SearchJobRequest) SetInStockOnly(val OptBool) {
+	s.InStockOnly = val
+}
+
+// SetRegion sets the value of Region.
+func (s *SearchJobRequest) SetRegion(val OptString) {
+	s.Region = val
+}
+
+type SearchJobStatus string
+
+const (
+	SearchJobStatusQueued    SearchJobStatus = "queued"
+	SearchJobStatusRunning   SearchJobStatus = "running"
+	SearchJobStatusSucceeded SearchJobStatus = "succeeded"
+	SearchJobStatusFailed    SearchJobStatus = "failed"
+	SearchJobStatusCanceled  SearchJobStatus = "canceled"
+)
+
+// AllValues returns all SearchJobStatus values.
+func (SearchJobStatus) AllValues() []SearchJobStatus {
+	return []SearchJobStatus{
+		SearchJobStatusQueued,
+		SearchJobStatusRunning,
+		SearchJobStatusSucceeded,
+		SearchJobStatusFailed,
+		SearchJobStatusCanceled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchJobStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchJobStatusQueued:
+		return []byte(s), nil
+	case SearchJobStatusRunning:
+		return []byte(s), nil
+	case SearchJobStatusSucceeded:
+		return []byte(s), nil
+	case SearchJobStatusFailed:
+		return []byte(s), nil
+	case SearchJobStatusCanceled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchJobStatus) UnmarshalText(data []byte) error {
+	switch SearchJobStatus(data) {
+	case SearchJobStatusQueued:
+		*s = SearchJobStatusQueued
+		return nil
+	case SearchJobStatusRunning:
+		*s = SearchJobStatusRunning
+		return nil
+	case SearchJobStatusSucceeded:
+		*s = SearchJobStatusSucceeded
+		return nil
+	case SearchJobStatusFailed:
+		*s = SearchJobStatusFailed
+		return nil
+	case SearchJobStatusCanceled:
+		*s = SearchJobStatusCanceled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SearchProductsResponse []Product
+
+func (*SearchProductsResponse) aPIV1MarketplaceParserServiceProductsCategoryGetRes() {}
+
+// SearchProductsResponseHeaders wraps SearchProductsResponse with response headers.
+type SearchProductsResponseHeaders struct {
+	Age              OptInt
+	XCache           OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache
+	XTimedOutSources []Marketplace
+	Response         SearchProductsResponse
+}
+
+// GetAge returns the value of Age.
+func (s *SearchProductsResponseHeaders) GetAge() OptInt {
+	return s.Age
+}
+
+// GetXCache returns the value of XCache.
+func (s *SearchProductsResponseHeaders) GetXCache() OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache {
+	return s.XCache
+}
+
+// GetXTimedOutSources returns the value of XTimedOutSources.
+func (s *SearchProductsResponseHeaders) GetXTimedOutSources() []Marketplace {
+	return s.XTimedOutSources
+}
+
+// GetResponse returns the value of Response.
+func (s *SearchProductsResponseHeaders) GetResponse() SearchProductsResponse {
+	return s.Response
+}
+
+// SetAge sets the value of Age.
+func (s *SearchProductsResponseHeaders) SetAge(val OptInt) {
+	s.Age = val
+}
+
+// SetXCache sets the value of XCache.
+func (s *SearchProductsResponseHeaders) SetXCache(val OptAPIV1MarketplaceParserServiceProductsSearchGetOKXCache) {
+	s.XCache = val
+}
+
+// SetXTimedOutSources sets the value of XTimedOutSources.
+func (s *SearchProductsResponseHeaders) SetXTimedOutSources(val []Marketplace) {
+	s.XTimedOutSources = val
+}
+
+// SetResponse sets the value of Response.
+func (s *SearchProductsResponseHeaders) SetResponse(val SearchProductsResponse) {
+	s.Response = val
+}
+
+func (*SearchProductsResponseHeaders) aPIV1MarketplaceParserServiceProductsSearchGetRes() {}
+
+// Ref: #/components/schemas/SourceProgress
+type SourceProgress struct {
+	Marketplace Marketplace         `json:"marketplace"`
+	State       SourceProgressState `json:"state"`
+}
+
+// GetMarketplace returns the value of Marketplace.
+func (s *SourceProgress) GetMarketplace() Marketplace {
+	return s.Marketplace
+}
+
+// GetState returns the value of State.
+func (s *SourceProgress) GetState() SourceProgressState {
+	return s.State
+}
+
+// SetMarketplace sets the value of Marketplace.
+func (s *SourceProgress) SetMarketplace(val Marketplace) {
+	s.Marketplace = val
+}
+
+// SetState sets the value of State.
+func (s *SourceProgress) SetState(val SourceProgressState) {
+	s.State = val
+}
+
+type SourceProgressState string
+
+const (
+	SourceProgressStatePending  SourceProgressState = "pending"
+	SourceProgressStateRunning  SourceProgressState = "running"
+	SourceProgressStateDone     SourceProgressState = "done"
+	SourceProgressStateTimedOut SourceProgressState = "timed_out"
+	SourceProgressStateFailed   SourceProgressState = "failed"
+	SourceProgressStateCanceled SourceProgressState = "canceled"
+)
+
+// AllValues returns all SourceProgressState values.
+func (SourceProgressState) AllValues() []SourceProgressState {
+	return []SourceProgressState{
+		SourceProgressStatePending,
+		SourceProgressStateRunning,
+		SourceProgressStateDone,
+		SourceProgressStateTimedOut,
+		SourceProgressStateFailed,
+		SourceProgressStateCanceled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SourceProgressState) MarshalText() ([]byte, error) {
+	switch s {
+	case SourceProgressStatePending:
+		return []byte(s), nil
+	case SourceProgressStateRunning:
+		return []byte(s), nil
+	case SourceProgressStateDone:
+		return []byte(s), nil
+	case SourceProgressStateTimedOut:
+		return []byte(s), nil
+	case SourceProgressStateFailed:
+		return []byte(s), nil
+	case SourceProgressStateCanceled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SourceProgressState) UnmarshalText(data []byte) error {
+	switch SourceProgressState(data) {
+	case SourceProgressStatePending:
+		*s = SourceProgressStatePending
+		return nil
+	case SourceProgressStateRunning:
+		*s = SourceProgressStateRunning
+		return nil
+	case SourceProgressStateDone:
+		*s = SourceProgressStateDone
+		return nil
+	case SourceProgressStateTimedOut:
+		*s = SourceProgressStateTimedOut
+		return nil
+	case SourceProgressStateFailed:
+		*s = SourceProgressStateFailed
+		return nil
+	case SourceProgressStateCanceled:
+		*s = SourceProgressStateCanceled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SourceStatus
+type SourceStatus struct {
+	Marketplace Marketplace       `json:"marketplace"`
+	State       SourceStatusState `json:"state"`
+	// Number of failures in a row.
+	Failures int `json:"failures"`
+	// Number of times the circuit was opened since the start.
+	Opens     int         `json:"opens"`
+	LastError OptString   `json:"lastError"`
+	OpenedAt  OptDateTime `json:"openedAt"`
+	// End of the cool-down of the open circuit.
+	RetryAt OptDateTime `json:"retryAt"`
+}
+
+// GetMarketplace returns the value of Marketplace.
+func (s *SourceStatus) GetMarketplace() Marketplace {
+	return s.Marketplace
+}
+
+// GetState returns the value of State.
+func (s *SourceStatus) GetState() SourceStatusState {
+	return s.State
+}
+
+// GetFailures returns the value of Failures.
+func (s *SourceStatus) GetFailures() int {
+	return s.Failures
+}
+
+// GetOpens returns the value of Opens.
+func (s *SourceStatus) GetOpens() int {
+	return s.Opens
+}
+
+// GetLastError returns the value of LastError.
+func (s *SourceStatus) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetOpenedAt returns the value of OpenedAt.
+func (s *SourceStatus) GetOpenedAt() OptDateTime {
+	return s.OpenedAt
+}
+
+// GetRetryAt returns the value of RetryAt.
+func (s *SourceStatus) GetRetryAt() OptDateTime {
+	return s.RetryAt
+}
+
+// SetMarketplace sets the value of Marketplace.
+func (s *SourceStatus) SetMarketplace(val Marketplace) {
+	s.Marketplace = val
+}
+
+// SetState sets the value of State.
+func (s *SourceStatus) SetState(val SourceStatusState) {
+	s.State = val
+}
+
+// SetFailures sets the value of Failures.
+func (s *SourceStatus) SetFailures(val int) {
+	s.Failures = val
+}
+
+// SetOpens sets the value of Opens.
+func (s *SourceStatus) SetOpens(val int) {
+	s.Opens = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *SourceStatus) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetOpenedAt sets the value of OpenedAt.
+func (s *SourceStatus) SetOpenedAt(val OptDateTime) {
+	s.OpenedAt = val
+}
+
+// SetRetryAt sets the value of RetryAt.
+func (s *SourceStatus) SetRetryAt(val OptDateTime) {
+	s.RetryAt = val
+}
+
+type SourceStatusState string
+
+const (
+	SourceStatusStateClosed   SourceStatusState = "closed"
+	SourceStatusStateOpen     SourceStatusState = "open"
+	SourceStatusStateHalfOpen SourceStatusState = "half_open"
+)
+
+// AllValues returns all SourceStatusState values.
+func (SourceStatusState) AllValues() []SourceStatusState {
+	return []SourceStatusState{
+		SourceStatusStateClosed,
+		SourceStatusStateOpen,
+		SourceStatusStateHalfOpen,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SourceStatusState) MarshalText() ([]byte, error) {
+	switch s {
+	case SourceStatusStateClosed:
+		return []byte(s), nil
+	case SourceStatusStateOpen:
+		return []byte(s), nil
+	case SourceStatusStateHalfOpen:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SourceStatusState) UnmarshalText(data []byte) error {
+	switch SourceStatusState(data) {
+	case SourceStatusStateClosed:
+		*s = SourceStatusStateClosed
+		return nil
+	case SourceStatusStateOpen:
+		*s = SourceStatusStateOpen
+		return nil
+	case SourceStatusStateHalfOpen:
+		*s = SourceStatusStateHalfOpen
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SourcesStatusResponse []SourceStatus
+
+// Ref: #/components/schemas/Suggestions
+type Suggestions struct {
+	Marketplace Marketplace `json:"marketplace"`
+	Queries     []string    `json:"queries"`
+}
+
+// GetMarketplace returns the value of Marketplace.
+func (s *Suggestions) GetMarketplace() Marketplace {
+	return s.Marketplace
+}
+
+// GetQueries returns the value of Queries.
+func (s *Suggestions) GetQueries() []string {
+	return s.Queries
+}
+
+// SetMarketplace sets the value of Marketplace.
+func (s *Suggestions) SetMarketplace(val Marketplace) {
+	s.Marketplace = val
+}
+
+// SetQueries sets the value of Queries.
+func (s *Suggestions) SetQueries(val []string) {
+	s.Queries = val
+}
+
+type SuggestionsResponse []Suggestions
+
+func (*SuggestionsResponse) aPIV1MarketplaceParserServiceProductsSuggestionsGetRes() {}
+
+// Ref: #/components/schemas/Watch
+type Watch struct {
+	ID          string          `json:"id"`
+	Product     OptWatchProduct `json:"product"`
+	Query       OptWatchQuery   `json:"query"`
+	TargetPrice float64         `json:"targetPrice"`
+	NotifyStock bool            `json:"notifyStock"`
+	WebhookUrl  string          `json:"webhookUrl"`
+	Interval    time.Duration   `json:"interval"`
+	// Key the webhook payloads are signed with, returned on creation only.
+	Secret    OptString     `json:"secret"`
+	CreatedAt time.Time     `json:"createdAt"`
+	LastCheck OptWatchState `json:"lastCheck"`
+}
+
+// GetID returns the value of ID.
+func (s *Watch) GetID() string {
+	return s.ID
+}
+
+// GetProduct returns the value of Product.
+func (s *Watch) GetProduct() OptWatchProduct {
+	return s.Product
+}
+
+// GetQuery returns the value of Query.
+func (s *Watch) GetQuery() OptWatchQuery {
+	return s.Query
+}
+
+// GetTargetPrice returns the value of TargetPrice.
+func (s *Watch) GetTargetPrice() float64 {
+	return s.TargetPrice
+}
+
+// GetNotifyStock returns the value of NotifyStock.
+func (s *Watch) GetNotifyStock() bool {
+	return s.NotifyStock
+}
+
+// GetWebhookUrl returns the value of WebhookUrl.
+func (s *Watch) GetWebhookUrl() string {
+	return s.WebhookUrl
+}
+
+// GetInterval returns the value of Interval.
+func (s *Watch) GetInterval() time.Duration {
+	return s.Interval
+}
+
+// GetSecret returns the value of Secret.
+func (s *Watch) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Watch) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastCheck returns the value of LastCheck.
+func (s *Watch) GetLastCheck() OptWatchState {
+	return s.LastCheck
+}
+
+// SetID sets the value of ID.
+func (s *Watch) SetID(val string) {
+	s.ID = val
+}
+
+// SetProduct sets the value of Product.
+func (s *Watch) SetProduct(val OptWatchProduct) {
+	s.Product = val
+}
+
+// SetQuery sets the value of Query.
+func (s *Watch) SetQuery(val OptWatchQuery) {
+	s.Query = val
+}
+
+// SetTargetPrice sets the value of TargetPrice.
+func (s *Watch) SetTargetPrice(val float64) {
+	s.TargetPrice = val
+}
+
+// SetNotifyStock sets the value of NotifyStock.
+func (s *Watch) SetNotifyStock(val bool) {
+	s.NotifyStock = val
+}
+
+// SetWebhookUrl sets the value of WebhookUrl.
+func (s *Watch) SetWebhookUrl(val string) {
+	s.WebhookUrl = val
+}
+
+// SetInterval sets the value of Interval.
+func (s *Watch) SetInterval(val time.Duration) {
+	s.Interval = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *Watch) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Watch) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastCheck sets the value of LastCheck.
+func (s *Watch) SetLastCheck(val OptWatchState) {
+	s.LastCheck = val
+}
+
+func (*Watch) aPIV1MarketplaceParserServiceWatchesIDGetRes() {}
+func (*Watch) aPIV1MarketplaceParserServiceWatchesIDPutRes() {}
+func (*Watch) aPIV1MarketplaceParserServiceWatchesPostRes()  {}
+
+// Product watched by its id or link. The product is parsed from its product page.
+// Ref: #/components/schemas/WatchProduct
+type WatchProduct struct {
+	Marketplace Marketplace `json:"marketplace"`
+	// Product id in the marketplace, parsed from url if it's not set.
+	ID  OptString `json:"id"`
+	URL OptString `json:"url"`
+	// Name the watch is labeled with.
+	Name OptString `json:"name"`
+	// Delivery region the product page is loaded for.
+	Region OptString `json:"region"`
+}
+
+// GetMarketplace returns the value of Marketplace.
+func (s *WatchProduct) GetMarketplace() Marketplace {
+	return s.Marketplace
+}
+
+// GetID returns the value of ID.
+func (s *WatchProduct) GetID() OptString {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *WatchProduct) GetURL() OptString {
+	return s.URL
+}
+
+// GetName returns the value of Name.
+func (s *WatchProduct) GetName() OptString {
+	return s.Name
+}
+
+// GetRegion returns the value of Region.
+func (s *WatchProduct) GetRegion() OptString {
+	return s.Region
+}
+
+// SetMarketplace sets the value of Marketplace.
+func (s *WatchProduct) SetMarketplace(val Marketplace) {
+	s.Marketplace = val
+}
+
+// SetID sets the value of ID.
+func (s *WatchProduct) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *WatchProduct) SetURL(val OptString) {
+	s.URL = val
+}
+
+// SetName sets the value of Name.
+func (s *WatchProduct) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetRegion sets the value of Region.
+func (s *WatchProduct) SetRegion(val OptString) {
+	s.Region = val
+}
+
+// Search query, the cheapest found product is compared to the target price.
+// Ref: #/components/schemas/WatchQuery
+type WatchQuery struct {
+	Name         string        `json:"name"`
+	PriceFrom    OptFloat64    `json:"priceFrom"`
+	PriceTo      OptFloat64    `json:"priceTo"`
+	InStockOnly  OptBool       `json:"inStockOnly"`
+	Region       OptString     `json:"region"`
+	Marketplaces []Marketplace `json:"marketplaces"`
+}
+
+// GetName returns the value of Name.
+func (s *WatchQuery) GetName() string {
+	return s.Name
+}
+
+// GetPriceFrom returns the value of PriceFrom.
+func (s *WatchQuery) GetPriceFrom() OptFloat64 {
+	return s.PriceFrom
+}
+
+// GetPriceTo returns the value of PriceTo.
+func (s *WatchQuery) GetPriceTo() OptFloat64 {
+	return s.PriceTo
+}
+
+// GetInStockOnly returns the value of InStockOnly.
+func (s *WatchQuery) GetInStockOnly() OptBool {
+	return s.InStockOnly
+}
+
+// GetRegion returns the value of Region.
+func (s *WatchQuery) GetRegion() OptString {
+	return s.Region
+}
+
+// GetMarketplaces returns the value of Marketplaces.
+func (s *WatchQuery) GetMarketplaces() []Marketplace {
+	return s.Marketplaces
+}
+
+// SetName sets the value of Name.
+func (s *WatchQuery) SetName(val string) {
+	s.Name = val
+}
+
+// SetPriceFrom sets the value of PriceFrom.
+func (s *WatchQuery) SetPriceFrom(val OptFloat64) {
+	s.PriceFrom = val
+}
+
+// SetPriceTo sets the value of PriceTo.
+func (s *WatchQuery) SetPriceTo(val OptFloat64) {
+	s.PriceTo = val
+}
+
+// SetInStockOnly sets the value of InStockOnly.
+func (s *WatchQuery) SetInStockOnly(val OptBool) {
+	s.InStockOnly = val
+}
+
+// SetRegion sets the value of Region.
+func (s *WatchQuery) SetRegion(val OptString) {
+	s.Region = val
+}
+
+// SetMarketplaces sets the value of Marketplaces.
+func (s *WatchQuery) SetMarketplaces(val []Marketplace) {
+	s.Marketplaces = val
+}
+
+// Ref: #/components/schemas/WatchRequest
+type WatchRequest struct {
+	Product OptWatchProduct `json:"product"`
+	Query   OptWatchQuery   `json:"query"`
+	// Price the drop below is notified, it may be 0 for a product watch with notifyStock.
+	TargetPrice float64 `json:"targetPrice"`
+	// Notify the changes of the stock of the watched product.
+	NotifyStock OptBool `json:"notifyStock"`
+	WebhookUrl  string  `json:"webhookUrl"`
+	// Time between the checks like "30m", the interval of the service config if it's not set.
+	Interval OptDuration `json:"interval"`
+}
+
+// GetProduct returns the value of Product.
+func (s *WatchRequest) GetProduct() OptWatchProduct {
+	return s.Product
+}
+
+// GetQuery returns the value of Query.
+func (s *WatchRequest) GetQuery() OptWatchQuery {
+	return s.Query
+}
+
+// GetTargetPrice returns the value of TargetPrice.
+func (s *WatchRequest) GetTargetPrice() float64 {
+	return s.TargetPrice
+}
+
+// GetNotifyStock returns the value of NotifyStock.
+func (s *WatchRequest) GetNotifyStock() OptBool {
+	return s.NotifyStock
+}
+
+// GetWebhookUrl returns the value of WebhookUrl.
+func (s *WatchRequest) GetWebhookUrl() string {
+	return s.WebhookUrl
+}
+
+// GetInterval returns the value of Interval.
+func (s *WatchRequest) GetInterval() OptDuration {
+	return s.Interval
+}
+
+// SetProduct sets the value of Product.
+func (s *WatchRequest) SetProduct(val OptWatchProduct) {
+	s.Product = val
+}
+
+// SetQuery sets the value of Query.
+func (s *WatchRequest) SetQuery(val OptWatchQuery) {
+	s.Query = val
+}
+
+// SetTargetPrice sets the value of TargetPrice.
+func (s *WatchRequest) SetTargetPrice(val float64) {
+	s.TargetPrice = val
+}
+
+// SetNotifyStock sets the value of NotifyStock.
+func (s *WatchRequest) SetNotifyStock(val OptBool) {
+	s.NotifyStock = val
+}
+
+// SetWebhookUrl sets the value of WebhookUrl.
+func (s *WatchRequest) SetWebhookUrl(val string) {
+	s.WebhookUrl = val
+}
+
+// SetInterval sets the value of Interval.
+func (s *WatchRequest) SetInterval(val OptDuration) {
+	s.Interval = val
+}
+
+// Ref: #/components/schemas/WatchState
+type WatchState struct {
+	CheckedAt time.Time `json:"checkedAt"`
+	// The watched product or a product of the query was found.
+	Found       bool       `json:"found"`
+	Product     OptProduct `json:"product"`
+	BelowTarget bool       `json:"belowTarget"`
+	// Error the check failed with, the product of the previous check is kept then.
+	Error OptString `json:"error"`
+}
+
+// GetCheckedAt returns the value of CheckedAt.
+func (s *WatchState) GetCheckedAt() time.Time {
+	return s.CheckedAt
+}
+
+// GetFound returns the value of Found.
+func (s *WatchState) GetFound() bool {
+	return s.Found
+}
+
+// GetProduct returns the value of Product.
+func (s *WatchState) GetProduct() OptProduct {
+	return s.Product
+}
+
+// GetBelowTarget returns the value of BelowTarget.
+func (s *WatchState) GetBelowTarget() bool {
+	return s.BelowTarget
+}
+
+// GetError returns the value of Error.
+func (s *WatchState) GetError() OptString {
+	return s.Error
+}
+
+// SetCheckedAt sets the value of CheckedAt.
+func (s *WatchState) SetCheckedAt(val time.Time) {
+	s.CheckedAt = val
+}
+
+// SetFound sets the value of Found.
+func (s *WatchState) SetFound(val bool) {
+	s.Found = val
+}
+
+// SetProduct sets the value of Product.
+func (s *WatchState) SetProduct(val OptProduct) {
+	s.Product = val
+}
+
+// SetBelowTarget sets the value of BelowTarget.
+func (s *WatchState) SetBelowTarget(val bool) {
+	s.BelowTarget = val
+}
+
+// SetError sets the value of Error.
+func (s *WatchState) SetError(val OptString) {
+	s.Error = val
+}
+
+type WatchesResponse []Watch
 
-func (*SearchProductsResponse) aPIV1MarketplaceParserServiceProductsSearchGetRes() {}
+func (*WatchesResponse) aPIV1MarketplaceParserServiceWatchesGetRes() {}
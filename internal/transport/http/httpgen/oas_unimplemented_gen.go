@@ -13,6 +13,95 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// APIV1MarketplaceParserServiceAdminSchedulesGet implements GET /api/v1/marketplace-parser-service/admin/schedules operation.
+//
+// Get the recurring searches with the status and duration of their last runs and the planned next
+// runs.
+//
+// GET /api/v1/marketplace-parser-service/admin/schedules
+func (UnimplementedHandler) APIV1MarketplaceParserServiceAdminSchedulesGet(ctx context.Context) (r APIV1MarketplaceParserServiceAdminSchedulesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceAdminSchedulesIDDelete implements DELETE /api/v1/marketplace-parser-service/admin/schedules/{id} operation.
+//
+// Remove the schedule created via the API, its running search is finished.
+//
+// DELETE /api/v1/marketplace-parser-service/admin/schedules/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) (r APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceAdminSchedulesPost implements POST /api/v1/marketplace-parser-service/admin/schedules operation.
+//
+// Run the search on the cron schedule. A run starts up to the configured jitter after its scheduled
+// time, the results are stored by the configured sink: the log, a JSON Lines file or an SQLite
+// database.
+//
+// POST /api/v1/marketplace-parser-service/admin/schedules
+func (UnimplementedHandler) APIV1MarketplaceParserServiceAdminSchedulesPost(ctx context.Context, req *ScheduleRequest) (r APIV1MarketplaceParserServiceAdminSchedulesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceAdminSessionsDelete implements DELETE /api/v1/marketplace-parser-service/admin/sessions operation.
+//
+// Remove the stored cookies and localStorage of the marketplace, of all marketplaces if it's not set.
+//
+// DELETE /api/v1/marketplace-parser-service/admin/sessions
+func (UnimplementedHandler) APIV1MarketplaceParserServiceAdminSessionsDelete(ctx context.Context, params APIV1MarketplaceParserServiceAdminSessionsDeleteParams) (r APIV1MarketplaceParserServiceAdminSessionsDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceAdminSourcesGet implements GET /api/v1/marketplace-parser-service/admin/sources operation.
+//
+// Get the state of the circuit breakers of the marketplaces. The calls of a marketplace with an open
+// circuit fail fast with 503 until the cool-down ends.
+//
+// GET /api/v1/marketplace-parser-service/admin/sources
+func (UnimplementedHandler) APIV1MarketplaceParserServiceAdminSourcesGet(ctx context.Context) (r SourcesStatusResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceBrowserNodesGet implements GET /api/v1/marketplace-parser-service/browser/nodes operation.
+//
+// Get the state of the chromium nodes the parser pages are distributed over.
+//
+// GET /api/v1/marketplace-parser-service/browser/nodes
+func (UnimplementedHandler) APIV1MarketplaceParserServiceBrowserNodesGet(ctx context.Context) (r BrowserNodesResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceProductsCategoryGet implements GET /api/v1/marketplace-parser-service/products/category operation.
+//
+// List products of a marketplace catalog category by its path or URL.
+//
+// GET /api/v1/marketplace-parser-service/products/category
+func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsCategoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsCategoryGetParams) (r APIV1MarketplaceParserServiceProductsCategoryGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet implements GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history operation.
+//
+// Get the prices, ratings and reviews of the product recorded every time it was found by a search, a
+// category listing, a job or a batch. The points are ordered by time.
+//
+// GET /api/v1/marketplace-parser-service/products/{marketplace}/{id}/history
+func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetParams) (r APIV1MarketplaceParserServiceProductsMarketplaceIDHistoryGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceProductsSearchBatchPost implements POST /api/v1/marketplace-parser-service/products/search/batch operation.
+//
+// Search for products by many queries at once, at most batch.parallelism queries run at the same
+// time. A failed query doesn't fail the others, its error is returned in its result. With "Accept:
+// application/x-ndjson" the result of every query is streamed as a JSON line as soon as the query is
+// finished.
+//
+// POST /api/v1/marketplace-parser-service/products/search/batch
+func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsSearchBatchPost(ctx context.Context, req *SearchBatchRequest) (r APIV1MarketplaceParserServiceProductsSearchBatchPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // APIV1MarketplaceParserServiceProductsSearchGet implements GET /api/v1/marketplace-parser-service/products/search operation.
 //
 // Search for products by name and price range.
@@ -21,3 +110,122 @@ var _ Handler = UnimplementedHandler{}
 func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsSearchGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchGetParams) (r APIV1MarketplaceParserServiceProductsSearchGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// APIV1MarketplaceParserServiceProductsSearchStreamGet implements GET /api/v1/marketplace-parser-service/products/search/stream operation.
+//
+// Search for products like the search endpoint, but stream the result of every marketplace as a
+// Server-Sent Event as soon as it's finished: a products event with the found products, a
+// source_error event for a failed or timed out marketplace. The stream always ends with a done event
+// or, if the search fails after the first event, an error event. The errors before the first event
+// are returned as JSON.
+//
+// GET /api/v1/marketplace-parser-service/products/search/stream
+func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsSearchStreamGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSearchStreamGetParams) (r APIV1MarketplaceParserServiceProductsSearchStreamGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceProductsSuggestionsGet implements GET /api/v1/marketplace-parser-service/products/suggestions operation.
+//
+// Get the search queries suggested by every marketplace for the typed prefix.
+//
+// GET /api/v1/marketplace-parser-service/products/suggestions
+func (UnimplementedHandler) APIV1MarketplaceParserServiceProductsSuggestionsGet(ctx context.Context, params APIV1MarketplaceParserServiceProductsSuggestionsGetParams) (r APIV1MarketplaceParserServiceProductsSuggestionsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDDelete implements DELETE /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+//
+// Cancel the queued or running job. A finished job is returned unchanged.
+//
+// DELETE /api/v1/marketplace-parser-service/search-jobs/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceSearchJobsIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) (r APIV1MarketplaceParserServiceSearchJobsIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceSearchJobsIDGet implements GET /api/v1/marketplace-parser-service/search-jobs/{id} operation.
+//
+// Get the status of the job, the progress of every marketplace and the products once the job is
+// succeeded. Finished jobs are kept for a limited time.
+//
+// GET /api/v1/marketplace-parser-service/search-jobs/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceSearchJobsIDGet(ctx context.Context, params APIV1MarketplaceParserServiceSearchJobsIDGetParams) (r APIV1MarketplaceParserServiceSearchJobsIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceSearchJobsPost implements POST /api/v1/marketplace-parser-service/search-jobs operation.
+//
+// Queue a search that runs in the background. Poll the job by its id for the progress and the
+// products.
+//
+// POST /api/v1/marketplace-parser-service/search-jobs
+func (UnimplementedHandler) APIV1MarketplaceParserServiceSearchJobsPost(ctx context.Context, req *SearchJobRequest) (r APIV1MarketplaceParserServiceSearchJobsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesGet implements GET /api/v1/marketplace-parser-service/watches operation.
+//
+// List watches.
+//
+// GET /api/v1/marketplace-parser-service/watches
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesGet(ctx context.Context) (r APIV1MarketplaceParserServiceWatchesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDelete implements DELETE /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Delete the watch and its delivery log.
+//
+// DELETE /api/v1/marketplace-parser-service/watches/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesIDDelete(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeleteParams) (r APIV1MarketplaceParserServiceWatchesIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesIDDeliveriesGet implements GET /api/v1/marketplace-parser-service/watches/{id}/deliveries operation.
+//
+// Get the last webhook deliveries of the watch, the newest first.
+//
+// GET /api/v1/marketplace-parser-service/watches/{id}/deliveries
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) (r APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesIDGet implements GET /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Get the watch and the result of its last check.
+//
+// GET /api/v1/marketplace-parser-service/watches/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesIDGet(ctx context.Context, params APIV1MarketplaceParserServiceWatchesIDGetParams) (r APIV1MarketplaceParserServiceWatchesIDGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesIDPut implements PUT /api/v1/marketplace-parser-service/watches/{id} operation.
+//
+// Replace the watch, its secret is kept. The result of the last check is reset if the product, the
+// query or the target price is changed.
+//
+// PUT /api/v1/marketplace-parser-service/watches/{id}
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesIDPut(ctx context.Context, req *WatchRequest, params APIV1MarketplaceParserServiceWatchesIDPutParams) (r APIV1MarketplaceParserServiceWatchesIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// APIV1MarketplaceParserServiceWatchesPost implements POST /api/v1/marketplace-parser-service/watches operation.
+//
+// Watch a product or the cheapest product of a search query. The target is searched every interval
+// and its webhook is notified with a signed JSON POST when the price drops below targetPrice or,
+// with notifyStock, the stock of the product changes. The signature is in X-Webhook-Signature:
+// "sha256=" and the hex HMAC-SHA256 of X-Webhook-Timestamp, "." and the body keyed by the secret
+// returned by this request only. Failed deliveries are retried with backoff.
+//
+// POST /api/v1/marketplace-parser-service/watches
+func (UnimplementedHandler) APIV1MarketplaceParserServiceWatchesPost(ctx context.Context, req *WatchRequest) (r APIV1MarketplaceParserServiceWatchesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MetricsGet implements GET /metrics operation.
+//
+// Get the service metrics in the Prometheus text format.
+//
+// GET /metrics
+func (UnimplementedHandler) MetricsGet(ctx context.Context) (r MetricsGetOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func (h *Handler) APIV1MarketplaceParserServiceSearchJobsPost(ctx context.Context, req *httpgen.SearchJobRequest) (httpgen.APIV1MarketplaceParserServiceSearchJobsPostRes, error) {
+	job, err := h.jobSrv.CreateSearchJob(ctx, domain.SearchQuery{
+		Name:        req.Name,
+		PriceFrom:   req.PriceFrom.Value,
+		PriceTo:     req.PriceTo.Value,
+		InStockOnly: req.InStockOnly.Value,
+		Region:      req.Region.Value,
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCreateSearchJobErrResp(), nil
+	}
+
+	return toSearchJobResp(job), nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceSearchJobsIDGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetParams) (httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetRes, error) {
+	job, err := h.jobSrv.GetSearchJob(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToGetSearchJobErrResp(), nil
+	}
+
+	return toSearchJobResp(job), nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceSearchJobsIDDelete(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteParams) (httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteRes, error) {
+	job, err := h.jobSrv.CancelSearchJob(ctx, params.ID)
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCancelSearchJobErrResp(), nil
+	}
+
+	return toSearchJobResp(job), nil
+}
+
+func toSearchJobResp(job domain.SearchJob) *httpgen.SearchJob {
+	res := &httpgen.SearchJob{
+		ID:     job.ID,
+		Status: httpgen.SearchJobStatus(job.Status),
+		Query: httpgen.SearchJobRequest{
+			Name:        job.Query.Name,
+			PriceFrom:   httpgen.NewOptFloat64(job.Query.PriceFrom),
+			PriceTo:     httpgen.NewOptFloat64(job.Query.PriceTo),
+			InStockOnly: httpgen.NewOptBool(job.Query.InStockOnly),
+		},
+		Sources:   make([]httpgen.SourceProgress, 0, len(job.Sources)),
+		CreatedAt: job.CreatedAt,
+	}
+	if job.Query.Region != "" {
+		res.Query.Region = httpgen.NewOptString(job.Query.Region)
+	}
+	for _, src := range job.Sources {
+		res.Sources = append(res.Sources, httpgen.SourceProgress{
+			Marketplace: httpgen.Marketplace(src.Marketplace),
+			State:       httpgen.SourceProgressState(src.State),
+		})
+	}
+	// The running job returns the results of the marketplaces finished so far
+	if job.Status == domain.JobSucceeded || job.Status == domain.JobRunning {
+		res.Products = make([]httpgen.Product, 0, len(job.Result.Products))
+		for _, p := range job.Result.Products {
+			res.Products = append(res.Products, toProductResp(p))
+		}
+		for _, m := range job.Result.TimedOut {
+			res.TimedOutSources = append(res.TimedOutSources, httpgen.Marketplace(m))
+		}
+	}
+	if job.Error != "" {
+		res.Error = httpgen.NewOptString(job.Error)
+	}
+	if !job.StartedAt.IsZero() {
+		res.StartedAt = httpgen.NewOptDateTime(job.StartedAt)
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishedAt = httpgen.NewOptDateTime(job.FinishedAt)
+	}
+
+	return res
+}
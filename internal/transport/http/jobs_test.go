@@ -0,0 +1,175 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func TestHandlers_APIV1MarketplaceParserServiceSearchJobsPost(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0, Region: "moscow"}
+	req := &httpgen.SearchJobRequest{Name: "prod", PriceTo: httpgen.NewOptFloat64(500.0), Region: httpgen.NewOptString("moscow")}
+
+	t.Run("queued", func(t *testing.T) {
+		jobSrvMock := &mocks.JobServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+		createdAt := time.Now()
+		job := domain.SearchJob{
+			ID:        "id",
+			Status:    domain.JobQueued,
+			Query:     query,
+			Sources:   []domain.SourceProgress{{Marketplace: domain.MarketplaceOzon, State: domain.SourcePending}},
+			CreatedAt: createdAt,
+		}
+		jobSrvMock.On("CreateSearchJob", mock.Anything, query).Return(job, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceSearchJobsPost(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, &httpgen.SearchJob{
+			ID:     "id",
+			Status: httpgen.SearchJobStatusQueued,
+			Query: httpgen.SearchJobRequest{
+				Name:        "prod",
+				PriceFrom:   httpgen.NewOptFloat64(0),
+				PriceTo:     httpgen.NewOptFloat64(500.0),
+				InStockOnly: httpgen.NewOptBool(false),
+				Region:      httpgen.NewOptString("moscow"),
+			},
+			Sources:   []httpgen.SourceProgress{{Marketplace: httpgen.MarketplaceOzon, State: httpgen.SourceProgressStatePending}},
+			CreatedAt: createdAt,
+		}, res)
+
+		jobSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("queue is full", func(t *testing.T) {
+		jobSrvMock := &mocks.JobServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+		jobSrvMock.On("CreateSearchJob", mock.Anything, query).Return(domain.SearchJob{}, &domain.TooManyRequestsError{Scope: "jobs", RetryAfter: 30 * time.Second}).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceSearchJobsPost(context.Background(), req)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.ErrorResponseHeaders)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Response.Status)
+		assert.Equal(t, httpgen.NewOptInt(30), resp.RetryAfter)
+
+		jobSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceSearchJobsIDGet(t *testing.T) {
+	t.Run("succeeded", func(t *testing.T) {
+		jobSrvMock := &mocks.JobServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+		job := domain.SearchJob{
+			ID:     "id",
+			Status: domain.JobSucceeded,
+			Query:  domain.SearchQuery{Name: "prod"},
+			Sources: []domain.SourceProgress{
+				{Marketplace: domain.MarketplaceOzon, State: domain.SourceTimedOut},
+				{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+			},
+			Result: domain.SearchResult{
+				Products: []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}},
+				TimedOut: []domain.Marketplace{domain.MarketplaceOzon},
+			},
+			CreatedAt:  time.Now(),
+			StartedAt:  time.Now(),
+			FinishedAt: time.Now(),
+		}
+		jobSrvMock.On("GetSearchJob", mock.Anything, "id").Return(job, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceSearchJobsIDGet(context.Background(), httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetParams{ID: "id"})
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchJob)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.SearchJobStatusSucceeded, resp.Status)
+		assert.Len(t, resp.Products, 1)
+		assert.Equal(t, []httpgen.Marketplace{httpgen.MarketplaceOzon}, resp.TimedOutSources)
+		assert.Equal(t, httpgen.SourceProgressStateTimedOut, resp.Sources[0].State)
+		assert.True(t, resp.FinishedAt.IsSet())
+
+		jobSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("running", func(t *testing.T) {
+		jobSrvMock := &mocks.JobServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+		job := domain.SearchJob{
+			ID:     "id",
+			Status: domain.JobRunning,
+			Query:  domain.SearchQuery{Name: "prod"},
+			Sources: []domain.SourceProgress{
+				{Marketplace: domain.MarketplaceOzon, State: domain.SourceRunning},
+				{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+			},
+			Result: domain.SearchResult{
+				Products: []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}},
+			},
+			CreatedAt: time.Now(),
+			StartedAt: time.Now(),
+		}
+		jobSrvMock.On("GetSearchJob", mock.Anything, "id").Return(job, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceSearchJobsIDGet(context.Background(), httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetParams{ID: "id"})
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.SearchJob)
+		assert.True(t, ok)
+		assert.Equal(t, httpgen.SearchJobStatusRunning, resp.Status)
+		assert.Len(t, resp.Products, 1)
+		assert.Equal(t, httpgen.SourceProgressStateRunning, resp.Sources[0].State)
+		assert.False(t, resp.FinishedAt.IsSet())
+
+		jobSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		jobSrvMock := &mocks.JobServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+		jobSrvMock.On("GetSearchJob", mock.Anything, "id").Return(domain.SearchJob{}, domain.ErrJobNotFound).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceSearchJobsIDGet(context.Background(), httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetParams{ID: "id"})
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceSearchJobsIDGetNotFound)
+		assert.True(t, ok)
+
+		jobSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceSearchJobsIDDelete(t *testing.T) {
+	jobSrvMock := &mocks.JobServiceMock{}
+	handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Jobs: jobSrvMock}, time.Second*30)
+
+	job := domain.SearchJob{ID: "id", Status: domain.JobCanceled, Query: domain.SearchQuery{Name: "prod"}, CreatedAt: time.Now(), FinishedAt: time.Now()}
+	jobSrvMock.On("CancelSearchJob", mock.Anything, "id").Return(job, nil).Once()
+
+	res, err := handler.APIV1MarketplaceParserServiceSearchJobsIDDelete(context.Background(), httpgen.APIV1MarketplaceParserServiceSearchJobsIDDeleteParams{ID: "id"})
+	assert.NoError(t, err)
+	resp, ok := res.(*httpgen.SearchJob)
+	assert.True(t, ok)
+	assert.Equal(t, httpgen.SearchJobStatusCanceled, resp.Status)
+	assert.Nil(t, resp.Products)
+
+	jobSrvMock.AssertExpectations(t)
+}
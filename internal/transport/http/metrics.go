@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+// circuitStateValues are the values of the circuit state gauge.
+var circuitStateValues = map[domain.CircuitState]int{
+	domain.CircuitClosed:   0,
+	domain.CircuitOpen:     1,
+	domain.CircuitHalfOpen: 2,
+}
+
+// MetricsGet writes the metrics in the Prometheus text exposition format.
+func (h *Handler) MetricsGet(ctx context.Context) (httpgen.MetricsGetOK, error) {
+	var b strings.Builder
+
+	statuses := h.parserSrv.GetSourcesStatus(ctx)
+
+	writeMetricHeader(&b, "marketplace_source_circuit_state", "gauge", "State of the circuit breaker of the marketplace: 0 closed, 1 open, 2 half-open.")
+	for _, st := range statuses {
+		fmt.Fprintf(&b, "marketplace_source_circuit_state{marketplace=%q} %d\n", st.Marketplace, circuitStateValues[st.State])
+	}
+
+	writeMetricHeader(&b, "marketplace_source_failures", "gauge", "Number of failures of the marketplace in a row.")
+	for _, st := range statuses {
+		fmt.Fprintf(&b, "marketplace_source_failures{marketplace=%q} %d\n", st.Marketplace, st.Failures)
+	}
+
+	writeMetricHeader(&b, "marketplace_source_circuit_opens_total", "counter", "Number of times the circuit breaker of the marketplace was opened.")
+	for _, st := range statuses {
+		fmt.Fprintf(&b, "marketplace_source_circuit_opens_total{marketplace=%q} %d\n", st.Marketplace, st.Opens)
+	}
+
+	return httpgen.MetricsGetOK{Data: strings.NewReader(b.String())}, nil
+}
+
+func writeMetricHeader(b *strings.Builder, name, typ, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, typ)
+}
@@ -56,7 +56,7 @@ func TestMiddlewares_LoggerMiddleware(t *testing.T) {
 			timeout := time.Second * 30
 			loggerMock := &mocks.LoggerMock{}
 
-			handler := ht.NewHandler(loggerMock, nil, timeout)
+			handler := ht.NewHandler(loggerMock, ht.Services{}, timeout)
 
 			req := httptest.NewRequest(http.MethodGet, "/testmiddleware", nil)
 			req.RemoteAddr = "1.2.3.4:1234"
@@ -91,3 +91,28 @@ func TestMiddlewares_LoggerMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestMiddlewares_RequestTimeoutMiddleware(t *testing.T) {
+	handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{}, time.Second*30)
+
+	testCases := []struct {
+		name        string
+		path        string
+		expDeadline bool
+	}{
+		{name: "search", path: "/api/v1/marketplace-parser-service/products/search", expDeadline: true},
+		{name: "batch", path: ht.SearchBatchPath, expDeadline: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var hasDeadline bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, hasDeadline = r.Context().Deadline()
+			})
+
+			handler.RequestTimeoutMiddleware(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, tc.path, nil))
+			assert.Equal(t, tc.expDeadline, hasDeadline)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func (h *Handler) APIV1MarketplaceParserServiceAdminSchedulesGet(ctx context.Context) (httpgen.APIV1MarketplaceParserServiceAdminSchedulesGetRes, error) {
+	var schedules []domain.Schedule
+	err := domain.ErrSchedulerDisabled
+	if h.schedulerSrv != nil {
+		schedules, err = h.schedulerSrv.ListSchedules(ctx)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToListSchedulesErrResp(), nil
+	}
+
+	res := make(httpgen.SchedulesResponse, 0, len(schedules))
+	for _, s := range schedules {
+		res = append(res, *toScheduleResp(s))
+	}
+
+	return &res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceAdminSchedulesPost(ctx context.Context, req *httpgen.ScheduleRequest) (httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostRes, error) {
+	schedule := domain.Schedule{
+		Cron: req.Cron,
+		Query: domain.SearchQuery{
+			Name:        req.Name,
+			PriceFrom:   req.PriceFrom.Value,
+			PriceTo:     req.PriceTo.Value,
+			InStockOnly: req.InStockOnly.Value,
+			Region:      req.Region.Value,
+		},
+	}
+	for _, m := range req.Marketplaces {
+		schedule.Query.Marketplaces = append(schedule.Query.Marketplaces, domain.Marketplace(m))
+	}
+
+	err := domain.ErrSchedulerDisabled
+	if h.schedulerSrv != nil {
+		schedule, err = h.schedulerSrv.CreateSchedule(ctx, schedule)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCreateScheduleErrResp(), nil
+	}
+
+	return toScheduleResp(schedule), nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceAdminSchedulesIDDelete(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams) (httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes, error) {
+	err := domain.ErrSchedulerDisabled
+	if h.schedulerSrv != nil {
+		err = h.schedulerSrv.DeleteSchedule(ctx, params.ID)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDeleteScheduleErrResp(), nil
+	}
+
+	return &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNoContent{}, nil
+}
+
+func toScheduleResp(schedule domain.Schedule) *httpgen.Schedule {
+	res := &httpgen.Schedule{
+		ID:          schedule.ID,
+		Cron:        schedule.Cron,
+		Name:        schedule.Query.Name,
+		PriceFrom:   httpgen.NewOptFloat64(schedule.Query.PriceFrom),
+		PriceTo:     httpgen.NewOptFloat64(schedule.Query.PriceTo),
+		InStockOnly: schedule.Query.InStockOnly,
+		FromConfig:  schedule.FromConfig,
+		CreatedAt:   schedule.CreatedAt,
+	}
+	if schedule.Query.Region != "" {
+		res.Region = httpgen.NewOptString(schedule.Query.Region)
+	}
+	for _, m := range schedule.Query.Marketplaces {
+		res.Marketplaces = append(res.Marketplaces, httpgen.Marketplace(m))
+	}
+	if !schedule.NextRunAt.IsZero() {
+		res.NextRunAt = httpgen.NewOptDateTime(schedule.NextRunAt)
+	}
+
+	if run := schedule.LastRun; !run.StartedAt.IsZero() {
+		lastRun := httpgen.ScheduleRun{
+			Status:    httpgen.ScheduleRunStatus(run.Status),
+			StartedAt: run.StartedAt,
+			Products:  run.Products,
+		}
+		if !run.FinishedAt.IsZero() {
+			lastRun.FinishedAt = httpgen.NewOptDateTime(run.FinishedAt)
+			lastRun.Duration = httpgen.NewOptDuration(run.Duration())
+		}
+		for _, m := range run.TimedOut {
+			lastRun.TimedOutSources = append(lastRun.TimedOutSources, httpgen.Marketplace(m))
+		}
+		if run.Error != "" {
+			lastRun.Error = httpgen.NewOptString(run.Error)
+		}
+		res.LastRun = httpgen.NewOptScheduleRun(lastRun)
+	}
+
+	return res
+}
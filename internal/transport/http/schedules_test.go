@@ -0,0 +1,177 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func TestHandlers_APIV1MarketplaceParserServiceAdminSchedulesGet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		schedulerSrvMock := &mocks.SchedulerServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Scheduler: schedulerSrvMock}, time.Second*30)
+
+		createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+		schedules := []domain.Schedule{
+			{
+				ID:         "phones",
+				Cron:       "0 */6 * * *",
+				Query:      domain.SearchQuery{Name: "iphone", PriceTo: 90000.0, Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}},
+				FromConfig: true,
+				CreatedAt:  createdAt,
+				LastRun: domain.ScheduleRun{
+					Status:     domain.JobFailed,
+					StartedAt:  createdAt.Add(time.Hour),
+					FinishedAt: createdAt.Add(time.Hour + 90*time.Second),
+					TimedOut:   []domain.Marketplace{domain.MarketplaceOzon},
+					Error:      "search failed",
+				},
+				NextRunAt: createdAt.Add(6 * time.Hour),
+			},
+			{
+				ID:        "id",
+				Cron:      "@hourly",
+				Query:     domain.SearchQuery{Name: "laptop", Region: "moscow"},
+				CreatedAt: createdAt,
+				LastRun:   domain.ScheduleRun{Status: domain.JobRunning, StartedAt: createdAt.Add(time.Hour)},
+			},
+		}
+		schedulerSrvMock.On("ListSchedules", mock.Anything).Return(schedules, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceAdminSchedulesGet(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &httpgen.SchedulesResponse{
+			{
+				ID:           "phones",
+				Cron:         "0 */6 * * *",
+				Name:         "iphone",
+				PriceFrom:    httpgen.NewOptFloat64(0),
+				PriceTo:      httpgen.NewOptFloat64(90000.0),
+				Marketplaces: []httpgen.Marketplace{httpgen.MarketplaceOzon},
+				FromConfig:   true,
+				CreatedAt:    createdAt,
+				LastRun: httpgen.NewOptScheduleRun(httpgen.ScheduleRun{
+					Status:          httpgen.ScheduleRunStatusFailed,
+					StartedAt:       createdAt.Add(time.Hour),
+					FinishedAt:      httpgen.NewOptDateTime(createdAt.Add(time.Hour + 90*time.Second)),
+					Duration:        httpgen.NewOptDuration(90 * time.Second),
+					TimedOutSources: []httpgen.Marketplace{httpgen.MarketplaceOzon},
+					Error:           httpgen.NewOptString("search failed"),
+				}),
+				NextRunAt: httpgen.NewOptDateTime(createdAt.Add(6 * time.Hour)),
+			},
+			{
+				ID:        "id",
+				Cron:      "@hourly",
+				Name:      "laptop",
+				PriceFrom: httpgen.NewOptFloat64(0),
+				PriceTo:   httpgen.NewOptFloat64(0),
+				Region:    httpgen.NewOptString("moscow"),
+				CreatedAt: createdAt,
+				LastRun: httpgen.NewOptScheduleRun(httpgen.ScheduleRun{
+					Status:    httpgen.ScheduleRunStatusRunning,
+					StartedAt: createdAt.Add(time.Hour),
+				}),
+			},
+		}, res)
+
+		schedulerSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("scheduler is disabled", func(t *testing.T) {
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{}, time.Second*30)
+
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceAdminSchedulesGet(context.Background())
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceAdminSchedulesGetNotFound)
+		assert.True(t, ok)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceAdminSchedulesPost(t *testing.T) {
+	req := &httpgen.ScheduleRequest{
+		Cron:         "0 */6 * * *",
+		Name:         "iphone",
+		PriceTo:      httpgen.NewOptFloat64(90000.0),
+		InStockOnly:  httpgen.NewOptBool(true),
+		Marketplaces: []httpgen.Marketplace{httpgen.MarketplaceWildberries},
+	}
+	schedule := domain.Schedule{
+		Cron:  "0 */6 * * *",
+		Query: domain.SearchQuery{Name: "iphone", PriceTo: 90000.0, InStockOnly: true, Marketplaces: []domain.Marketplace{domain.MarketplaceWildberries}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		schedulerSrvMock := &mocks.SchedulerServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Scheduler: schedulerSrvMock}, time.Second*30)
+
+		created := schedule
+		created.ID = "id"
+		created.CreatedAt = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+		schedulerSrvMock.On("CreateSchedule", mock.Anything, schedule).Return(created, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceAdminSchedulesPost(context.Background(), req)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.Schedule)
+		assert.True(t, ok)
+		assert.Equal(t, "id", resp.ID)
+		assert.True(t, resp.InStockOnly)
+		assert.False(t, resp.LastRun.Set)
+		assert.False(t, resp.NextRunAt.Set)
+
+		schedulerSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid cron", func(t *testing.T) {
+		schedulerSrvMock := &mocks.SchedulerServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Scheduler: schedulerSrvMock}, time.Second*30)
+
+		schedulerSrvMock.On("CreateSchedule", mock.Anything, schedule).Return(domain.Schedule{}, domain.ErrInvalidCron).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceAdminSchedulesPost(context.Background(), req)
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceAdminSchedulesPostBadRequest)
+		assert.True(t, ok)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceAdminSchedulesIDDelete(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		exp  httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteRes
+	}{
+		{name: "success", exp: &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNoContent{}},
+		{name: "not found", err: domain.ErrScheduleNotFound, exp: &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteNotFound{Message: "not found", Status: 404}},
+		{name: "from config", err: domain.ErrScheduleFromConfig, exp: &httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteConflict{Message: "conflict", Status: 409}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedulerSrvMock := &mocks.SchedulerServiceMock{}
+			loggerMock := &mocks.LoggerMock{}
+			handler := ht.NewHandler(loggerMock, ht.Services{Scheduler: schedulerSrvMock}, time.Second*30)
+
+			schedulerSrvMock.On("DeleteSchedule", mock.Anything, "id").Return(tc.err).Once()
+			loggerMock.On("Warn", "http_request_failed", mock.Anything).Maybe()
+
+			res, err := handler.APIV1MarketplaceParserServiceAdminSchedulesIDDelete(context.Background(), httpgen.APIV1MarketplaceParserServiceAdminSchedulesIDDeleteParams{ID: "id"})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, res)
+
+			schedulerSrvMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+// SearchStreamPath is the Server-Sent Events variant of the search endpoint.
+const SearchStreamPath = "/api/v1/marketplace-parser-service/products/search/stream"
+
+// Routes serves the streamed responses the generated server can't serve and passes the other requests to api.
+func (h *Handler) Routes(api http.Handler) http.Handler {
+	// The generated server decodes and validates the parameters of the search stream, but it writes the whole
+	// response at once, so the handler writes the events to the response it finds in the context
+	h.router.HandleFunc("GET "+SearchStreamPath, func(w http.ResponseWriter, r *http.Request) {
+		sw := &streamWriter{ResponseWriter: w}
+		api.ServeHTTP(sw, r.WithContext(context.WithValue(r.Context(), streamWriterKey{}, sw)))
+	})
+	h.router.HandleFunc("POST "+SearchBatchPath, func(w http.ResponseWriter, r *http.Request) {
+		if !acceptsNDJSON(r) {
+			api.ServeHTTP(w, r)
+			return
+		}
+		h.SearchBatchStream(w, r)
+	})
+	h.router.Handle("/", api)
+
+	return h.router
+}
+
+type productsEvent struct {
+	Marketplace httpgen.Marketplace `json:"marketplace"`
+	Products    []httpgen.Product   `json:"products"`
+}
+
+type sourceErrorEvent struct {
+	Marketplace httpgen.Marketplace `json:"marketplace"`
+	Status      int                 `json:"status"`
+	Message     string              `json:"message"`
+	RetryAfter  int                 `json:"retryAfter,omitempty"`
+	ArtifactID  string              `json:"artifactId,omitempty"`
+}
+
+// errorEvent ends the stream of the search failed after the first event.
+type errorEvent struct {
+	Status     int    `json:"status"`
+	Message    string `json:"message"`
+	RetryAfter int    `json:"retryAfter,omitempty"`
+	ArtifactID string `json:"artifactId,omitempty"`
+}
+
+type doneEvent struct {
+	// Products is the number of the products sent in all products events.
+	Products        int                   `json:"products"`
+	TimedOutSources []httpgen.Marketplace `json:"timedOutSources"`
+	FailedSources   []httpgen.Marketplace `json:"failedSources"`
+}
+
+// APIV1MarketplaceParserServiceProductsSearchStreamGet searches the products and streams the result of every
+// marketplace as soon as it's finished: a products event for the found products, a source_error event for a failed
+// or timed out marketplace and the done event at the end, or the error event if the search fails after the first event.
+// The errors before the first event are returned as JSON.
+func (h *Handler) APIV1MarketplaceParserServiceProductsSearchStreamGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetParams) (httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetRes, error) {
+	sw, ok := ctx.Value(streamWriterKey{}).(*streamWriter)
+	if !ok {
+		return nil, errors.New("search stream is served without Routes")
+	}
+
+	stream := &eventStream{w: sw}
+	done := doneEvent{TimedOutSources: []httpgen.Marketplace{}, FailedSources: []httpgen.Marketplace{}}
+
+	err := h.parserSrv.StreamProductsList(ctx, domain.SearchQuery{
+		Name:        params.Name,
+		PriceFrom:   params.PriceFrom.Value,
+		PriceTo:     params.PriceTo.Value,
+		InStockOnly: params.InStockOnly.Value,
+		Region:      params.Region.Value,
+		Debug:       params.Debug.Value,
+	}, func(res domain.SourceResult) {
+		marketplace := httpgen.Marketplace(res.Marketplace)
+
+		switch {
+		case res.TimedOut:
+			done.TimedOutSources = append(done.TimedOutSources, marketplace)
+			h.sendEvent(stream, "source_error", sourceErrorEvent{
+				Marketplace: marketplace,
+				Status:      http.StatusGatewayTimeout,
+				Message:     ErrGatewayTimeout.Error(),
+			})
+		case res.Err != nil:
+			httpErr := MapError(res.Err)
+			h.LogHTTPError(ctx, res.Err, httpErr)
+			done.FailedSources = append(done.FailedSources, marketplace)
+			h.sendEvent(stream, "source_error", sourceErrorEvent{
+				Marketplace: marketplace,
+				Status:      httpErr.Status,
+				Message:     httpErr.Message,
+				RetryAfter:  httpErr.RetryAfter,
+				ArtifactID:  httpErr.ArtifactID,
+			})
+		default:
+			event := productsEvent{Marketplace: marketplace, Products: make([]httpgen.Product, 0, len(res.Products))}
+			for _, p := range res.Products {
+				event.Products = append(event.Products, toProductResp(p))
+			}
+			done.Products += len(res.Products)
+			h.sendEvent(stream, "products", event)
+		}
+	})
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		if !sw.started {
+			return httpErr.ToSearchStreamErrResp(), nil
+		}
+		h.sendEvent(stream, "error", errorEvent{
+			Status:     httpErr.Status,
+			Message:    httpErr.Message,
+			RetryAfter: httpErr.RetryAfter,
+			ArtifactID: httpErr.ArtifactID,
+		})
+	} else {
+		h.sendEvent(stream, "done", done)
+	}
+
+	// The events are already written, the generated server writes nothing after them
+	return &httpgen.APIV1MarketplaceParserServiceProductsSearchStreamGetOK{Data: http.NoBody}, nil
+}
+
+func (h *Handler) sendEvent(stream *eventStream, event string, data any) {
+	if err := stream.send(event, data); err != nil {
+		h.logger.Warn("send event", "event", event, "err", err)
+	}
+}
+
+type streamWriterKey struct{}
+
+// streamWriter is the response of the search stream, the response written by the generated server
+// is dropped once the handler started the stream.
+type streamWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (w *streamWriter) WriteHeader(status int) {
+	if !w.started {
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (w *streamWriter) Write(b []byte) (int, error) {
+	if w.started {
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController flush the events through the wrapper.
+func (w *streamWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// eventStream writes the Server-Sent Events, the headers are written with the first event.
+type eventStream struct {
+	w *streamWriter
+}
+
+func (s *eventStream) send(event string, data any) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal data: %w", err)
+	}
+
+	w := s.w.ResponseWriter
+	if !s.w.started {
+		s.w.started = true
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, b); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	if err := http.NewResponseController(w).Flush(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
+
+func writeErrorResponse(w http.ResponseWriter, httpErr *HTTPError) {
+	resp := httpgen.ErrorResponse{Message: httpErr.Message, Status: httpErr.Status, ArtifactId: httpErr.optArtifactID()}
+	b, err := resp.MarshalJSON()
+	if err != nil {
+		http.Error(w, httpErr.Message, httpErr.Status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if httpErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(httpErr.RetryAfter))
+	}
+	w.WriteHeader(httpErr.Status)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,160 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+// serveStream serves the request by the routes of the handler in front of the generated server like main does.
+func serveStream(t *testing.T, handler *ht.Handler, req *http.Request) *httptest.ResponseRecorder {
+	srv, err := httpgen.NewServer(handler)
+	assert.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.Routes(srv).ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandlers_SearchStream(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0, InStockOnly: true}
+
+	t.Run("events", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.SourceResult))
+			fn(domain.SourceResult{Marketplace: domain.MarketplaceWildberries, Products: []domain.Product{{Name: "prod", Link: "link1", Price: 100.0, InStock: true}}})
+			fn(domain.SourceResult{Marketplace: domain.MarketplaceOzon, Err: &domain.SourceBlockedError{Marketplace: domain.MarketplaceOzon, RetryAfter: time.Minute}})
+		}).Return(nil).Once()
+		loggerMock.On("Error", "http_request_failed", mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, ht.SearchStreamPath+"?name=prod&price_to=500&in_stock_only=true", nil)
+		rec := serveStream(t, handler, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, "event: products\n"+
+			`data: {"marketplace":"wildberries","products":[{"name":"prod","link":"link1","price":100,"rating":0,"reviewsCount":0,"inStock":true}]}`+"\n\n"+
+			"event: source_error\n"+
+			`data: {"marketplace":"ozon","status":503,"message":"service unavailable","retryAfter":60}`+"\n\n"+
+			"event: done\n"+
+			`data: {"products":1,"timedOutSources":[],"failedSources":["ozon"]}`+"\n\n", rec.Body.String())
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			query string
+		}{
+			{name: "invalid price", query: "?name=prod&price_to=abc"},
+			{name: "no name", query: "?price_to=500"},
+			{name: "invalid in stock only", query: "?name=prod&in_stock_only=maybe"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				parserSrvMock := &mocks.ParserServiceMock{}
+				handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+				req := httptest.NewRequest(http.MethodGet, ht.SearchStreamPath+tc.query, nil)
+				rec := serveStream(t, handler, req)
+
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+				assert.NotEqual(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+				parserSrvMock.AssertNotCalled(t, "StreamProductsList", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("invalid price range", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		query := domain.SearchQuery{Name: "prod", PriceFrom: 500.0, PriceTo: 100.0}
+		parserSrvMock.On("StreamProductsList", mock.Anything, query, mock.Anything).Return(domain.ErrPriceFromAbovePriceTo).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, ht.SearchStreamPath+"?name=prod&price_from=500&price_to=100", nil)
+		rec := serveStream(t, handler, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"status":400,"message":"bad request"}`, rec.Body.String())
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("failed before events", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("StreamProductsList", mock.Anything, domain.SearchQuery{Name: "prod"}, mock.Anything).Return(&domain.TooManyRequestsError{Scope: "global", RetryAfter: 5 * time.Second}).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, ht.SearchStreamPath+"?name=prod", nil)
+		rec := serveStream(t, handler, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "5", rec.Header().Get("Retry-After"))
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("failed after events", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Parser: parserSrvMock}, time.Second*30)
+
+		parserSrvMock.On("StreamProductsList", mock.Anything, domain.SearchQuery{Name: "prod"}, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.SourceResult))
+			fn(domain.SourceResult{Marketplace: domain.MarketplaceWildberries, Products: []domain.Product{}})
+		}).Return(domain.ErrGatewayTimeout).Once()
+		loggerMock.On("Error", "http_request_failed", mock.Anything).Once()
+
+		req := httptest.NewRequest(http.MethodGet, ht.SearchStreamPath+"?name=prod", nil)
+		rec := serveStream(t, handler, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "event: products\n"+
+			`data: {"marketplace":"wildberries","products":[]}`+"\n\n"+
+			"event: error\n"+
+			`data: {"status":504,"message":"gateway timeout"}`+"\n\n", rec.Body.String())
+
+		parserSrvMock.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("other routes", func(t *testing.T) {
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{}, time.Second*30)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/marketplace-parser-service/products/search?name=prod", nil)
+		handler.Routes(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+	})
+}
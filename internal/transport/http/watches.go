@@ -0,0 +1,228 @@
+package http
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesPost(ctx context.Context, req *httpgen.WatchRequest) (httpgen.APIV1MarketplaceParserServiceWatchesPostRes, error) {
+	watch, err := toWatch(req)
+	if err == nil && h.watchSrv == nil {
+		err = domain.ErrWatchesDisabled
+	}
+	if err == nil {
+		watch, err = h.watchSrv.CreateWatch(ctx, watch)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToCreateWatchErrResp(), nil
+	}
+
+	// The secret is returned on creation only
+	res := toWatchResp(watch)
+	res.Secret = httpgen.NewOptString(watch.Secret)
+
+	return res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesGet(ctx context.Context) (httpgen.APIV1MarketplaceParserServiceWatchesGetRes, error) {
+	var watches []domain.Watch
+	err := domain.ErrWatchesDisabled
+	if h.watchSrv != nil {
+		watches, err = h.watchSrv.ListWatches(ctx)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToListWatchesErrResp(), nil
+	}
+
+	res := make(httpgen.WatchesResponse, 0, len(watches))
+	for _, w := range watches {
+		res = append(res, *toWatchResp(w))
+	}
+
+	return &res, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesIDGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceWatchesIDGetParams) (httpgen.APIV1MarketplaceParserServiceWatchesIDGetRes, error) {
+	var watch domain.Watch
+	err := domain.ErrWatchesDisabled
+	if h.watchSrv != nil {
+		watch, err = h.watchSrv.GetWatch(ctx, params.ID)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToGetWatchErrResp(), nil
+	}
+
+	return toWatchResp(watch), nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesIDPut(ctx context.Context, req *httpgen.WatchRequest, params httpgen.APIV1MarketplaceParserServiceWatchesIDPutParams) (httpgen.APIV1MarketplaceParserServiceWatchesIDPutRes, error) {
+	watch, err := toWatch(req)
+	if err == nil && h.watchSrv == nil {
+		err = domain.ErrWatchesDisabled
+	}
+	if err == nil {
+		watch.ID = params.ID
+		watch, err = h.watchSrv.UpdateWatch(ctx, watch)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToUpdateWatchErrResp(), nil
+	}
+
+	return toWatchResp(watch), nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesIDDelete(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteParams) (httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteRes, error) {
+	err := domain.ErrWatchesDisabled
+	if h.watchSrv != nil {
+		err = h.watchSrv.DeleteWatch(ctx, params.ID)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToDeleteWatchErrResp(), nil
+	}
+
+	return &httpgen.APIV1MarketplaceParserServiceWatchesIDDeleteNoContent{}, nil
+}
+
+func (h *Handler) APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(ctx context.Context, params httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams) (httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetRes, error) {
+	var deliveries []domain.Delivery
+	err := domain.ErrWatchesDisabled
+	if h.watchSrv != nil {
+		deliveries, err = h.watchSrv.ListDeliveries(ctx, params.ID)
+	}
+	if err != nil {
+		httpErr := MapError(err)
+		h.LogHTTPError(ctx, err, httpErr)
+		return httpErr.ToListDeliveriesErrResp(), nil
+	}
+
+	res := make(httpgen.DeliveriesResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		delivery := httpgen.Delivery{
+			ID:        d.ID,
+			Event:     httpgen.DeliveryEvent(d.Notification.Event),
+			Product:   toProductResp(d.Notification.Product),
+			Status:    httpgen.DeliveryStatus(d.Status),
+			Attempts:  d.Attempts,
+			CreatedAt: d.CreatedAt,
+		}
+		if d.ResponseStatus != 0 {
+			delivery.ResponseStatus = httpgen.NewOptInt(d.ResponseStatus)
+		}
+		if d.Error != "" {
+			delivery.Error = httpgen.NewOptString(d.Error)
+		}
+		if !d.NextAttemptAt.IsZero() {
+			delivery.NextAttemptAt = httpgen.NewOptDateTime(d.NextAttemptAt)
+		}
+		if !d.DeliveredAt.IsZero() {
+			delivery.DeliveredAt = httpgen.NewOptDateTime(d.DeliveredAt)
+		}
+		res = append(res, delivery)
+	}
+
+	return &res, nil
+}
+
+// toWatch converts the request to the watch, exactly one of the product and the query must be set.
+// The id of the product is parsed from its url if it's not set.
+func toWatch(req *httpgen.WatchRequest) (domain.Watch, error) {
+	if req.Product.Set == req.Query.Set {
+		return domain.Watch{}, domain.ErrInvalidWatchTarget
+	}
+
+	watch := domain.Watch{
+		TargetPrice: req.TargetPrice,
+		NotifyStock: req.NotifyStock.Value,
+		WebhookURL:  strings.TrimSpace(req.WebhookUrl),
+		Interval:    req.Interval.Value,
+	}
+	if product, ok := req.Product.Get(); ok {
+		watch.Marketplace = domain.Marketplace(product.Marketplace)
+		watch.ProductID = product.ID.Value
+		if watch.ProductID == "" && product.URL.Value != "" {
+			watch.ProductID = watch.Marketplace.ProductID(product.URL.Value)
+		}
+		watch.Query = domain.SearchQuery{Name: product.Name.Value, Region: product.Region.Value}
+	}
+	if query, ok := req.Query.Get(); ok {
+		watch.Query = domain.SearchQuery{
+			Name:        query.Name,
+			PriceFrom:   query.PriceFrom.Value,
+			PriceTo:     query.PriceTo.Value,
+			InStockOnly: query.InStockOnly.Value,
+			Region:      query.Region.Value,
+		}
+		for _, m := range query.Marketplaces {
+			watch.Query.Marketplaces = append(watch.Query.Marketplaces, domain.Marketplace(m))
+		}
+	}
+
+	return watch, nil
+}
+
+func toWatchResp(watch domain.Watch) *httpgen.Watch {
+	res := &httpgen.Watch{
+		ID:          watch.ID,
+		TargetPrice: watch.TargetPrice,
+		NotifyStock: watch.NotifyStock,
+		WebhookUrl:  watch.WebhookURL,
+		Interval:    watch.Interval,
+		CreatedAt:   watch.CreatedAt,
+	}
+	if watch.ProductWatch() {
+		product := httpgen.WatchProduct{
+			Marketplace: httpgen.Marketplace(watch.Marketplace),
+			ID:          httpgen.NewOptString(watch.ProductID),
+		}
+		if watch.Query.Name != "" {
+			product.Name = httpgen.NewOptString(watch.Query.Name)
+		}
+		if watch.Query.Region != "" {
+			product.Region = httpgen.NewOptString(watch.Query.Region)
+		}
+		res.Product = httpgen.NewOptWatchProduct(product)
+	} else {
+		query := httpgen.WatchQuery{
+			Name:        watch.Query.Name,
+			PriceFrom:   httpgen.NewOptFloat64(watch.Query.PriceFrom),
+			PriceTo:     httpgen.NewOptFloat64(watch.Query.PriceTo),
+			InStockOnly: httpgen.NewOptBool(watch.Query.InStockOnly),
+		}
+		if watch.Query.Region != "" {
+			query.Region = httpgen.NewOptString(watch.Query.Region)
+		}
+		for _, m := range watch.Query.Marketplaces {
+			query.Marketplaces = append(query.Marketplaces, httpgen.Marketplace(m))
+		}
+		res.Query = httpgen.NewOptWatchQuery(query)
+	}
+	if !watch.State.CheckedAt.IsZero() {
+		state := httpgen.WatchState{
+			CheckedAt:   watch.State.CheckedAt,
+			Found:       watch.State.Found,
+			BelowTarget: watch.State.BelowTarget,
+		}
+		if watch.State.Found {
+			state.Product = httpgen.NewOptProduct(toProductResp(watch.State.Product))
+		}
+		if watch.State.Error != "" {
+			state.Error = httpgen.NewOptString(watch.State.Error)
+		}
+		res.LastCheck = httpgen.NewOptWatchState(state)
+	}
+
+	return res
+}
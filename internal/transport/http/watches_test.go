@@ -0,0 +1,215 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	ht "github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/transport/http/httpgen"
+)
+
+func TestHandlers_APIV1MarketplaceParserServiceWatchesPost(t *testing.T) {
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("product url", func(t *testing.T) {
+		watchSrvMock := &mocks.WatchServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+		req := &httpgen.WatchRequest{
+			Product:     httpgen.NewOptWatchProduct(httpgen.WatchProduct{Marketplace: httpgen.MarketplaceOzon, URL: httpgen.NewOptString("https://www.ozon.ru/product/prod-123/")}),
+			TargetPrice: 100.0,
+			WebhookUrl:  " https://example.com/hook ",
+		}
+		watch := domain.Watch{
+			Marketplace: domain.MarketplaceOzon,
+			ProductID:   "123",
+			TargetPrice: 100.0,
+			WebhookURL:  "https://example.com/hook",
+		}
+		created := watch
+		created.ID = "id"
+		created.Secret = "secret"
+		created.Query = domain.SearchQuery{Name: "prod"}
+		created.Interval = time.Hour
+		created.CreatedAt = createdAt
+		watchSrvMock.On("CreateWatch", mock.Anything, watch).Return(created, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesPost(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, &httpgen.Watch{
+			ID: "id",
+			Product: httpgen.NewOptWatchProduct(httpgen.WatchProduct{
+				Marketplace: httpgen.MarketplaceOzon,
+				ID:          httpgen.NewOptString("123"),
+				Name:        httpgen.NewOptString("prod"),
+			}),
+			TargetPrice: 100.0,
+			WebhookUrl:  "https://example.com/hook",
+			Interval:    time.Hour,
+			Secret:      httpgen.NewOptString("secret"),
+			CreatedAt:   createdAt,
+		}, res)
+
+		watchSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		watchSrvMock := &mocks.WatchServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+		req := &httpgen.WatchRequest{
+			Query: httpgen.NewOptWatchQuery(httpgen.WatchQuery{
+				Name:         "prod",
+				PriceTo:      httpgen.NewOptFloat64(500.0),
+				Marketplaces: []httpgen.Marketplace{httpgen.MarketplaceWildberries},
+			}),
+			TargetPrice: 100.0,
+			WebhookUrl:  "https://example.com/hook",
+			Interval:    httpgen.NewOptDuration(10 * time.Minute),
+		}
+		watch := domain.Watch{
+			Query:       domain.SearchQuery{Name: "prod", PriceTo: 500.0, Marketplaces: []domain.Marketplace{domain.MarketplaceWildberries}},
+			TargetPrice: 100.0,
+			WebhookURL:  "https://example.com/hook",
+			Interval:    10 * time.Minute,
+		}
+		created := watch
+		created.ID = "id"
+		created.Secret = "secret"
+		watchSrvMock.On("CreateWatch", mock.Anything, watch).Return(created, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesPost(context.Background(), req)
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.Watch)
+		assert.True(t, ok)
+		assert.Equal(t, "prod", resp.Query.Value.Name)
+		assert.False(t, resp.Product.Set)
+		assert.Equal(t, httpgen.NewOptString("secret"), resp.Secret)
+
+		watchSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("product and query", func(t *testing.T) {
+		watchSrvMock := &mocks.WatchServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesPost(context.Background(), &httpgen.WatchRequest{
+			Product:     httpgen.NewOptWatchProduct(httpgen.WatchProduct{Marketplace: httpgen.MarketplaceOzon, ID: httpgen.NewOptString("1")}),
+			Query:       httpgen.NewOptWatchQuery(httpgen.WatchQuery{Name: "prod"}),
+			TargetPrice: 100.0,
+			WebhookUrl:  "https://example.com/hook",
+		})
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceWatchesPostBadRequest)
+		assert.True(t, ok)
+
+		watchSrvMock.AssertNotCalled(t, "CreateWatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("watches are disabled", func(t *testing.T) {
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{}, time.Second*30)
+
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesPost(context.Background(), &httpgen.WatchRequest{
+			Query:       httpgen.NewOptWatchQuery(httpgen.WatchQuery{Name: "prod"}),
+			TargetPrice: 100.0,
+			WebhookUrl:  "https://example.com/hook",
+		})
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceWatchesPostNotFound)
+		assert.True(t, ok)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceWatchesIDGet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		watchSrvMock := &mocks.WatchServiceMock{}
+		handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+		checkedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+		watch := domain.Watch{
+			ID:          "id",
+			Query:       domain.SearchQuery{Name: "prod"},
+			TargetPrice: 100.0,
+			WebhookURL:  "https://example.com/hook",
+			Secret:      "secret",
+			State:       domain.WatchState{CheckedAt: checkedAt, Found: true, Product: domain.Product{ID: "1", Name: "prod", Price: 90.0}, BelowTarget: true},
+		}
+		watchSrvMock.On("GetWatch", mock.Anything, "id").Return(watch, nil).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesIDGet(context.Background(), httpgen.APIV1MarketplaceParserServiceWatchesIDGetParams{ID: "id"})
+		assert.NoError(t, err)
+		resp, ok := res.(*httpgen.Watch)
+		assert.True(t, ok)
+		assert.False(t, resp.Secret.Set)
+		assert.Equal(t, httpgen.NewOptWatchState(httpgen.WatchState{
+			CheckedAt:   checkedAt,
+			Found:       true,
+			Product:     httpgen.NewOptProduct(httpgen.Product{ID: httpgen.NewOptString("1"), Name: "prod", Price: 90.0}),
+			BelowTarget: true,
+		}), resp.LastCheck)
+
+		watchSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		watchSrvMock := &mocks.WatchServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		handler := ht.NewHandler(loggerMock, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+		watchSrvMock.On("GetWatch", mock.Anything, "id").Return(domain.Watch{}, domain.ErrWatchNotFound).Once()
+		loggerMock.On("Warn", "http_request_failed", mock.Anything).Once()
+
+		res, err := handler.APIV1MarketplaceParserServiceWatchesIDGet(context.Background(), httpgen.APIV1MarketplaceParserServiceWatchesIDGetParams{ID: "id"})
+		assert.NoError(t, err)
+		_, ok := res.(*httpgen.APIV1MarketplaceParserServiceWatchesIDGetNotFound)
+		assert.True(t, ok)
+
+		watchSrvMock.AssertExpectations(t)
+	})
+}
+
+func TestHandlers_APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(t *testing.T) {
+	watchSrvMock := &mocks.WatchServiceMock{}
+	handler := ht.NewHandler(&mocks.LoggerMock{}, ht.Services{Watches: watchSrvMock}, time.Second*30)
+
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	deliveries := []domain.Delivery{{
+		ID:             "delivery",
+		WatchID:        "id",
+		Notification:   domain.WatchNotification{Event: domain.WatchPriceDrop, Product: domain.Product{ID: "1", Name: "prod", Price: 90.0}},
+		Status:         domain.DeliveryPending,
+		Attempts:       1,
+		ResponseStatus: 500,
+		Error:          "webhook responded with status 500",
+		CreatedAt:      createdAt,
+		NextAttemptAt:  createdAt.Add(time.Minute),
+	}}
+	watchSrvMock.On("ListDeliveries", mock.Anything, "id").Return(deliveries, nil).Once()
+
+	res, err := handler.APIV1MarketplaceParserServiceWatchesIDDeliveriesGet(context.Background(), httpgen.APIV1MarketplaceParserServiceWatchesIDDeliveriesGetParams{ID: "id"})
+	assert.NoError(t, err)
+	assert.Equal(t, &httpgen.DeliveriesResponse{{
+		ID:             "delivery",
+		Event:          httpgen.DeliveryEventPriceDrop,
+		Product:        httpgen.Product{ID: httpgen.NewOptString("1"), Name: "prod", Price: 90.0},
+		Status:         httpgen.DeliveryStatusPending,
+		Attempts:       1,
+		ResponseStatus: httpgen.NewOptInt(500),
+		Error:          httpgen.NewOptString("webhook responded with status 500"),
+		CreatedAt:      createdAt,
+		NextAttemptAt:  httpgen.NewOptDateTime(createdAt.Add(time.Minute)),
+	}}, res)
+
+	watchSrvMock.AssertExpectations(t)
+}
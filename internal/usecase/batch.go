@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+// BatchService runs many search queries at once with bounded parallelism.
+type BatchService interface {
+	// SearchBatch passes the result of every query to fn as soon as it's finished, the failed queries
+	// don't stop the others. fn is called once per query and never concurrently.
+	SearchBatch(ctx context.Context, queries []domain.BatchQuery, fn func(domain.BatchResult)) error
+}
+
+// BatchConfig describes the parallelism and the deadlines of the batch searches.
+type BatchConfig struct {
+	// Parallelism is the number of the queries of a batch run at the same time.
+	Parallelism int
+	MaxQueries  int
+	// QueryTimeout is the deadline of a query, it replaces the request timeout of the synchronous search.
+	QueryTimeout time.Duration
+	// Timeout is the deadline of the whole batch, the queries that aren't started by then fail with ErrGatewayTimeout.
+	Timeout time.Duration
+}
+
+type batchService struct {
+	parser ParserService
+	cfg    *BatchConfig
+}
+
+func NewBatchService(parser ParserService, cfg *BatchConfig) *batchService {
+	return &batchService{parser: parser, cfg: cfg}
+}
+
+func (s *batchService) SearchBatch(ctx context.Context, queries []domain.BatchQuery, fn func(domain.BatchResult)) error {
+	if err := s.validateBatch(queries); err != nil {
+		return err
+	}
+
+	if s.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.Timeout)
+		defer cancel()
+	}
+
+	results := make(chan domain.BatchResult)
+	go s.runQueries(ctx, queries, results)
+
+	for res := range results {
+		fn(res)
+	}
+
+	if ctx.Err() != nil && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return domain.ErrClientClosedRequest
+	}
+
+	return nil
+}
+
+// runQueries runs at most Parallelism queries at the same time and sends their results, the queries
+// that aren't started before ctx is done get its error. The results are closed after the last one.
+func (s *batchService) runQueries(ctx context.Context, queries []domain.BatchQuery, results chan<- domain.BatchResult) {
+	slots := make(chan struct{}, max(s.cfg.Parallelism, 1))
+	wg := &sync.WaitGroup{}
+
+	for _, q := range queries {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			results <- domain.BatchResult{Key: q.Key, Err: mapContextError(ctx.Err())}
+			continue
+		}
+
+		wg.Add(1)
+		go func(q domain.BatchQuery) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			queryCtx, cancel := s.queryContext(ctx)
+			defer cancel()
+
+			res, err := s.parser.GetProductsList(queryCtx, q.Query)
+			results <- domain.BatchResult{Key: q.Key, Result: res, Err: err}
+		}(q)
+	}
+
+	wg.Wait()
+	close(results)
+}
+
+func (s *batchService) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.cfg.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.cfg.QueryTimeout)
+}
+
+func (s *batchService) validateBatch(queries []domain.BatchQuery) error {
+	if len(queries) == 0 {
+		return domain.ErrEmptyBatch
+	}
+	if s.cfg.MaxQueries > 0 && len(queries) > s.cfg.MaxQueries {
+		return domain.ErrBatchTooLarge
+	}
+
+	keys := make(map[string]struct{}, len(queries))
+	for _, q := range queries {
+		if _, ok := keys[q.Key]; ok {
+			return domain.ErrDuplicateBatchKey
+		}
+		keys[q.Key] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestBatchService_SearchBatch(t *testing.T) {
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+
+	t.Run("bounded parallelism", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewBatchService(parserSvc, &usecase.BatchConfig{Parallelism: 2, QueryTimeout: time.Second})
+
+		var mu sync.Mutex
+		running, maxRunning := 0, 0
+		parserSvc.On("GetProductsList", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}).Return(domain.SearchResult{Products: prods}, nil).Times(5)
+
+		queries := []domain.BatchQuery{
+			{Key: "a", Query: domain.SearchQuery{Name: "a"}},
+			{Key: "b", Query: domain.SearchQuery{Name: "b"}},
+			{Key: "c", Query: domain.SearchQuery{Name: "c"}},
+			{Key: "d", Query: domain.SearchQuery{Name: "d"}},
+			{Key: "e", Query: domain.SearchQuery{Name: "e"}},
+		}
+		results := make(map[string]domain.BatchResult)
+		err := svc.SearchBatch(context.Background(), queries, func(res domain.BatchResult) {
+			results[res.Key] = res
+		})
+		assert.NoError(t, err)
+		assert.Len(t, results, 5)
+		assert.Equal(t, prods, results["c"].Result.Products)
+		assert.Equal(t, 2, maxRunning)
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("failed query", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewBatchService(parserSvc, &usecase.BatchConfig{Parallelism: 2})
+
+		ozonQuery := domain.SearchQuery{Name: "a", Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}}
+		parserSvc.On("GetProductsList", mock.Anything, ozonQuery).Return(domain.SearchResult{Products: prods}, nil).Once()
+		parserSvc.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: "b"}).Return(domain.SearchResult{}, domain.ErrGatewayTimeout).Once()
+
+		results := make(map[string]domain.BatchResult)
+		err := svc.SearchBatch(context.Background(), []domain.BatchQuery{{Key: "a", Query: ozonQuery}, {Key: "b", Query: domain.SearchQuery{Name: "b"}}}, func(res domain.BatchResult) {
+			results[res.Key] = res
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, results["a"].Err)
+		assert.Equal(t, prods, results["a"].Result.Products)
+		assert.ErrorIs(t, results["b"].Err, domain.ErrGatewayTimeout)
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("batch timeout", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewBatchService(parserSvc, &usecase.BatchConfig{Parallelism: 1, Timeout: 20 * time.Millisecond})
+
+		parserSvc.On("GetProductsList", mock.Anything, domain.SearchQuery{Name: "a"}).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).Return(domain.SearchResult{}, domain.ErrGatewayTimeout).Once()
+
+		results := make(map[string]domain.BatchResult)
+		err := svc.SearchBatch(context.Background(), []domain.BatchQuery{{Key: "a", Query: domain.SearchQuery{Name: "a"}}, {Key: "b", Query: domain.SearchQuery{Name: "b"}}}, func(res domain.BatchResult) {
+			results[res.Key] = res
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, results["a"].Err, domain.ErrGatewayTimeout)
+		assert.ErrorIs(t, results["b"].Err, domain.ErrGatewayTimeout)
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		svc := usecase.NewBatchService(&mocks.ParserServiceMock{}, &usecase.BatchConfig{Parallelism: 1, MaxQueries: 2})
+		fn := func(domain.BatchResult) { t.Fail() }
+
+		err := svc.SearchBatch(context.Background(), nil, fn)
+		assert.ErrorIs(t, err, domain.ErrEmptyBatch)
+
+		err = svc.SearchBatch(context.Background(), []domain.BatchQuery{{Key: "a"}, {Key: "b"}, {Key: "c"}}, fn)
+		assert.ErrorIs(t, err, domain.ErrBatchTooLarge)
+
+		err = svc.SearchBatch(context.Background(), []domain.BatchQuery{{Key: "a"}, {Key: "a"}}, fn)
+		assert.ErrorIs(t, err, domain.ErrDuplicateBatchKey)
+	})
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+// BreakerPolicy describes when the circuit of a source opens and how long it stays open.
+type BreakerPolicy struct {
+	// FailureThreshold is the number of failures in a row that opens the circuit, the breaker is disabled if it's less than 1.
+	FailureThreshold int
+	CoolDown         time.Duration
+}
+
+// circuitBreaker stops calling a failing source, so the requests don't wait for its timeouts.
+// After the cool-down a single trial call is passed, its result closes or opens the circuit again.
+type circuitBreaker struct {
+	policy BreakerPolicy
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    domain.CircuitState
+	failures int
+	opens    int
+	lastErr  error
+	openedAt time.Time
+	// trial is true while the trial call of the half-open circuit is running.
+	trial bool
+}
+
+func newCircuitBreaker(policy BreakerPolicy) *circuitBreaker {
+	return &circuitBreaker{policy: policy, now: time.Now, state: domain.CircuitClosed}
+}
+
+// allow reports whether the source can be called, otherwise it returns the time left until the trial call.
+func (b *circuitBreaker) allow() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == domain.CircuitOpen {
+		retryAt := b.openedAt.Add(b.policy.CoolDown)
+		if wait := retryAt.Sub(b.now()); wait > 0 {
+			return wait, false
+		}
+		b.state = domain.CircuitHalfOpen
+	}
+
+	if b.state == domain.CircuitHalfOpen {
+		if b.trial {
+			return b.policy.CoolDown, false
+		}
+		b.trial = true
+	}
+
+	return 0, true
+}
+
+// record counts the result of the call allowed by allow.
+func (b *circuitBreaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	halfOpen := b.state == domain.CircuitHalfOpen
+	b.trial = false
+
+	if err == nil {
+		b.state = domain.CircuitClosed
+		b.failures = 0
+		return
+	}
+	if !sourceFailure(ctx, err) {
+		// The call tells nothing about the source, the next call is the trial one again
+		return
+	}
+
+	b.failures++
+	b.lastErr = err
+	if halfOpen || b.failures >= b.policy.FailureThreshold {
+		b.state = domain.CircuitOpen
+		b.openedAt = b.now()
+		b.opens++
+	}
+}
+
+func (b *circuitBreaker) status() domain.SourceStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := domain.SourceStatus{
+		State:    b.state,
+		Failures: b.failures,
+		Opens:    b.opens,
+		OpenedAt: b.openedAt,
+	}
+	if b.lastErr != nil {
+		res.LastError = b.lastErr.Error()
+	}
+	if b.state == domain.CircuitOpen {
+		res.RetryAt = b.openedAt.Add(b.policy.CoolDown)
+	}
+
+	return res
+}
+
+// sourceFailure reports whether the error is a failure of the source. The canceled calls, the invalid queries,
+// the missing products and the calls rejected or timed out by the admission limits aren't the failures of the source,
+// the timeouts of the source calls are, because a slow source is as useless as a failing one.
+func sourceFailure(ctx context.Context, err error) bool {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return false
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrInvalidCategory),
+		errors.Is(err, repository.ErrProductNotFound),
+		errors.Is(err, repository.ErrClientClosedRequest),
+		errors.Is(err, domain.ErrTooManyRequests),
+		errors.Is(err, errQueueLeft),
+		errors.Is(err, context.Canceled):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,153 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestParserService_CircuitBreaker(t *testing.T) {
+	query := domain.CategoryQuery{Marketplace: domain.MarketplaceOzon, Category: "catalog/elektronika", PriceTo: 500.0}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+	errLayout := errors.New("wait products: context deadline exceeded")
+	cfg := &usecase.SearchConfig{
+		Breakers: map[domain.Marketplace]usecase.BreakerPolicy{
+			domain.MarketplaceOzon: {FailureThreshold: 2, CoolDown: 50 * time.Millisecond},
+		},
+	}
+
+	t.Run("open and close", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, cfg)
+
+		searchRepo.On("GetCategoryProducts", mock.Anything, query).Return(nil, errLayout).Twice()
+		for range 2 {
+			_, err := searchSrv.GetCategoryProducts(context.Background(), query)
+			assert.ErrorIs(t, err, errLayout)
+		}
+
+		// The open circuit fails fast without calling the source
+		_, err := searchSrv.GetCategoryProducts(context.Background(), query)
+		assert.ErrorIs(t, err, domain.ErrSourceUnavailable)
+		var unavailableErr *domain.SourceUnavailableError
+		assert.ErrorAs(t, err, &unavailableErr)
+		assert.Equal(t, domain.MarketplaceOzon, unavailableErr.Marketplace)
+		assert.Positive(t, unavailableErr.RetryAfter)
+
+		status := searchSrv.GetSourcesStatus(context.Background())
+		assert.Len(t, status, 1)
+		assert.Equal(t, domain.MarketplaceOzon, status[0].Marketplace)
+		assert.Equal(t, domain.CircuitOpen, status[0].State)
+		assert.Equal(t, 2, status[0].Failures)
+		assert.Equal(t, 1, status[0].Opens)
+		assert.Equal(t, errLayout.Error(), status[0].LastError)
+		assert.False(t, status[0].RetryAt.IsZero())
+
+		// The trial call after the cool-down closes the circuit
+		time.Sleep(60 * time.Millisecond)
+		searchRepo.On("GetCategoryProducts", mock.Anything, query).Return(prods, nil).Once()
+		res, err := searchSrv.GetCategoryProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res)
+
+		status = searchSrv.GetSourcesStatus(context.Background())
+		assert.Equal(t, domain.CircuitClosed, status[0].State)
+		assert.Zero(t, status[0].Failures)
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("failed trial call", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, cfg)
+
+		searchRepo.On("GetCategoryProducts", mock.Anything, query).Return(nil, errLayout).Times(3)
+		for range 2 {
+			_, err := searchSrv.GetCategoryProducts(context.Background(), query)
+			assert.ErrorIs(t, err, errLayout)
+		}
+
+		time.Sleep(60 * time.Millisecond)
+		_, err := searchSrv.GetCategoryProducts(context.Background(), query)
+		assert.ErrorIs(t, err, errLayout)
+
+		// A single failure of the trial call opens the circuit again
+		_, err = searchSrv.GetCategoryProducts(context.Background(), query)
+		assert.ErrorIs(t, err, domain.ErrSourceUnavailable)
+		status := searchSrv.GetSourcesStatus(context.Background())
+		assert.Equal(t, domain.CircuitOpen, status[0].State)
+		assert.Equal(t, 2, status[0].Opens)
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, cfg)
+
+		searchRepo.On("GetCategoryProducts", mock.Anything, query).Return(nil, repository.ErrInvalidCategory).Times(3)
+		for range 3 {
+			_, err := searchSrv.GetCategoryProducts(context.Background(), query)
+			assert.ErrorIs(t, err, domain.ErrInvalidCategory)
+		}
+
+		status := searchSrv.GetSourcesStatus(context.Background())
+		assert.Equal(t, domain.CircuitClosed, status[0].State)
+		assert.Zero(t, status[0].Failures)
+
+		searchRepo.AssertExpectations(t)
+	})
+	t.Run("admission queue saturation", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		sources := usecase.WithLimits([]repository.SearchRepository{searchRepo}, map[domain.Marketplace]usecase.LimitPolicy{
+			domain.MarketplaceOzon: {MaxConcurrent: 1, MaxQueue: 1, RetryAfter: time.Second},
+		}, loggerMock)
+		searchSrv := usecase.NewSearchService(sources, cfg)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		searchRepo.On("GetCategoryProducts", mock.Anything, query).Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+		}).Return(prods, nil).Once()
+		loggerMock.On("Warn", "admission queue left", mock.Anything).Times(3)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = searchSrv.GetCategoryProducts(context.Background(), query)
+		}()
+		<-started
+
+		// The calls time out in the queue more times than the failure threshold
+		for range 3 {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			_, err := searchSrv.GetCategoryProducts(ctx, query)
+			cancel()
+			assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+		}
+
+		status := searchSrv.GetSourcesStatus(context.Background())
+		assert.Equal(t, domain.CircuitClosed, status[0].State)
+		assert.Zero(t, status[0].Failures)
+
+		close(release)
+		<-done
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+)
+
+type BrowserService interface {
+	GetNodes(ctx context.Context) []domain.BrowserNode
+	ResetSessions(ctx context.Context, marketplace domain.Marketplace) error
+}
+
+type browserService struct {
+	browser  repository.BrowserRepository
+	sessions repository.SessionRepository
+}
+
+func NewBrowserService(browser repository.BrowserRepository, sessions repository.SessionRepository) *browserService {
+	return &browserService{browser: browser, sessions: sessions}
+}
+
+func (s *browserService) GetNodes(ctx context.Context) []domain.BrowserNode {
+	nodes := s.browser.Nodes()
+
+	res := make([]domain.BrowserNode, 0, len(nodes))
+	for _, n := range nodes {
+		res = append(res, domain.BrowserNode{
+			URL:         n.URL,
+			Healthy:     n.Healthy,
+			Connections: n.Connections,
+			Pages:       n.Pages,
+			Failures:    n.Failures,
+			LastError:   n.LastError,
+			CheckedAt:   n.CheckedAt,
+		})
+	}
+
+	return res
+}
+
+// ResetSessions removes the stored sessions of the marketplace, all sessions if the marketplace is empty.
+func (s *browserService) ResetSessions(ctx context.Context, marketplace domain.Marketplace) error {
+	switch marketplace {
+	case "", domain.MarketplaceWildberries, domain.MarketplaceOzon:
+	default:
+		return domain.ErrUnknownMarketplace
+	}
+
+	return s.sessions.Delete(ctx, marketplace)
+}
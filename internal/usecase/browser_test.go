@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestBrowserService_GetNodes(t *testing.T) {
+	browserMock := &mocks.BrowserRepositoryMock{}
+	svc := usecase.NewBrowserService(browserMock, nil)
+
+	checkedAt := time.Now()
+	browserMock.On("Nodes").Return([]repository.BrowserNode{
+		{URL: "ws://chromium-1:7317", Healthy: true, Connections: 2, Pages: 1, CheckedAt: checkedAt},
+		{URL: "ws://chromium-2:7317", Failures: 1, LastError: "connection refused", CheckedAt: checkedAt},
+	}).Once()
+
+	nodes := svc.GetNodes(context.Background())
+	assert.Equal(t, []domain.BrowserNode{
+		{URL: "ws://chromium-1:7317", Healthy: true, Connections: 2, Pages: 1, CheckedAt: checkedAt},
+		{URL: "ws://chromium-2:7317", Failures: 1, LastError: "connection refused", CheckedAt: checkedAt},
+	}, nodes)
+
+	browserMock.AssertExpectations(t)
+}
+
+func TestBrowserService_ResetSessions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		marketplace domain.Marketplace
+		expErr      error
+	}{
+		{name: "marketplace", marketplace: domain.MarketplaceOzon},
+		{name: "all", marketplace: ""},
+		{name: "unknown marketplace", marketplace: "amazon", expErr: domain.ErrUnknownMarketplace},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sessionsMock := &mocks.SessionRepositoryMock{}
+			svc := usecase.NewBrowserService(nil, sessionsMock)
+
+			if tc.expErr == nil {
+				sessionsMock.On("Delete", mock.Anything, tc.marketplace).Return(nil).Once()
+			}
+
+			err := svc.ResetSessions(context.Background(), tc.marketplace)
+			assert.ErrorIs(t, err, tc.expErr)
+
+			sessionsMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+// CacheConfig describes the cache of the results of identical searches.
+type CacheConfig struct {
+	TTL        time.Duration
+	MaxEntries int
+	// CacheErrors enables caching of the failed searches for ErrorTTL.
+	CacheErrors bool
+	ErrorTTL    time.Duration
+}
+
+// cachedParserService returns the cached result of an identical search instead of searching the marketplaces again.
+// The other methods of the service aren't cached.
+type cachedParserService struct {
+	ParserService
+	cfg   *CacheConfig
+	cache *lruCache[searchKey, cachedSearch]
+}
+
+// searchKey is the search query without the options that don't change the result.
+type searchKey struct {
+	name        string
+	priceFrom   float64
+	priceTo     float64
+	inStockOnly bool
+	region      string
+	// marketplaces are the sorted marketplaces of the query, empty for all of them.
+	marketplaces string
+}
+
+type cachedSearch struct {
+	result domain.SearchResult
+	err    error
+}
+
+// NewCachedParserService wraps the parser service into the cache of the search results.
+func NewCachedParserService(srv ParserService, cfg *CacheConfig) *cachedParserService {
+	return &cachedParserService{ParserService: srv, cfg: cfg, cache: newLRUCache[searchKey, cachedSearch](cfg.MaxEntries)}
+}
+
+// GetProductsList returns the cached result of the identical search if it's not expired, otherwise it searches
+// the marketplaces and caches the result. The debug and no-cache searches always search the marketplaces,
+// the result of a no-cache search replaces the cached one.
+func (s *cachedParserService) GetProductsList(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error) {
+	if query.Debug {
+		return s.ParserService.GetProductsList(ctx, query)
+	}
+
+	key := newSearchKey(query)
+	if !query.NoCache {
+		if cached, createdAt, ok := s.cache.Get(key); ok {
+			if cached.err != nil {
+				return domain.SearchResult{}, cached.err
+			}
+			res := cached.result
+			res.CachedAt = createdAt
+			return res, nil
+		}
+	}
+
+	res, err := s.ParserService.GetProductsList(ctx, query)
+	switch {
+	case err == nil:
+		// The next search may find the products of the timed out marketplaces, so the partial results aren't cached
+		if len(res.TimedOut) == 0 {
+			s.cache.Add(key, cachedSearch{result: res}, s.cfg.TTL)
+		}
+	case s.cfg.CacheErrors && cacheableError(err):
+		s.cache.Add(key, cachedSearch{err: err}, s.cfg.ErrorTTL)
+	}
+
+	return res, err
+}
+
+func newSearchKey(query domain.SearchQuery) searchKey {
+	key := searchKey{
+		name:        strings.ToLower(strings.TrimSpace(query.Name)),
+		priceFrom:   query.PriceFrom,
+		priceTo:     query.PriceTo,
+		inStockOnly: query.InStockOnly,
+		region:      strings.ToLower(strings.TrimSpace(query.Region)),
+	}
+	if len(query.Marketplaces) > 0 {
+		marketplaces := make([]string, 0, len(query.Marketplaces))
+		for _, m := range query.Marketplaces {
+			marketplaces = append(marketplaces, string(m))
+		}
+		slices.Sort(marketplaces)
+		key.marketplaces = strings.Join(slices.Compact(marketplaces), ",")
+	}
+
+	return key
+}
+
+// cacheableError reports whether the failed search would fail again, the closed requests tell nothing about the search.
+func cacheableError(err error) bool {
+	return !errors.Is(err, domain.ErrClientClosedRequest)
+}
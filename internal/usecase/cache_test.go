@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestCachedParserService_GetProductsList(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0, Region: "moscow"}
+	result := domain.SearchResult{Products: []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}}
+	cfg := &usecase.CacheConfig{TTL: time.Minute, MaxEntries: 10, ErrorTTL: time.Minute}
+
+	t.Run("hit", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, cfg)
+
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(result, nil).Once()
+
+		res, err := cached.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, result, res)
+
+		// The letter case and spaces of the name don't change the search
+		res, err = cached.GetProductsList(context.Background(), domain.SearchQuery{Name: " Prod ", PriceTo: 500.0, Region: "Moscow"})
+		assert.NoError(t, err)
+		assert.Equal(t, result.Products, res.Products)
+		assert.False(t, res.CachedAt.IsZero())
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("no cache", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, cfg)
+
+		noCacheQuery := query
+		noCacheQuery.NoCache = true
+		debugQuery := query
+		debugQuery.Debug = true
+
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(result, nil).Once()
+		parserSrvMock.On("GetProductsList", mock.Anything, noCacheQuery).Return(result, nil).Once()
+		parserSrvMock.On("GetProductsList", mock.Anything, debugQuery).Return(result, nil).Once()
+
+		for _, q := range []domain.SearchQuery{query, noCacheQuery, debugQuery} {
+			res, err := cached.GetProductsList(context.Background(), q)
+			assert.NoError(t, err)
+			assert.True(t, res.CachedAt.IsZero())
+		}
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, &usecase.CacheConfig{TTL: 20 * time.Millisecond, MaxEntries: 10})
+
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(result, nil).Twice()
+
+		_, err := cached.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		time.Sleep(30 * time.Millisecond)
+		res, err := cached.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		assert.True(t, res.CachedAt.IsZero())
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("least recently used is evicted", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, &usecase.CacheConfig{TTL: time.Minute, MaxEntries: 2})
+
+		first := domain.SearchQuery{Name: "first"}
+		second := domain.SearchQuery{Name: "second"}
+		third := domain.SearchQuery{Name: "third"}
+		parserSrvMock.On("GetProductsList", mock.Anything, first).Return(result, nil).Once()
+		parserSrvMock.On("GetProductsList", mock.Anything, second).Return(result, nil).Twice()
+		parserSrvMock.On("GetProductsList", mock.Anything, third).Return(result, nil).Once()
+
+		for _, q := range []domain.SearchQuery{first, second, first, third, first, second} {
+			_, err := cached.GetProductsList(context.Background(), q)
+			assert.NoError(t, err)
+		}
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("partial result", func(t *testing.T) {
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, cfg)
+
+		partial := domain.SearchResult{Products: result.Products, TimedOut: []domain.Marketplace{domain.MarketplaceOzon}}
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(partial, nil).Twice()
+
+		for range 2 {
+			res, err := cached.GetProductsList(context.Background(), query)
+			assert.NoError(t, err)
+			assert.Equal(t, partial, res)
+		}
+
+		parserSrvMock.AssertExpectations(t)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		errSearch := errors.New("search")
+
+		parserSrvMock := &mocks.ParserServiceMock{}
+		cached := usecase.NewCachedParserService(parserSrvMock, cfg)
+
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(domain.SearchResult{}, errSearch).Twice()
+		for range 2 {
+			_, err := cached.GetProductsList(context.Background(), query)
+			assert.ErrorIs(t, err, errSearch)
+		}
+		parserSrvMock.AssertExpectations(t)
+
+		negativeCfg := *cfg
+		negativeCfg.CacheErrors = true
+		parserSrvMock = &mocks.ParserServiceMock{}
+		cached = usecase.NewCachedParserService(parserSrvMock, &negativeCfg)
+
+		parserSrvMock.On("GetProductsList", mock.Anything, query).Return(domain.SearchResult{}, errSearch).Once()
+		for range 2 {
+			_, err := cached.GetProductsList(context.Background(), query)
+			assert.ErrorIs(t, err, errSearch)
+		}
+		parserSrvMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/config"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+)
+
+// SearchConfig describes the time budgets of the marketplaces within a search request.
+type SearchConfig struct {
+	// Timeouts are the budgets of the marketplaces, a marketplace without a budget is limited by the request deadline only.
+	Timeouts map[domain.Marketplace]time.Duration
+	// Reserve is the part of the request deadline left for encoding of the response.
+	Reserve time.Duration
+	// Retries are the retry policies of the marketplaces.
+	Retries map[domain.Marketplace]RetryPolicy
+	// Breakers are the circuit breaker policies of the marketplaces.
+	Breakers map[domain.Marketplace]BreakerPolicy
+	// Limits are the concurrency limits of the marketplaces.
+	Limits map[domain.Marketplace]LimitPolicy
+}
+
+func NewSearchConfig(cfg *config.Config) *SearchConfig {
+	res := &SearchConfig{
+		Timeouts: make(map[domain.Marketplace]time.Duration),
+		Reserve:  cfg.Server.ResponseReserve,
+		Retries:  make(map[domain.Marketplace]RetryPolicy),
+		Breakers: make(map[domain.Marketplace]BreakerPolicy),
+		Limits:   make(map[domain.Marketplace]LimitPolicy),
+	}
+	if cfg.Server.WbCfg != nil {
+		if cfg.Server.WbCfg.Timeout > 0 {
+			res.Timeouts[domain.MarketplaceWildberries] = cfg.Server.WbCfg.Timeout
+		}
+		res.Retries[domain.MarketplaceWildberries] = newRetryPolicy(cfg.Server.WbCfg.Retry)
+		res.Breakers[domain.MarketplaceWildberries] = newBreakerPolicy(cfg.Server.WbCfg.Breaker)
+		res.Limits[domain.MarketplaceWildberries] = newLimitPolicy(cfg.Server.WbCfg.Limit)
+	}
+	if cfg.Server.OzonCfg != nil {
+		if cfg.Server.OzonCfg.Timeout > 0 {
+			res.Timeouts[domain.MarketplaceOzon] = cfg.Server.OzonCfg.Timeout
+		}
+		res.Retries[domain.MarketplaceOzon] = newRetryPolicy(cfg.Server.OzonCfg.Retry)
+		res.Breakers[domain.MarketplaceOzon] = newBreakerPolicy(cfg.Server.OzonCfg.Breaker)
+		res.Limits[domain.MarketplaceOzon] = newLimitPolicy(cfg.Server.OzonCfg.Limit)
+	}
+
+	return res
+}
+
+func newRetryPolicy(cfg config.RetryConfig) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		BaseDelay:   cfg.BaseDelay,
+		MaxDelay:    cfg.MaxDelay,
+	}
+}
+
+func newBreakerPolicy(cfg config.BreakerConfig) BreakerPolicy {
+	return BreakerPolicy{
+		FailureThreshold: cfg.FailureThreshold,
+		CoolDown:         cfg.CoolDown,
+	}
+}
+
+func newLimitPolicy(cfg config.LimitConfig) LimitPolicy {
+	return LimitPolicy{
+		MaxConcurrent: cfg.MaxConcurrent,
+		MaxQueue:      cfg.MaxQueue,
+		RetryAfter:    cfg.RetryAfter,
+	}
+}
+
+// NewAdmissionPolicy returns the global concurrency limit of the requests.
+func NewAdmissionPolicy(cfg *config.Config) LimitPolicy {
+	return LimitPolicy{
+		MaxConcurrent: cfg.Admission.MaxConcurrent,
+		MaxQueue:      cfg.Admission.MaxQueue,
+		RetryAfter:    cfg.Admission.RetryAfter,
+	}
+}
+
+func NewCacheConfig(cfg *config.Config) *CacheConfig {
+	return &CacheConfig{
+		TTL:         cfg.Cache.TTL,
+		MaxEntries:  cfg.Cache.MaxEntries,
+		CacheErrors: cfg.Cache.CacheErrors,
+		ErrorTTL:    cfg.Cache.ErrorTTL,
+	}
+}
+
+func NewJobConfig(cfg *config.Config) *JobConfig {
+	return &JobConfig{
+		Workers:    cfg.Jobs.Workers,
+		QueueSize:  cfg.Jobs.QueueSize,
+		RetryAfter: cfg.Jobs.RetryAfter,
+		Timeout:    cfg.Jobs.Timeout,
+	}
+}
+
+func NewBatchConfig(cfg *config.Config) *BatchConfig {
+	return &BatchConfig{
+		Parallelism:  cfg.Batch.Parallelism,
+		MaxQueries:   cfg.Batch.MaxQueries,
+		QueryTimeout: cfg.Batch.QueryTimeout,
+		Timeout:      cfg.Batch.Timeout,
+	}
+}
+
+func NewHistoryConfig(cfg *config.Config) *HistoryConfig {
+	return &HistoryConfig{
+		Retention:     cfg.History.Retention,
+		PruneInterval: cfg.History.PruneInterval,
+		BufferSize:    cfg.History.BufferSize,
+	}
+}
+
+func NewWatchConfig(cfg *config.Config) *WatchConfig {
+	return &WatchConfig{
+		Tick:            cfg.Watches.Tick,
+		Workers:         cfg.Watches.Workers,
+		DefaultInterval: cfg.Watches.DefaultInterval,
+		MinInterval:     cfg.Watches.MinInterval,
+		CheckTimeout:    cfg.Watches.CheckTimeout,
+		Delivery: RetryPolicy{
+			MaxAttempts: cfg.Watches.Webhook.MaxAttempts,
+			BaseDelay:   cfg.Watches.Webhook.BaseDelay,
+			MaxDelay:    cfg.Watches.Webhook.MaxDelay,
+		},
+		LogSize:              cfg.Watches.Webhook.LogSize,
+		AllowPrivateNetworks: cfg.Watches.Webhook.AllowPrivateNetworks,
+	}
+}
+
+func NewSchedulerConfig(cfg *config.Config) *SchedulerConfig {
+	schedules := make([]domain.Schedule, 0, len(cfg.Scheduler.Schedules))
+	for _, sc := range cfg.Scheduler.Schedules {
+		schedule := domain.Schedule{
+			ID:   sc.ID,
+			Cron: sc.Cron,
+			Query: domain.SearchQuery{
+				Name:        sc.Query,
+				PriceFrom:   sc.PriceFrom,
+				PriceTo:     sc.PriceTo,
+				InStockOnly: sc.InStockOnly,
+				Region:      sc.Region,
+			},
+		}
+		for _, m := range sc.Marketplaces {
+			schedule.Query.Marketplaces = append(schedule.Query.Marketplaces, domain.Marketplace(m))
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return &SchedulerConfig{
+		Tick:          cfg.Scheduler.Tick,
+		MaxConcurrent: cfg.Scheduler.MaxConcurrent,
+		Jitter:        cfg.Scheduler.Jitter,
+		Timeout:       cfg.Scheduler.Timeout,
+		MinInterval:   cfg.Scheduler.MinInterval,
+		Schedules:     schedules,
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup runs a single call per key at a time, the concurrent callers with the same key share its result.
+// The call doesn't depend on the context of any single caller: it's canceled only when every caller has left.
+type flightGroup[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*flightCall[V]
+}
+
+type flightCall[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+	// waiters is the number of the callers waiting for the result.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newFlightGroup[K comparable, V any]() *flightGroup[K, V] {
+	return &flightGroup[K, V]{calls: make(map[K]*flightCall[V])}
+}
+
+// Do runs fn or joins the running call with the same key and waits for its result until ctx is done.
+// fn gets a context with the values of ctx that is canceled after the last caller leaves.
+func (g *flightGroup[K, V]) Do(ctx context.Context, key K, fn func(ctx context.Context) (V, error)) (V, error) {
+	g.mu.Lock()
+	c, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		c = &flightCall[V]{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = c
+
+		go func() {
+			c.val, c.err = fn(callCtx)
+			g.forget(key, c)
+			cancel()
+			close(c.done)
+		}()
+	}
+	c.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.val, c.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		c.waiters--
+		if c.waiters == 0 {
+			// Nobody waits for the result, the next caller starts a new call
+			c.cancel()
+			g.forgetLocked(key, c)
+		}
+		g.mu.Unlock()
+
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (g *flightGroup[K, V]) forget(key K, c *flightCall[V]) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.forgetLocked(key, c)
+}
+
+func (g *flightGroup[K, V]) forgetLocked(key K, c *flightCall[V]) {
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+}
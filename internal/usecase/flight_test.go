@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestParserService_GetProductsListCoalescing(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+
+	t.Run("identical searches", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		release := make(chan struct{})
+		searchRepo.On("GetAllProducts", mock.Anything, query).Run(func(mock.Arguments) { <-release }).Return(prods, nil).Once()
+
+		wg := &sync.WaitGroup{}
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := searchSrv.GetProductsList(context.Background(), query)
+				assert.NoError(t, err)
+				assert.Equal(t, prods, res.Products)
+			}()
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("one caller leaves", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		release := make(chan struct{})
+		searchCtxCh := make(chan context.Context, 1)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Run(func(args mock.Arguments) {
+			searchCtxCh <- args.Get(0).(context.Context)
+			<-release
+		}).Return(prods, nil).Once()
+
+		leaving, leave := context.WithCancel(context.Background())
+		leftCh := make(chan error, 1)
+		go func() {
+			_, err := searchSrv.GetProductsList(leaving, query)
+			leftCh <- err
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		resCh := make(chan domain.SearchResult, 1)
+		go func() {
+			res, err := searchSrv.GetProductsList(context.Background(), query)
+			assert.NoError(t, err)
+			resCh <- res
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		leave()
+		assert.ErrorIs(t, <-leftCh, domain.ErrClientClosedRequest)
+		// The other caller still waits, so the shared search goes on
+		assert.NoError(t, (<-searchCtxCh).Err())
+
+		close(release)
+		assert.Equal(t, prods, (<-resCh).Products)
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("every caller leaves", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		canceled := make(chan struct{})
+		searchRepo.On("GetAllProducts", mock.Anything, query).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+			close(canceled)
+		}).Return(nil, repository.ErrClientClosedRequest).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+		_, err := searchSrv.GetProductsList(ctx, query)
+		assert.ErrorIs(t, err, domain.ErrClientClosedRequest)
+
+		select {
+		case <-canceled:
+		case <-time.After(time.Second):
+			t.Error("shared search isn't canceled")
+		}
+
+		searchRepo.AssertExpectations(t)
+	})
+}
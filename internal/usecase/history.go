@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+type HistoryService interface {
+	// GetPriceHistory returns the history of the product ordered by time, downsampled to the interval of the query if it's set.
+	GetPriceHistory(ctx context.Context, query domain.HistoryQuery) ([]domain.PricePoint, error)
+}
+
+type historyService struct {
+	history repository.HistoryRepository
+}
+
+func NewHistoryService(history repository.HistoryRepository) *historyService {
+	return &historyService{history: history}
+}
+
+func (s *historyService) GetPriceHistory(ctx context.Context, query domain.HistoryQuery) ([]domain.PricePoint, error) {
+	query.ProductID = strings.TrimSpace(query.ProductID)
+	if query.ProductID == "" {
+		return nil, domain.ErrEmptyProductID
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && query.From.After(query.To) {
+		return nil, domain.ErrInvalidHistoryRange
+	}
+	if query.Interval < 0 {
+		return nil, domain.ErrInvalidInterval
+	}
+
+	observations, err := s.history.Observations(ctx, query.Marketplace, query.ProductID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return downsample(observations, query.Interval), nil
+}
+
+// downsample aggregates the observations ordered by time into the buckets of the interval,
+// every observation is a point of its own if the interval is 0.
+func downsample(observations []domain.PriceObservation, interval time.Duration) []domain.PricePoint {
+	res := make([]domain.PricePoint, 0, len(observations))
+	var sum float64
+	for _, o := range observations {
+		bucket := o.ObservedAt
+		if interval > 0 {
+			bucket = o.ObservedAt.Truncate(interval)
+		}
+
+		if n := len(res); interval > 0 && n > 0 && res[n-1].Time.Equal(bucket) {
+			p := &res[n-1]
+			sum += o.Price
+			p.Samples++
+			p.Price = sum / float64(p.Samples)
+			p.MinPrice = min(p.MinPrice, o.Price)
+			p.MaxPrice = max(p.MaxPrice, o.Price)
+			p.Rating = o.Rating
+			p.ReviewsCount = o.ReviewsCount
+			p.InStock = o.InStock
+			continue
+		}
+
+		sum = o.Price
+		res = append(res, domain.PricePoint{
+			Time:         bucket,
+			Price:        o.Price,
+			MinPrice:     o.Price,
+			MaxPrice:     o.Price,
+			Rating:       o.Rating,
+			ReviewsCount: o.ReviewsCount,
+			InStock:      o.InStock,
+			Samples:      1,
+		})
+	}
+
+	return res
+}
+
+// HistoryConfig describes the recording of the price history.
+type HistoryConfig struct {
+	// Retention is how long the observations are kept, they are kept forever if it's 0.
+	Retention     time.Duration
+	PruneInterval time.Duration
+	// BufferSize is the number of the searches whose observations wait to be recorded.
+	BufferSize int
+}
+
+// historyRecorder records the observations in the background, so the searches don't wait for the database.
+// The observations wait in a bounded buffer, the ones of the searches that don't fit into it are dropped.
+// It also prunes the observations older than the retention.
+type historyRecorder struct {
+	history repository.HistoryRepository
+	cfg     *HistoryConfig
+	logger  logger.Logger
+	queue   chan []domain.PriceObservation
+	done    chan struct{}
+
+	// mu guards closed, the queue is only sent to under the read lock, so it isn't sent to after it's closed.
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewHistoryRecorder(history repository.HistoryRepository, cfg *HistoryConfig, logger logger.Logger) *historyRecorder {
+	return &historyRecorder{
+		history: history,
+		cfg:     cfg,
+		logger:  logger,
+		queue:   make(chan []domain.PriceObservation, max(cfg.BufferSize, 1)),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run records the queued observations and prunes the old ones every prune interval until ctx is done or Close is called.
+// The observations queued by then are recorded before it returns.
+func (r *historyRecorder) Run(ctx context.Context) {
+	defer close(r.done)
+
+	var prune <-chan time.Time
+	if r.cfg.Retention > 0 {
+		r.prune(ctx)
+		ticker := time.NewTicker(r.cfg.PruneInterval)
+		defer ticker.Stop()
+		prune = ticker.C
+	}
+
+	for {
+		select {
+		case observations, ok := <-r.queue:
+			if !ok {
+				return
+			}
+			r.write(ctx, observations)
+		case <-prune:
+			r.prune(ctx)
+		case <-ctx.Done():
+			for {
+				select {
+				case observations, ok := <-r.queue:
+					if !ok {
+						return
+					}
+					r.write(ctx, observations)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close stops queuing the observations and waits for Run to record the queued ones.
+func (r *historyRecorder) Close() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+
+	<-r.done
+}
+
+// Record queues the observations, they are dropped if the buffer is full or the recorder is closed.
+func (r *historyRecorder) Record(observations []domain.PriceObservation) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- observations:
+	default:
+		r.logger.Warn("price history buffer is full", "dropped", len(observations))
+	}
+}
+
+// write saves the observations, a failure is logged only. The searches are done already, so ctx isn't waited for.
+func (r *historyRecorder) write(ctx context.Context, observations []domain.PriceObservation) {
+	if err := r.history.Record(context.WithoutCancel(ctx), observations); err != nil {
+		r.logger.Warn("record price history", "marketplace", observations[0].Marketplace, "err", err)
+	}
+}
+
+func (r *historyRecorder) prune(ctx context.Context) {
+	n, err := r.history.Prune(ctx, time.Now().Add(-r.cfg.Retention))
+	if err != nil {
+		r.logger.Warn("prune price history", "err", err)
+		return
+	}
+	if n > 0 {
+		r.logger.Info("price history pruned", "observations", n)
+	}
+}
+
+// historySource records the products found by the source to the price history.
+type historySource struct {
+	repository.SearchRepository
+	recorder *historyRecorder
+}
+
+// WithHistory wraps every source into the recording of the found products, so every search, category listing,
+// job and batch adds to the price history. The products without an id aren't recorded.
+func WithHistory(sources []repository.SearchRepository, recorder *historyRecorder) []repository.SearchRepository {
+	res := make([]repository.SearchRepository, 0, len(sources))
+	for _, source := range sources {
+		res = append(res, &historySource{SearchRepository: source, recorder: recorder})
+	}
+
+	return res
+}
+
+func (s *historySource) GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error) {
+	res, err := s.SearchRepository.GetAllProducts(ctx, query)
+	if err == nil {
+		s.record(res)
+	}
+
+	return res, err
+}
+
+func (s *historySource) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	res, err := s.SearchRepository.GetCategoryProducts(ctx, query)
+	if err == nil {
+		s.record(res)
+	}
+
+	return res, err
+}
+
+func (s *historySource) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	res, err := s.SearchRepository.GetProduct(ctx, query)
+	if err == nil {
+		s.record([]domain.Product{res})
+	}
+
+	return res, err
+}
+
+// record queues the observations of the products, so they are recorded without delaying the search.
+// The products without an id or a price are skipped, the price of the latter couldn't be parsed.
+func (s *historySource) record(products []domain.Product) {
+	now := time.Now()
+	observations := make([]domain.PriceObservation, 0, len(products))
+	for _, p := range products {
+		if p.ID == "" || p.Price <= 0 {
+			continue
+		}
+		observations = append(observations, domain.PriceObservation{
+			Marketplace:  s.Marketplace(),
+			ProductID:    p.ID,
+			Name:         p.Name,
+			Price:        p.Price,
+			Rating:       p.Rating,
+			ReviewsCount: p.ReviewsCount,
+			InStock:      p.InStock,
+			ObservedAt:   now,
+		})
+	}
+	if len(observations) == 0 {
+		return
+	}
+
+	s.recorder.Record(observations)
+}
@@ -0,0 +1,251 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestHistoryService_GetPriceHistory(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	observations := []domain.PriceObservation{
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Price: 100, Rating: 4.5, ReviewsCount: 10, InStock: true, ObservedAt: start.Add(time.Hour)},
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Price: 80, Rating: 4.6, ReviewsCount: 12, InStock: false, ObservedAt: start.Add(5 * time.Hour)},
+		{Marketplace: domain.MarketplaceOzon, ProductID: "1", Price: 120, Rating: 4.7, ReviewsCount: 15, InStock: true, ObservedAt: start.Add(26 * time.Hour)},
+	}
+
+	t.Run("every observation", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		srv := usecase.NewHistoryService(historyRepo)
+
+		historyRepo.On("Observations", mock.Anything, domain.MarketplaceOzon, "1", time.Time{}, time.Time{}).Return(observations, nil).Once()
+
+		res, err := srv.GetPriceHistory(context.Background(), domain.HistoryQuery{Marketplace: domain.MarketplaceOzon, ProductID: "1"})
+		assert.NoError(t, err)
+		assert.Len(t, res, 3)
+		assert.Equal(t, domain.PricePoint{
+			Time:         start.Add(5 * time.Hour),
+			Price:        80,
+			MinPrice:     80,
+			MaxPrice:     80,
+			Rating:       4.6,
+			ReviewsCount: 12,
+			InStock:      false,
+			Samples:      1,
+		}, res[1])
+
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("downsampled", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		srv := usecase.NewHistoryService(historyRepo)
+
+		from := start
+		historyRepo.On("Observations", mock.Anything, domain.MarketplaceOzon, "1", from, time.Time{}).Return(observations, nil).Once()
+
+		res, err := srv.GetPriceHistory(context.Background(), domain.HistoryQuery{
+			Marketplace: domain.MarketplaceOzon,
+			ProductID:   " 1 ",
+			From:        from,
+			Interval:    24 * time.Hour,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PricePoint{
+			{Time: start, Price: 90, MinPrice: 80, MaxPrice: 100, Rating: 4.6, ReviewsCount: 12, InStock: false, Samples: 2},
+			{Time: start.Add(24 * time.Hour), Price: 120, MinPrice: 120, MaxPrice: 120, Rating: 4.7, ReviewsCount: 15, InStock: true, Samples: 1},
+		}, res)
+
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		srv := usecase.NewHistoryService(historyRepo)
+
+		_, err := srv.GetPriceHistory(context.Background(), domain.HistoryQuery{Marketplace: domain.MarketplaceOzon, ProductID: " "})
+		assert.ErrorIs(t, err, domain.ErrEmptyProductID)
+
+		_, err = srv.GetPriceHistory(context.Background(), domain.HistoryQuery{Marketplace: domain.MarketplaceOzon, ProductID: "1", From: start.Add(time.Hour), To: start})
+		assert.ErrorIs(t, err, domain.ErrInvalidHistoryRange)
+
+		_, err = srv.GetPriceHistory(context.Background(), domain.HistoryQuery{Marketplace: domain.MarketplaceOzon, ProductID: "1", Interval: -time.Hour})
+		assert.ErrorIs(t, err, domain.ErrInvalidInterval)
+
+		historyRepo.AssertNotCalled(t, "Observations", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestHistorySource_GetAllProducts(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod"}
+	prods := []domain.Product{
+		{ID: "1", Name: "prod", Link: "link1", Price: 100.0, Rating: 4.5, ReviewsCount: 10, InStock: true},
+		{Name: "prod without id", Link: "link2", Price: 200.0},
+		{ID: "3", Name: "prod without price", Link: "link3"},
+	}
+	cfg := &usecase.HistoryConfig{BufferSize: 8}
+
+	t.Run("products are recorded", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, cfg, &mocks.LoggerMock{})
+		go recorder.Run(context.Background())
+		source := usecase.WithHistory([]repository.SearchRepository{searchRepo}, recorder)[0]
+
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		historyRepo.On("Record", mock.Anything, mock.MatchedBy(func(observations []domain.PriceObservation) bool {
+			return len(observations) == 1 &&
+				observations[0].Marketplace == domain.MarketplaceOzon &&
+				observations[0].ProductID == "1" &&
+				observations[0].Price == 100.0 &&
+				observations[0].ReviewsCount == 10 &&
+				!observations[0].ObservedAt.IsZero()
+		})).Return(nil).Once()
+
+		res, err := source.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res)
+
+		// Close waits for the queued observations to be recorded
+		recorder.Close()
+		searchRepo.AssertExpectations(t)
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("recording failure doesn't fail the search", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, cfg, loggerMock)
+		go recorder.Run(context.Background())
+		source := usecase.WithHistory([]repository.SearchRepository{searchRepo}, recorder)[0]
+
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		historyRepo.On("Record", mock.Anything, mock.Anything).Return(errors.New("disk is full")).Once()
+		loggerMock.On("Warn", "record price history", mock.Anything).Once()
+
+		res, err := source.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res)
+
+		recorder.Close()
+		historyRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("products without price aren't recorded", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, cfg, &mocks.LoggerMock{})
+		go recorder.Run(context.Background())
+		source := usecase.WithHistory([]repository.SearchRepository{searchRepo}, recorder)[0]
+
+		category := domain.CategoryQuery{Marketplace: domain.MarketplaceOzon, Category: "category"}
+		unparsed := []domain.Product{{ID: "1", Name: "prod", Link: "link1"}, {ID: "2", Name: "prod 2", Link: "link2", Price: -1.0}}
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchRepo.On("GetCategoryProducts", mock.Anything, category).Return(unparsed, nil).Once()
+
+		res, err := source.GetCategoryProducts(context.Background(), category)
+		assert.NoError(t, err)
+		assert.Equal(t, unparsed, res)
+
+		recorder.Close()
+		historyRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed search isn't recorded", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, cfg, &mocks.LoggerMock{})
+		go recorder.Run(context.Background())
+		source := usecase.WithHistory([]repository.SearchRepository{searchRepo}, recorder)[0]
+
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, repository.ErrGatewayTimeout).Once()
+
+		_, err := source.GetAllProducts(context.Background(), query)
+		assert.ErrorIs(t, err, repository.ErrGatewayTimeout)
+
+		recorder.Close()
+		historyRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHistoryRecorder(t *testing.T) {
+	observations := []domain.PriceObservation{{Marketplace: domain.MarketplaceOzon, ProductID: "1", Price: 100.0}}
+
+	t.Run("full buffer", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, &usecase.HistoryConfig{BufferSize: 1}, loggerMock)
+
+		// The search doesn't wait for the full buffer, its observations are dropped
+		loggerMock.On("Warn", "price history buffer is full", mock.Anything).Once()
+		recorder.Record(observations)
+		recorder.Record(observations)
+
+		historyRepo.On("Record", mock.Anything, observations).Return(nil).Once()
+		go recorder.Run(context.Background())
+		recorder.Close()
+
+		// The observations aren't queued after Close
+		recorder.Record(observations)
+
+		historyRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, &usecase.HistoryConfig{
+			Retention:     24 * time.Hour,
+			PruneInterval: 10 * time.Millisecond,
+			BufferSize:    1,
+		}, loggerMock)
+
+		pruned := make(chan struct{})
+		historyRepo.On("Prune", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= 24*time.Hour && time.Since(before) < 25*time.Hour
+		})).Return(int64(3), nil).Once()
+		historyRepo.On("Prune", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+			select {
+			case <-pruned:
+			default:
+				close(pruned)
+			}
+		}).Return(int64(0), nil)
+		loggerMock.On("Info", "price history pruned", mock.Anything).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go recorder.Run(ctx)
+
+		// The observations are pruned on start and then every prune interval
+		<-pruned
+		cancel()
+		recorder.Close()
+
+		historyRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("no retention", func(t *testing.T) {
+		historyRepo := &mocks.HistoryRepositoryMock{}
+		recorder := usecase.NewHistoryRecorder(historyRepo, &usecase.HistoryConfig{BufferSize: 1}, &mocks.LoggerMock{})
+
+		go recorder.Run(context.Background())
+		recorder.Close()
+
+		historyRepo.AssertNotCalled(t, "Prune", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// JobService runs the searches in the background, so the clients don't hold the connection while the marketplaces are scraped.
+type JobService interface {
+	// CreateSearchJob queues the search, it fails with TooManyRequestsError if the queue is full.
+	CreateSearchJob(ctx context.Context, query domain.SearchQuery) (domain.SearchJob, error)
+	GetSearchJob(ctx context.Context, id string) (domain.SearchJob, error)
+	// CancelSearchJob cancels the queued or running job, the finished job is returned as is.
+	CancelSearchJob(ctx context.Context, id string) (domain.SearchJob, error)
+}
+
+// JobConfig describes the workers of the search jobs.
+type JobConfig struct {
+	Workers int
+	// QueueSize is the number of the jobs waiting for a worker.
+	QueueSize int
+	// RetryAfter is the time the clients are asked to wait if the queue is full.
+	RetryAfter time.Duration
+	// Timeout is the deadline of a job.
+	Timeout time.Duration
+}
+
+type jobService struct {
+	parser ParserService
+	jobs   repository.JobRepository
+	cfg    *JobConfig
+	logger logger.Logger
+	queue  chan string
+
+	// mu serializes the updates of the jobs, so a canceled job isn't overwritten by its worker.
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func NewJobService(parser ParserService, jobs repository.JobRepository, cfg *JobConfig, logger logger.Logger) *jobService {
+	return &jobService{
+		parser:  parser,
+		jobs:    jobs,
+		cfg:     cfg,
+		logger:  logger,
+		queue:   make(chan string, cfg.QueueSize),
+		cancels: make(map[string]context.CancelFunc),
+	}
+}
+
+// Run runs the workers until ctx is done, the running jobs are canceled then.
+func (s *jobService) Run(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	for range max(s.cfg.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case id := <-s.queue:
+					s.runJob(ctx, id)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *jobService) CreateSearchJob(ctx context.Context, query domain.SearchQuery) (domain.SearchJob, error) {
+	if err := ValidateSearchArgs(query.Name, query.PriceFrom, query.PriceTo); err != nil {
+		return domain.SearchJob{}, err
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return domain.SearchJob{}, fmt.Errorf("new job id: %w", err)
+	}
+
+	job := domain.SearchJob{ID: id, Status: domain.JobQueued, Query: query, CreatedAt: time.Now()}
+	for _, st := range s.parser.GetSourcesStatus(ctx) {
+		job.Sources = append(job.Sources, domain.SourceProgress{Marketplace: st.Marketplace, State: domain.SourcePending})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The queue is only sent to under the lock, so the send below doesn't block
+	if len(s.queue) == cap(s.queue) {
+		return domain.SearchJob{}, &domain.TooManyRequestsError{Scope: "jobs", RetryAfter: s.cfg.RetryAfter}
+	}
+	if err := s.jobs.Save(ctx, job); err != nil {
+		return domain.SearchJob{}, fmt.Errorf("save job: %w", err)
+	}
+	s.queue <- job.ID
+
+	return job, nil
+}
+
+func (s *jobService) GetSearchJob(ctx context.Context, id string) (domain.SearchJob, error) {
+	job, err := s.jobs.Get(ctx, id)
+	if err != nil {
+		return domain.SearchJob{}, mapJobError(err)
+	}
+
+	return job, nil
+}
+
+func (s *jobService) CancelSearchJob(ctx context.Context, id string) (domain.SearchJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.jobs.Get(ctx, id)
+	if err != nil {
+		return domain.SearchJob{}, mapJobError(err)
+	}
+	if job.Status.Finished() {
+		return job, nil
+	}
+
+	if cancel, ok := s.cancels[id]; ok {
+		cancel()
+	}
+	finishJob(&job, domain.JobCanceled, domain.SourceCanceled)
+	if err := s.jobs.Save(ctx, job); err != nil {
+		return domain.SearchJob{}, fmt.Errorf("save job: %w", err)
+	}
+
+	return job, nil
+}
+
+// runJob runs the queued job, the job canceled while it was queued is skipped.
+// The result of every marketplace is saved into the job as soon as the marketplace is finished,
+// so the clients polling the job see the progress of the search.
+func (s *jobService) runJob(ctx context.Context, id string) {
+	jobCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	job, ok := s.startJob(jobCtx, id, cancel)
+	if !ok {
+		return
+	}
+
+	// Any failure of a marketplace fails the job like it fails the search, the job fails if all marketplaces timed out too
+	var sourceErr error
+	results, timedOut := 0, 0
+	err := s.parser.StreamProductsList(jobCtx, job.Query, func(res domain.SourceResult) {
+		results++
+		switch {
+		case res.Err != nil && sourceErr == nil:
+			sourceErr = res.Err
+		case res.TimedOut:
+			timedOut++
+		}
+		s.saveSourceResult(ctx, id, res)
+	})
+	switch {
+	case err != nil:
+	case sourceErr != nil:
+		err = sourceErr
+	case results > 0 && timedOut == results:
+		err = domain.ErrGatewayTimeout
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.cancels, id)
+	job, getErr := s.jobs.Get(ctx, id)
+	if getErr != nil {
+		s.logger.Error("get search job", "id", id, "err", getErr)
+		return
+	}
+	if job.Status == domain.JobCanceled {
+		return
+	}
+
+	// The marketplaces finished before the failure keep their states
+	switch {
+	case err != nil && ctx.Err() != nil:
+		finishJob(&job, domain.JobCanceled, domain.SourceCanceled)
+	case err != nil:
+		s.logger.Warn("search job failed", "id", id, "err", err)
+		job.Error = err.Error()
+		finishJob(&job, domain.JobFailed, domain.SourceFailed)
+	default:
+		if job.Result.Products == nil {
+			job.Result.Products = []domain.Product{}
+		}
+		finishJob(&job, domain.JobSucceeded, domain.SourceDone)
+	}
+
+	if err := s.jobs.Save(ctx, job); err != nil {
+		s.logger.Error("save search job", "id", id, "err", err)
+	}
+}
+
+// saveSourceResult saves the result of the finished marketplace into the running job.
+func (s *jobService) saveSourceResult(ctx context.Context, id string, res domain.SourceResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.jobs.Get(ctx, id)
+	if err != nil {
+		s.logger.Error("get search job", "id", id, "err", err)
+		return
+	}
+	if job.Status != domain.JobRunning {
+		return
+	}
+
+	state := domain.SourceDone
+	switch {
+	case res.Err != nil:
+		state = domain.SourceFailed
+	case res.TimedOut:
+		state = domain.SourceTimedOut
+		job.Result.TimedOut = append(job.Result.TimedOut, res.Marketplace)
+	default:
+		job.Result.Products = append(job.Result.Products, res.Products...)
+	}
+	for i := range job.Sources {
+		if job.Sources[i].Marketplace == res.Marketplace {
+			job.Sources[i].State = state
+		}
+	}
+
+	if err := s.jobs.Save(ctx, job); err != nil {
+		s.logger.Error("save search job", "id", id, "err", err)
+	}
+}
+
+// startJob marks the queued job as running and keeps its cancel func for CancelSearchJob.
+func (s *jobService) startJob(ctx context.Context, id string, cancel context.CancelFunc) (domain.SearchJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, err := s.jobs.Get(ctx, id)
+	if err != nil {
+		s.logger.Error("get search job", "id", id, "err", err)
+		return domain.SearchJob{}, false
+	}
+	if job.Status != domain.JobQueued {
+		return domain.SearchJob{}, false
+	}
+
+	job.Status = domain.JobRunning
+	job.StartedAt = time.Now()
+	for i := range job.Sources {
+		job.Sources[i].State = domain.SourceRunning
+	}
+	if err := s.jobs.Save(ctx, job); err != nil {
+		s.logger.Error("save search job", "id", id, "err", err)
+		return domain.SearchJob{}, false
+	}
+	s.cancels[id] = cancel
+
+	return job, true
+}
+
+// finishJob sets the final status of the job, the marketplaces that aren't finished yet get the given state.
+func finishJob(job *domain.SearchJob, status domain.JobStatus, state domain.SourceState) {
+	job.Status = status
+	job.FinishedAt = time.Now()
+	for i := range job.Sources {
+		switch job.Sources[i].State {
+		case domain.SourcePending, domain.SourceRunning:
+			job.Sources[i].State = state
+		}
+	}
+}
+
+func mapJobError(err error) error {
+	if errors.Is(err, repository.ErrJobNotFound) {
+		return domain.ErrJobNotFound
+	}
+
+	return err
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
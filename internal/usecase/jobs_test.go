@@ -0,0 +1,317 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+// newJobRepositoryMock returns the job repository mock that keeps the saved jobs in a map.
+func newJobRepositoryMock() *mocks.JobRepositoryMock {
+	var mu sync.Mutex
+	jobs := make(map[string]domain.SearchJob)
+
+	jobRepo := &mocks.JobRepositoryMock{}
+	jobRepo.On("Save", mock.Anything, mock.Anything).Return(func(ctx context.Context, job domain.SearchJob) error {
+		mu.Lock()
+		defer mu.Unlock()
+		job.Sources = append([]domain.SourceProgress(nil), job.Sources...)
+		jobs[job.ID] = job
+		return nil
+	})
+	jobRepo.On("Get", mock.Anything, mock.Anything).Return(func(ctx context.Context, id string) (domain.SearchJob, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		job, ok := jobs[id]
+		if !ok {
+			return domain.SearchJob{}, repository.ErrJobNotFound
+		}
+		job.Sources = append([]domain.SourceProgress(nil), job.Sources...)
+		return job, nil
+	})
+
+	return jobRepo
+}
+
+func waitJob(t *testing.T, svc usecase.JobService, id string) domain.SearchJob {
+	t.Helper()
+
+	var job domain.SearchJob
+	assert.Eventually(t, func() bool {
+		var err error
+		job, err = svc.GetSearchJob(context.Background(), id)
+		return err == nil && job.Status.Finished()
+	}, time.Second, 5*time.Millisecond)
+
+	return job
+}
+
+// streamResults returns the Run func of the StreamProductsList mock that streams the results to the callback.
+func streamResults(results ...domain.SourceResult) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		fn := args.Get(2).(func(domain.SourceResult))
+		for _, res := range results {
+			fn(res)
+		}
+	}
+}
+
+func TestJobService_CreateSearchJob(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	statuses := []domain.SourceStatus{{Marketplace: domain.MarketplaceOzon}, {Marketplace: domain.MarketplaceWildberries}}
+	cfg := &usecase.JobConfig{Workers: 1, QueueSize: 1, RetryAfter: 30 * time.Second, Timeout: time.Minute}
+
+	t.Run("succeeded", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		result := domain.SearchResult{
+			Products: []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}},
+			TimedOut: []domain.Marketplace{domain.MarketplaceOzon},
+		}
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+		parserSvc.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(streamResults(
+			domain.SourceResult{Marketplace: domain.MarketplaceWildberries, Products: result.Products},
+			domain.SourceResult{Marketplace: domain.MarketplaceOzon, TimedOut: true},
+		)).Return(nil).Once()
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, job.ID)
+		assert.Equal(t, domain.JobQueued, job.Status)
+		assert.Equal(t, []domain.SourceProgress{
+			{Marketplace: domain.MarketplaceOzon, State: domain.SourcePending},
+			{Marketplace: domain.MarketplaceWildberries, State: domain.SourcePending},
+		}, job.Sources)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.Run(ctx)
+
+		job = waitJob(t, svc, job.ID)
+		assert.Equal(t, domain.JobSucceeded, job.Status)
+		assert.Equal(t, result, job.Result)
+		assert.Equal(t, []domain.SourceProgress{
+			{Marketplace: domain.MarketplaceOzon, State: domain.SourceTimedOut},
+			{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+		}, job.Sources)
+		assert.False(t, job.StartedAt.IsZero())
+		assert.False(t, job.FinishedAt.IsZero())
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, loggerMock)
+
+		blockedErr := &domain.SourceBlockedError{Marketplace: domain.MarketplaceOzon}
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+		parserSvc.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(streamResults(
+			domain.SourceResult{Marketplace: domain.MarketplaceWildberries, Products: []domain.Product{{Name: "prod"}}},
+			domain.SourceResult{Marketplace: domain.MarketplaceOzon, Err: blockedErr},
+		)).Return(nil).Once()
+		loggerMock.On("Warn", "search job failed", mock.Anything).Once()
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.Run(ctx)
+
+		job = waitJob(t, svc, job.ID)
+		assert.Equal(t, domain.JobFailed, job.Status)
+		assert.Equal(t, blockedErr.Error(), job.Error)
+		// The marketplace finished before the failure isn't marked as failed
+		assert.Equal(t, []domain.SourceProgress{
+			{Marketplace: domain.MarketplaceOzon, State: domain.SourceFailed},
+			{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+		}, job.Sources)
+
+		parserSvc.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("all sources timed out", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, loggerMock)
+
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+		parserSvc.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(streamResults(
+			domain.SourceResult{Marketplace: domain.MarketplaceWildberries, TimedOut: true},
+			domain.SourceResult{Marketplace: domain.MarketplaceOzon, TimedOut: true},
+		)).Return(nil).Once()
+		loggerMock.On("Warn", "search job failed", mock.Anything).Once()
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.Run(ctx)
+
+		job = waitJob(t, svc, job.ID)
+		assert.Equal(t, domain.JobFailed, job.Status)
+		assert.Equal(t, domain.ErrGatewayTimeout.Error(), job.Error)
+		assert.Equal(t, domain.SourceTimedOut, job.Sources[0].State)
+
+		parserSvc.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+		firstDone := make(chan struct{})
+		polled := make(chan struct{})
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+		parserSvc.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.SourceResult))
+			fn(domain.SourceResult{Marketplace: domain.MarketplaceWildberries, Products: prods})
+			close(firstDone)
+			<-polled
+			fn(domain.SourceResult{Marketplace: domain.MarketplaceOzon})
+		}).Return(nil).Once()
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.Run(ctx)
+
+		// The finished marketplace is saved while the other one is still running
+		<-firstDone
+		job, err = svc.GetSearchJob(context.Background(), job.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.JobRunning, job.Status)
+		assert.Equal(t, []domain.SourceProgress{
+			{Marketplace: domain.MarketplaceOzon, State: domain.SourceRunning},
+			{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+		}, job.Sources)
+		assert.Equal(t, prods, job.Result.Products)
+		close(polled)
+
+		job = waitJob(t, svc, job.ID)
+		assert.Equal(t, domain.JobSucceeded, job.Status)
+		assert.Equal(t, []domain.SourceProgress{
+			{Marketplace: domain.MarketplaceOzon, State: domain.SourceDone},
+			{Marketplace: domain.MarketplaceWildberries, State: domain.SourceDone},
+		}, job.Sources)
+		assert.Equal(t, prods, job.Result.Products)
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		svc := usecase.NewJobService(&mocks.ParserServiceMock{}, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		_, err := svc.CreateSearchJob(context.Background(), domain.SearchQuery{})
+		assert.ErrorIs(t, err, domain.ErrEmptyProductName)
+	})
+
+	t.Run("queue is full", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+
+		_, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+
+		_, err = svc.CreateSearchJob(context.Background(), query)
+		var tooManyErr *domain.TooManyRequestsError
+		if assert.ErrorAs(t, err, &tooManyErr) {
+			assert.Equal(t, cfg.RetryAfter, tooManyErr.RetryAfter)
+		}
+	})
+}
+
+func TestJobService_CancelSearchJob(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	statuses := []domain.SourceStatus{{Marketplace: domain.MarketplaceOzon}}
+	cfg := &usecase.JobConfig{Workers: 1, QueueSize: 1, Timeout: time.Minute}
+
+	t.Run("running", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		started := make(chan struct{})
+		stopped := make(chan struct{})
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+		parserSvc.On("StreamProductsList", mock.Anything, query, mock.Anything).Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+			args.Get(2).(func(domain.SourceResult))(domain.SourceResult{
+				Marketplace: domain.MarketplaceOzon,
+				Err:         domain.ErrClientClosedRequest,
+			})
+			close(stopped)
+		}).Return(domain.ErrClientClosedRequest).Once()
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.Run(ctx)
+		<-started
+
+		job, err = svc.CancelSearchJob(context.Background(), job.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.JobCanceled, job.Status)
+		assert.Equal(t, domain.SourceCanceled, job.Sources[0].State)
+
+		// The search is canceled and its error doesn't overwrite the status
+		<-stopped
+		job = waitJob(t, svc, job.ID)
+		assert.Equal(t, domain.JobCanceled, job.Status)
+		assert.Empty(t, job.Error)
+
+		parserSvc.AssertExpectations(t)
+	})
+
+	t.Run("queued", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewJobService(parserSvc, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		parserSvc.On("GetSourcesStatus", mock.Anything).Return(statuses)
+
+		job, err := svc.CreateSearchJob(context.Background(), query)
+		assert.NoError(t, err)
+		job, err = svc.CancelSearchJob(context.Background(), job.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.JobCanceled, job.Status)
+
+		// The worker skips the canceled job
+		ctx, cancel := context.WithCancel(context.Background())
+		go svc.Run(ctx)
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		parserSvc.AssertNotCalled(t, "StreamProductsList", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := usecase.NewJobService(&mocks.ParserServiceMock{}, newJobRepositoryMock(), cfg, &mocks.LoggerMock{})
+
+		_, err := svc.CancelSearchJob(context.Background(), "id")
+		assert.ErrorIs(t, err, domain.ErrJobNotFound)
+
+		_, err = svc.GetSearchJob(context.Background(), "id")
+		assert.ErrorIs(t, err, domain.ErrJobNotFound)
+	})
+}
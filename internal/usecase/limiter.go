@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// LimitPolicy describes the concurrency limit of the calls and the bounded queue of the calls waiting for a slot.
+type LimitPolicy struct {
+	// MaxConcurrent is the number of the calls running at the same time, the limit is disabled if it's less than 1.
+	MaxConcurrent int
+	// MaxQueue is the number of the calls waiting for a slot, the next calls are rejected.
+	MaxQueue int
+	// RetryAfter is the time the rejected clients are asked to wait before retrying.
+	RetryAfter time.Duration
+}
+
+// limiter admits at most MaxConcurrent calls at the same time and queues at most MaxQueue calls,
+// so the browser isn't overloaded by the pages of too many requests.
+type limiter struct {
+	name   string
+	policy LimitPolicy
+	logger logger.Logger
+	slots  chan struct{}
+
+	mu     sync.Mutex
+	queued int
+}
+
+func newLimiter(name string, policy LimitPolicy, logger logger.Logger) *limiter {
+	return &limiter{name: name, policy: policy, logger: logger, slots: make(chan struct{}, policy.MaxConcurrent)}
+}
+
+// acquire takes a slot, waiting in the queue until ctx is done if there are no free slots.
+// It fails with TooManyRequestsError if the queue is full and with errQueueLeft if ctx is done in the queue.
+// The returned func releases the slot.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	release := func() { <-l.slots }
+
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	l.mu.Lock()
+	if l.queued >= l.policy.MaxQueue {
+		queued := l.queued
+		l.mu.Unlock()
+		l.logger.Warn("admission rejected", "limiter", l.name, "queue", queued)
+		return nil, &domain.TooManyRequestsError{Scope: l.name, RetryAfter: l.policy.RetryAfter}
+	}
+	l.queued++
+	queued := l.queued
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		l.queued--
+		l.mu.Unlock()
+	}()
+
+	start := time.Now()
+	select {
+	case l.slots <- struct{}{}:
+		l.logger.Info("admission queued", "limiter", l.name, "queue", queued, "wait", time.Since(start))
+		return release, nil
+	case <-ctx.Done():
+		l.logger.Warn("admission queue left", "limiter", l.name, "queue", queued, "wait", time.Since(start))
+		return nil, fmt.Errorf("%w: %w", errQueueLeft, mapContextError(ctx.Err()))
+	}
+}
+
+// errQueueLeft marks the calls whose ctx is done while they wait for a slot, the waiting isn't a failure of the source.
+var errQueueLeft = errors.New("admission queue left")
+
+// limitedParserService admits a limited number of concurrent operations of the parser service.
+type limitedParserService struct {
+	ParserService
+	limiter *limiter
+}
+
+// NewLimitedParserService wraps the searches of the parser service into the global concurrency limit.
+func NewLimitedParserService(srv ParserService, policy LimitPolicy, logger logger.Logger) ParserService {
+	if policy.MaxConcurrent < 1 {
+		return srv
+	}
+
+	return &limitedParserService{ParserService: srv, limiter: newLimiter("global", policy, logger)}
+}
+
+func (s *limitedParserService) GetProductsList(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return domain.SearchResult{}, err
+	}
+	defer release()
+
+	return s.ParserService.GetProductsList(ctx, query)
+}
+
+func (s *limitedParserService) StreamProductsList(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult)) error {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return s.ParserService.StreamProductsList(ctx, query, fn)
+}
+
+func (s *limitedParserService) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.ParserService.GetCategoryProducts(ctx, query)
+}
+
+func (s *limitedParserService) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	defer release()
+
+	return s.ParserService.GetProduct(ctx, query)
+}
+
+func (s *limitedParserService) GetSuggestions(ctx context.Context, prefix string) ([]domain.Suggestions, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.ParserService.GetSuggestions(ctx, prefix)
+}
+
+// limitedSource admits a limited number of concurrent calls of the source.
+type limitedSource struct {
+	repository.SearchRepository
+	limiter *limiter
+}
+
+// WithLimits wraps every source into the concurrency limit of its marketplace, the sources without a limit are returned as is.
+func WithLimits(sources []repository.SearchRepository, policies map[domain.Marketplace]LimitPolicy, logger logger.Logger) []repository.SearchRepository {
+	res := make([]repository.SearchRepository, 0, len(sources))
+	for _, source := range sources {
+		policy, ok := policies[source.Marketplace()]
+		if !ok || policy.MaxConcurrent < 1 {
+			res = append(res, source)
+			continue
+		}
+		res = append(res, &limitedSource{SearchRepository: source, limiter: newLimiter(string(source.Marketplace()), policy, logger)})
+	}
+
+	return res
+}
+
+func (s *limitedSource) GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.SearchRepository.GetAllProducts(ctx, query)
+}
+
+func (s *limitedSource) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.SearchRepository.GetCategoryProducts(ctx, query)
+}
+
+func (s *limitedSource) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	defer release()
+
+	return s.SearchRepository.GetProduct(ctx, query)
+}
+
+func (s *limitedSource) GetSuggestions(ctx context.Context, prefix string) ([]string, error) {
+	release, err := s.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.SearchRepository.GetSuggestions(ctx, prefix)
+}
+
+// mapContextError maps the error of the done ctx to the domain errors.
+func mapContextError(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return domain.ErrGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return domain.ErrClientClosedRequest
+	default:
+		return err
+	}
+}
@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestLimitedParserService_GetProductsList(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+	policy := usecase.LimitPolicy{MaxConcurrent: 1, MaxQueue: 1, RetryAfter: 5 * time.Second}
+
+	t.Run("queue is full", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewLimitedParserService(parserSvc, policy, loggerMock)
+
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		parserSvc.On("GetProductsList", mock.Anything, query).Run(func(args mock.Arguments) {
+			started <- struct{}{}
+			<-release
+		}).Return(domain.SearchResult{Products: prods}, nil).Twice()
+		loggerMock.On("Warn", "admission rejected", mock.Anything).Once()
+		loggerMock.On("Info", "admission queued", mock.Anything).Once()
+
+		errs := make(chan error, 3)
+		go func() {
+			_, err := svc.GetProductsList(context.Background(), query)
+			errs <- err
+		}()
+		<-started
+
+		// One of the next searches waits in the queue, the other one is rejected
+		wg := &sync.WaitGroup{}
+		for range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := svc.GetProductsList(context.Background(), query)
+				errs <- err
+			}()
+		}
+
+		var tooManyErr *domain.TooManyRequestsError
+		err := <-errs
+		if assert.ErrorAs(t, err, &tooManyErr) {
+			assert.Equal(t, "global", tooManyErr.Scope)
+			assert.Equal(t, policy.RetryAfter, tooManyErr.RetryAfter)
+		}
+
+		close(release)
+		wg.Wait()
+		assert.NoError(t, <-errs)
+		assert.NoError(t, <-errs)
+
+		parserSvc.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("queue timeout", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewLimitedParserService(parserSvc, policy, loggerMock)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		parserSvc.On("GetProductsList", mock.Anything, query).Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+		}).Return(domain.SearchResult{Products: prods}, nil).Once()
+		loggerMock.On("Warn", "admission queue left", mock.Anything).Once()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = svc.GetProductsList(context.Background(), query)
+		}()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := svc.GetProductsList(ctx, query)
+		assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+		close(release)
+		<-done
+
+		parserSvc.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		svc := usecase.NewLimitedParserService(parserSvc, usecase.LimitPolicy{}, &mocks.LoggerMock{})
+		assert.Same(t, parserSvc, svc)
+	})
+}
+
+func TestWithLimits(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	policies := map[domain.Marketplace]usecase.LimitPolicy{
+		domain.MarketplaceOzon: {MaxConcurrent: 1, RetryAfter: time.Second},
+	}
+
+	ozonRepo := &mocks.SearchRepositoryMock{}
+	wbRepo := &mocks.SearchRepositoryMock{}
+	loggerMock := &mocks.LoggerMock{}
+
+	ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+	wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+
+	sources := usecase.WithLimits([]repository.SearchRepository{ozonRepo, wbRepo}, policies, loggerMock)
+	assert.Same(t, wbRepo, sources[1])
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	ozonRepo.On("GetAllProducts", mock.Anything, query).Run(func(args mock.Arguments) {
+		close(started)
+		<-release
+	}).Return([]domain.Product{}, nil).Once()
+	loggerMock.On("Warn", "admission rejected", mock.Anything).Once()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = sources[0].GetAllProducts(context.Background(), query)
+	}()
+	<-started
+
+	_, err := sources[0].GetAllProducts(context.Background(), query)
+	var tooManyErr *domain.TooManyRequestsError
+	if assert.ErrorAs(t, err, &tooManyErr) {
+		assert.Equal(t, string(domain.MarketplaceOzon), tooManyErr.Scope)
+	}
+
+	close(release)
+	<-done
+
+	ozonRepo.AssertExpectations(t)
+	loggerMock.AssertExpectations(t)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruCache keeps at most maxEntries values, the least recently used one is evicted first.
+// Every value expires after its own TTL.
+type lruCache[K comparable, V any] struct {
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func newLRUCache[K comparable, V any](maxEntries int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		maxEntries: maxEntries,
+		now:        time.Now,
+		order:      list.New(),
+		entries:    make(map[K]*list.Element),
+	}
+}
+
+// Get returns the value of the key and the time it was added, the expired value is removed.
+func (c *lruCache[K, V]) Get(key K) (V, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	el, ok := c.entries[key]
+	if !ok {
+		return zero, time.Time{}, false
+	}
+
+	e := el.Value.(*lruEntry[K, V])
+	if !c.now().Before(e.expiresAt) {
+		c.remove(el)
+		return zero, time.Time{}, false
+	}
+	c.order.MoveToFront(el)
+
+	return e.value, e.createdAt, true
+}
+
+// Add adds or replaces the value of the key, the least recently used value is evicted if the cache is full.
+func (c *lruCache[K, V]) Add(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	e := &lruEntry[K, V]{key: key, value: value, createdAt: now, expiresAt: now.Add(ttl)}
+	if el, ok := c.entries[key]; ok {
+		el.Value = e
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(e)
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *lruCache[K, V]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry[K, V]).key)
+}
@@ -3,6 +3,9 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
@@ -10,98 +13,462 @@ import (
 )
 
 type ParserService interface {
-	GetProductsList(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error)
+	GetProductsList(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error)
+	// StreamProductsList searches the products like GetProductsList, but passes the result of every marketplace
+	// to fn as soon as it's finished. A failed marketplace doesn't stop the others.
+	StreamProductsList(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult)) error
+	GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error)
+	// GetProduct parses the product from its product page in the marketplace of the query.
+	GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error)
+	GetSuggestions(ctx context.Context, prefix string) ([]domain.Suggestions, error)
+	GetSourcesStatus(ctx context.Context) []domain.SourceStatus
 }
 
 type parserService struct {
 	source []repository.SearchRepository
+	cfg    *SearchConfig
+	// breakers are the circuit breakers of the sources by their index, nil for a source without a breaker.
+	breakers []*circuitBreaker
+	// searches coalesce the concurrent identical searches of the sources by their index.
+	searches []*flightGroup[searchKey, []domain.Product]
 }
 
-func NewSearchService(source []repository.SearchRepository) *parserService {
-	return &parserService{source: source}
-}
+// NewSearchService creates a parser service over the sources, a nil cfg means no time budgets and circuit breakers.
+func NewSearchService(source []repository.SearchRepository, cfg *SearchConfig) *parserService {
+	if cfg == nil {
+		cfg = &SearchConfig{}
+	}
 
-func (s *parserService) GetProductsList(ctx context.Context, name string, priceFrom float64, priceTo float64) ([]domain.Product, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	s := &parserService{
+		source:   source,
+		cfg:      cfg,
+		breakers: make([]*circuitBreaker, len(source)),
+		searches: make([]*flightGroup[searchKey, []domain.Product], len(source)),
+	}
+	for i := range source {
+		s.searches[i] = newFlightGroup[searchKey, []domain.Product]()
+	}
+	if len(cfg.Breakers) > 0 {
+		for i, src := range source {
+			if policy, ok := cfg.Breakers[src.Marketplace()]; ok && policy.FailureThreshold > 0 {
+				s.breakers[i] = newCircuitBreaker(policy)
+			}
+		}
+	}
 
-	if err := ValidateSearchArgs(name, priceFrom, priceTo); err != nil {
-		return nil, err
+	return s
+}
+
+// GetProductsList searches the products in every marketplace of the query. Every marketplace runs within its time budget,
+// the ones that exceed it are reported as timed out and the products of the others are returned.
+// Any other failure of a marketplace fails the whole search.
+func (s *parserService) GetProductsList(ctx context.Context, query domain.SearchQuery) (domain.SearchResult, error) {
+	if err := ValidateSearchArgs(query.Name, query.PriceFrom, query.PriceTo); err != nil {
+		return domain.SearchResult{}, err
+	}
+	sources, err := s.searchedSources(query.Marketplaces)
+	if err != nil {
+		return domain.SearchResult{}, err
 	}
 
-	resCh := make(chan []domain.Product)
+	sourcesCtx, cancel := s.sourcesContext(ctx)
+	defer cancel()
+
+	products := make([][]domain.Product, len(s.source))
+	timedOut := make([]bool, len(s.source))
 	errCh := make(chan error, 1)
 
 	wg := &sync.WaitGroup{}
-	for _, src := range s.source {
+	for _, i := range sources {
 		wg.Add(1)
-		go func(source repository.SearchRepository) {
+		go func(i int, source repository.SearchRepository) {
 			defer wg.Done()
-			products, err := source.GetAllProducts(ctx, name, priceFrom, priceTo)
+
+			res, err := s.searchSource(sourcesCtx, i, query)
 			if err != nil {
-				if errors.Is(err, repository.ErrGatewayTimeout) {
-					select {
-					case errCh <- domain.ErrGatewayTimeout:
-						break
-					default:
-					}
-					cancel()
-					return
-				} else if errors.Is(err, repository.ErrClientClosedRequest) {
-					select {
-					case errCh <- domain.ErrClientClosedRequest:
-						break
-					default:
-					}
-					cancel()
-					return
-				} else {
-					select {
-					case errCh <- err:
-						break
-					default:
-					}
-					cancel()
+				if sourceTimedOut(ctx, sourcesCtx, err) {
+					timedOut[i] = true
 					return
 				}
-			}
 
-			select {
-			case resCh <- products:
-				return
-			case <-ctx.Done():
+				select {
+				case errCh <- mapRepositoryError(source, err):
+				default:
+				}
+				cancel()
 				return
 			}
-		}(src)
-	}
-
-	go func() {
-		wg.Wait()
-		close(resCh)
-	}()
-
-	res := []domain.Product{}
-	for {
-		select {
-		case err := <-errCh:
-			return nil, err
-		case r, ok := <-resCh:
-			if !ok {
-				return res, nil
+
+			products[i] = res
+		}(i, s.source[i])
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return domain.SearchResult{}, domain.ErrGatewayTimeout
+		}
+		return domain.SearchResult{}, domain.ErrClientClosedRequest
+	}
+
+	select {
+	case err := <-errCh:
+		return domain.SearchResult{}, err
+	default:
+	}
+
+	res := domain.SearchResult{Products: []domain.Product{}}
+	for _, i := range sources {
+		if timedOut[i] {
+			res.TimedOut = append(res.TimedOut, s.source[i].Marketplace())
+			continue
+		}
+		res.Products = append(res.Products, FilterProducts(products[i], query.InStockOnly)...)
+	}
+	if len(sources) > 0 && len(res.TimedOut) == len(sources) {
+		return domain.SearchResult{}, domain.ErrGatewayTimeout
+	}
+
+	return res, nil
+}
+
+func (s *parserService) StreamProductsList(ctx context.Context, query domain.SearchQuery, fn func(domain.SourceResult)) error {
+	if err := ValidateSearchArgs(query.Name, query.PriceFrom, query.PriceTo); err != nil {
+		return err
+	}
+	sources, err := s.searchedSources(query.Marketplaces)
+	if err != nil {
+		return err
+	}
+
+	sourcesCtx, cancel := s.sourcesContext(ctx)
+	defer cancel()
+
+	results := make(chan domain.SourceResult, len(sources))
+	for _, i := range sources {
+		go func(i int, source repository.SearchRepository) {
+			res := domain.SourceResult{Marketplace: source.Marketplace()}
+
+			prods, err := s.searchSource(sourcesCtx, i, query)
+			switch {
+			case err == nil:
+				res.Products = FilterProducts(prods, query.InStockOnly)
+			case sourceTimedOut(ctx, sourcesCtx, err):
+				res.TimedOut = true
+			default:
+				res.Err = mapRepositoryError(source, err)
 			}
-			res = append(res, r...)
-		case <-ctx.Done():
-			if ctx.Err() != nil {
-				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-					return nil, domain.ErrGatewayTimeout
-				}
-				if errors.Is(ctx.Err(), context.Canceled) {
-					return nil, domain.ErrClientClosedRequest
+			results <- res
+		}(i, s.source[i])
+	}
+
+	// fn is called from this goroutine only, so it doesn't need to be safe for concurrent use
+	for range sources {
+		fn(<-results)
+	}
+
+	if ctx.Err() != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return domain.ErrGatewayTimeout
+		}
+		return domain.ErrClientClosedRequest
+	}
+
+	return nil
+}
+
+// sourceTimedOut reports whether the search of a source failed because the budget of the source
+// or the reserved request deadline is exceeded while the request is still alive.
+func sourceTimedOut(ctx, sourcesCtx context.Context, err error) bool {
+	return ctx.Err() == nil && (errors.Is(err, errSourceTimedOut) || errors.Is(sourcesCtx.Err(), context.DeadlineExceeded))
+}
+
+// errSourceTimedOut marks the failures of the sources that exceeded their time budget.
+var errSourceTimedOut = errors.New("source timed out")
+
+// searchSource searches the products in the source i within its time budget. The concurrent identical searches
+// share a single search of the source, it's canceled only when all of them are done.
+func (s *parserService) searchSource(ctx context.Context, i int, query domain.SearchQuery) ([]domain.Product, error) {
+	source := s.source[i]
+	search := func(ctx context.Context) ([]domain.Product, error) {
+		sourceCtx, cancel := s.sourceContext(ctx, source)
+		defer cancel()
+
+		var res []domain.Product
+		err := s.callSource(sourceCtx, i, func() (err error) {
+			res, err = source.GetAllProducts(sourceCtx, query)
+			return err
+		})
+		if err != nil && errors.Is(sourceCtx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w: %w", errSourceTimedOut, err)
+		}
+
+		return res, err
+	}
+
+	// The debug search captures the artifacts of its own pages
+	if query.Debug {
+		return search(ctx)
+	}
+
+	// The search of the source doesn't depend on the other marketplaces of the query
+	key := newSearchKey(query)
+	key.marketplaces = ""
+
+	return s.searches[i].Do(ctx, key, search)
+}
+
+// sourcesContext returns the context of the sources that ends the reserve before the request deadline,
+// so there is time left to return the products found.
+func (s *parserService) sourcesContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok || s.cfg.Reserve <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithDeadline(ctx, deadline.Add(-s.cfg.Reserve))
+}
+
+// sourceContext applies the time budget of the source marketplace if it has one.
+func (s *parserService) sourceContext(ctx context.Context, source repository.SearchRepository) (context.Context, context.CancelFunc) {
+	if len(s.cfg.Timeouts) == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	timeout, ok := s.cfg.Timeouts[source.Marketplace()]
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// GetCategoryProducts gets a list of products from the catalog category of the given marketplace.
+func (s *parserService) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	if err := ValidateCategoryArgs(query.Category, query.PriceFrom, query.PriceTo); err != nil {
+		return nil, err
+	}
+
+	i, ok := s.sourceByMarketplace(query.Marketplace)
+	if !ok {
+		return nil, domain.ErrUnknownMarketplace
+	}
+	source := s.source[i]
+
+	var products []domain.Product
+	err := s.callSource(ctx, i, func() (err error) {
+		products, err = source.GetCategoryProducts(ctx, query)
+		return err
+	})
+	if err != nil {
+		return nil, mapRepositoryError(source, err)
+	}
+
+	return FilterPrice(FilterProducts(products, query.InStockOnly), query.PriceFrom, query.PriceTo), nil
+}
+
+// GetProduct gets the product from its product page within the time budget of its marketplace.
+func (s *parserService) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	query.ID = strings.TrimSpace(query.ID)
+	if query.ID == "" {
+		return domain.Product{}, domain.ErrEmptyProductID
+	}
+
+	i, ok := s.sourceByMarketplace(query.Marketplace)
+	if !ok {
+		return domain.Product{}, domain.ErrUnknownMarketplace
+	}
+	source := s.source[i]
+
+	sourceCtx, cancel := s.sourceContext(ctx, source)
+	defer cancel()
+
+	var product domain.Product
+	err := s.callSource(sourceCtx, i, func() (err error) {
+		product, err = source.GetProduct(sourceCtx, query)
+		return err
+	})
+	if err != nil {
+		return domain.Product{}, mapRepositoryError(source, err)
+	}
+
+	return product, nil
+}
+
+// GetSuggestions gets the search query suggestions for the prefix from every marketplace.
+func (s *parserService) GetSuggestions(ctx context.Context, prefix string) ([]domain.Suggestions, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, domain.ErrEmptyPrefix
+	}
+
+	res := make([]domain.Suggestions, len(s.source))
+	errCh := make(chan error, 1)
+
+	wg := &sync.WaitGroup{}
+	for i, src := range s.source {
+		wg.Add(1)
+		go func(i int, source repository.SearchRepository) {
+			defer wg.Done()
+			var queries []string
+			err := s.callSource(ctx, i, func() (err error) {
+				queries, err = source.GetSuggestions(ctx, prefix)
+				return err
+			})
+			if err != nil {
+				select {
+				case errCh <- mapRepositoryError(source, err):
+				default:
 				}
-				return nil, ctx.Err()
+				cancel()
+				return
 			}
+
+			res[i] = domain.Suggestions{Marketplace: source.Marketplace(), Queries: queries}
+		}(i, src)
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	default:
+		return res, nil
+	}
+}
+
+// GetSourcesStatus returns the state of the circuit breakers of the sources, a source without a breaker is always closed.
+func (s *parserService) GetSourcesStatus(ctx context.Context) []domain.SourceStatus {
+	res := make([]domain.SourceStatus, 0, len(s.source))
+	for i, src := range s.source {
+		status := domain.SourceStatus{State: domain.CircuitClosed}
+		if s.breakers[i] != nil {
+			status = s.breakers[i].status()
 		}
+		status.Marketplace = src.Marketplace()
+		res = append(res, status)
+	}
+
+	return res
+}
+
+// callSource calls the source i through its circuit breaker,
+// the call fails fast with SourceUnavailableError while the circuit is open.
+func (s *parserService) callSource(ctx context.Context, i int, fn func() error) error {
+	breaker := s.breakers[i]
+	if breaker == nil {
+		return fn()
 	}
+
+	retryAfter, ok := breaker.allow()
+	if !ok {
+		return &domain.SourceUnavailableError{Marketplace: s.source[i].Marketplace(), RetryAfter: retryAfter}
+	}
+
+	err := fn()
+	breaker.record(ctx, err)
+
+	return err
+}
+
+// searchedSources returns the indexes of the sources of the marketplaces, all sources if there are no marketplaces.
+func (s *parserService) searchedSources(marketplaces []domain.Marketplace) ([]int, error) {
+	if len(marketplaces) == 0 {
+		res := make([]int, len(s.source))
+		for i := range s.source {
+			res[i] = i
+		}
+		return res, nil
+	}
+
+	res := make([]int, 0, len(marketplaces))
+	for _, m := range marketplaces {
+		i, ok := s.sourceByMarketplace(m)
+		if !ok {
+			return nil, domain.ErrUnknownMarketplace
+		}
+		if !slices.Contains(res, i) {
+			res = append(res, i)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *parserService) sourceByMarketplace(marketplace domain.Marketplace) (int, bool) {
+	for i, src := range s.source {
+		if src.Marketplace() == marketplace {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// mapRepositoryError converts repository errors of the source to domain errors, other errors are returned as is.
+// The id of the debug artifact captured on the failure is kept, only the error it wraps is converted.
+func mapRepositoryError(source repository.SearchRepository, err error) error {
+	var artifactErr *domain.ArtifactError
+	if errors.As(err, &artifactErr) {
+		return &domain.ArtifactError{ArtifactID: artifactErr.ArtifactID, HARPath: artifactErr.HARPath, Err: mapSourceError(source, artifactErr.Err)}
+	}
+
+	return mapSourceError(source, err)
+}
+
+func mapSourceError(source repository.SearchRepository, err error) error {
+	var blockedErr *repository.BlockedError
+	switch {
+	case errors.As(err, &blockedErr):
+		return &domain.SourceBlockedError{Marketplace: source.Marketplace(), RetryAfter: blockedErr.RetryAfter}
+	case errors.Is(err, repository.ErrSourceBlocked):
+		return &domain.SourceBlockedError{Marketplace: source.Marketplace()}
+	case errors.Is(err, repository.ErrGatewayTimeout):
+		return domain.ErrGatewayTimeout
+	case errors.Is(err, repository.ErrClientClosedRequest):
+		return domain.ErrClientClosedRequest
+	case errors.Is(err, repository.ErrInvalidCategory):
+		return domain.ErrInvalidCategory
+	case errors.Is(err, repository.ErrProductNotFound):
+		return domain.ErrProductNotFound
+	default:
+		return err
+	}
+}
+
+// FilterProducts drops out-of-stock products if inStockOnly is set.
+func FilterProducts(products []domain.Product, inStockOnly bool) []domain.Product {
+	if !inStockOnly {
+		return products
+	}
+
+	res := make([]domain.Product, 0, len(products))
+	for _, p := range products {
+		if p.InStock {
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+// FilterPrice returns the products with the price in the range, zero priceTo means the range has no upper limit.
+func FilterPrice(products []domain.Product, priceFrom float64, priceTo float64) []domain.Product {
+	if priceFrom == 0 && priceTo == 0 {
+		return products
+	}
+
+	res := make([]domain.Product, 0, len(products))
+	for _, p := range products {
+		if p.Price >= priceFrom && (priceTo == 0 || p.Price <= priceTo) {
+			res = append(res, p)
+		}
+	}
+
+	return res
 }
 
 func ValidateSearchArgs(name string, priceFrom float64, priceTo float64) error {
@@ -109,11 +476,24 @@ func ValidateSearchArgs(name string, priceFrom float64, priceTo float64) error {
 		return domain.ErrEmptyProductName
 	}
 
+	return ValidatePriceRange(priceFrom, priceTo)
+}
+
+func ValidateCategoryArgs(category string, priceFrom float64, priceTo float64) error {
+	if strings.TrimSpace(category) == "" {
+		return domain.ErrEmptyCategory
+	}
+
+	return ValidatePriceRange(priceFrom, priceTo)
+}
+
+func ValidatePriceRange(priceFrom float64, priceTo float64) error {
 	if priceFrom < 0 {
 		return domain.ErrPriceFromBelowZero
 	}
 
-	if priceFrom > priceTo {
+	// The zero price to is no upper limit
+	if priceTo > 0 && priceFrom > priceTo {
 		return domain.ErrPriceFromAbovePriceTo
 	}
 
@@ -2,7 +2,9 @@ package usecase_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -58,7 +60,7 @@ func TestParserService_GetProductsList(t *testing.T) {
 			name:      "price from above price to",
 			prodName:  "prod",
 			priceFrom: 250.0,
-			priceTo:   0.0,
+			priceTo:   200.0,
 			products:  nil,
 			expErr:    true,
 		},
@@ -78,20 +80,21 @@ func TestParserService_GetProductsList(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			if !tc.expErr {
 				searchRepo := &mocks.SearchRepositoryMock{}
-				searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo})
+				searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
 
-				searchRepo.On("GetAllProducts", mock.Anything, tc.prodName, tc.priceFrom, tc.priceTo).Return(tc.products, nil)
-				res, err := searchSrv.GetProductsList(context.Background(), tc.prodName, tc.priceFrom, tc.priceTo)
+				searchRepo.On("GetAllProducts", mock.Anything, domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo}).Return(tc.products, nil)
+				res, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo})
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
-				assert.ElementsMatch(t, tc.products, res)
+				assert.ElementsMatch(t, tc.products, res.Products)
+				assert.Empty(t, res.TimedOut)
 
 				searchRepo.AssertExpectations(t)
 			} else {
 				searchRepo := &mocks.SearchRepositoryMock{}
-				searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo})
+				searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
 
-				_, err := searchSrv.GetProductsList(context.Background(), tc.prodName, tc.priceFrom, tc.priceTo)
+				_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: tc.prodName, PriceFrom: tc.priceFrom, PriceTo: tc.priceTo})
 				assert.Error(t, err)
 
 				searchRepo.AssertNotCalled(t, "GetAllProducts")
@@ -104,10 +107,10 @@ func TestParserService_GetProductsList(t *testing.T) {
 		p := testCases[0]
 
 		searchRepo := &mocks.SearchRepositoryMock{}
-		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo})
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
 
-		searchRepo.On("GetAllProducts", mock.Anything, p.prodName, p.priceFrom, p.priceTo).Return(nil, repository.ErrGatewayTimeout).Once()
-		_, err := searchSrv.GetProductsList(context.Background(), p.prodName, p.priceFrom, p.priceTo)
+		searchRepo.On("GetAllProducts", mock.Anything, domain.SearchQuery{Name: p.prodName, PriceFrom: p.priceFrom, PriceTo: p.priceTo}).Return(nil, repository.ErrGatewayTimeout).Once()
+		_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: p.prodName, PriceFrom: p.priceFrom, PriceTo: p.priceTo})
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
 
@@ -118,15 +121,233 @@ func TestParserService_GetProductsList(t *testing.T) {
 		p := testCases[0]
 
 		searchRepo := &mocks.SearchRepositoryMock{}
-		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo})
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
 
-		searchRepo.On("GetAllProducts", mock.Anything, p.prodName, p.priceFrom, p.priceTo).Return(nil, repository.ErrClientClosedRequest)
-		_, err := searchSrv.GetProductsList(context.Background(), p.prodName, p.priceFrom, p.priceTo)
+		searchRepo.On("GetAllProducts", mock.Anything, domain.SearchQuery{Name: p.prodName, PriceFrom: p.priceFrom, PriceTo: p.priceTo}).Return(nil, repository.ErrClientClosedRequest)
+		_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: p.prodName, PriceFrom: p.priceFrom, PriceTo: p.priceTo})
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, domain.ErrClientClosedRequest)
 
 		searchRepo.AssertExpectations(t)
 	})
+
+	t.Run("source blocked", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		repoErr := fmt.Errorf("check block page: %w", &repository.BlockedError{URL: "url", Marker: "captcha", RetryAfter: time.Minute})
+		searchRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		searchRepo.On("GetAllProducts", mock.Anything, domain.SearchQuery{Name: "prod", PriceTo: 500.0}).Return(nil, repoErr).Once()
+
+		_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: "prod", PriceTo: 500.0})
+		assert.ErrorIs(t, err, domain.ErrSourceBlocked)
+		var blockedErr *domain.SourceBlockedError
+		assert.ErrorAs(t, err, &blockedErr)
+		assert.Equal(t, domain.MarketplaceOzon, blockedErr.Marketplace)
+		assert.Equal(t, time.Minute, blockedErr.RetryAfter)
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("debug artifact", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		repoErr := &domain.ArtifactError{ArtifactID: "artifact-id", HARPath: "har/page.har", Err: repository.ErrGatewayTimeout}
+		searchRepo.On("GetAllProducts", mock.Anything, domain.SearchQuery{Name: "prod", PriceTo: 500.0}).Return(nil, repoErr).Once()
+
+		_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: "prod", PriceTo: 500.0})
+		assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+		var artifactErr *domain.ArtifactError
+		assert.ErrorAs(t, err, &artifactErr)
+		assert.Equal(t, "artifact-id", artifactErr.ArtifactID)
+		assert.Equal(t, "har/page.har", artifactErr.HARPath)
+
+		searchRepo.AssertExpectations(t)
+	})
+
+	t.Run("in stock only", func(t *testing.T) {
+		p := testCases[0]
+		inStock := domain.Product{Name: "prod", Link: "link1", Price: 100.0, InStock: true}
+		outOfStock := domain.Product{Name: "prod", Link: "link2", Price: 100.0, InStock: false}
+
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		query := domain.SearchQuery{Name: p.prodName, PriceFrom: p.priceFrom, PriceTo: p.priceTo, InStockOnly: true}
+
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return([]domain.Product{inStock, outOfStock}, nil).Once()
+		res, err := searchSrv.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []domain.Product{inStock}, res.Products)
+
+		searchRepo.AssertExpectations(t)
+	})
+}
+
+func TestParserService_GetProductsListTimeouts(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+	waitDone := func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}
+
+	t.Run("source timed out", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, &usecase.SearchConfig{
+			Timeouts: map[domain.Marketplace]time.Duration{domain.MarketplaceOzon: 20 * time.Millisecond},
+		})
+
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		wbRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetAllProducts", mock.Anything, query).Run(waitDone).Return(nil, repository.ErrGatewayTimeout).Once()
+
+		res, err := searchSrv.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res.Products)
+		assert.Equal(t, []domain.Marketplace{domain.MarketplaceOzon}, res.TimedOut)
+
+		wbRepo.AssertExpectations(t)
+		ozonRepo.AssertExpectations(t)
+	})
+
+	t.Run("reserve of request deadline", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, &usecase.SearchConfig{
+			Reserve: 50 * time.Millisecond,
+		})
+
+		wbRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetAllProducts", mock.Anything, query).Run(waitDone).Return(nil, repository.ErrGatewayTimeout).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		res, err := searchSrv.GetProductsList(ctx, query)
+		assert.NoError(t, err)
+		assert.NoError(t, ctx.Err())
+		assert.Equal(t, prods, res.Products)
+		assert.Equal(t, []domain.Marketplace{domain.MarketplaceOzon}, res.TimedOut)
+
+		wbRepo.AssertExpectations(t)
+		ozonRepo.AssertExpectations(t)
+	})
+
+	t.Run("all sources timed out", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{wbRepo}, &usecase.SearchConfig{
+			Timeouts: map[domain.Marketplace]time.Duration{domain.MarketplaceWildberries: 20 * time.Millisecond},
+		})
+
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		wbRepo.On("GetAllProducts", mock.Anything, query).Run(waitDone).Return(nil, repository.ErrGatewayTimeout).Once()
+
+		_, err := searchSrv.GetProductsList(context.Background(), query)
+		assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+		wbRepo.AssertExpectations(t)
+	})
+}
+
+func TestParserService_GetProductsListMarketplaces(t *testing.T) {
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+
+	t.Run("selected marketplace", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, nil)
+
+		query := domain.SearchQuery{Name: "prod", Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}}
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+
+		res, err := searchSrv.GetProductsList(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res.Products)
+
+		ozonRepo.AssertExpectations(t)
+		wbRepo.AssertNotCalled(t, "GetAllProducts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown marketplace", func(t *testing.T) {
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo}, nil)
+
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+
+		_, err := searchSrv.GetProductsList(context.Background(), domain.SearchQuery{Name: "prod", Marketplaces: []domain.Marketplace{"yandex"}})
+		assert.ErrorIs(t, err, domain.ErrUnknownMarketplace)
+	})
+}
+
+func TestParserService_StreamProductsList(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0, InStockOnly: true}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0, InStock: true}, {Name: "prod", Link: "link2", Price: 200.0}}
+
+	t.Run("every source is streamed", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, &usecase.SearchConfig{
+			Timeouts: map[domain.Marketplace]time.Duration{domain.MarketplaceOzon: 20 * time.Millisecond},
+		})
+
+		// The slow ozon search doesn't hold the wildberries products
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		wbRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetAllProducts", mock.Anything, query).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).Return(nil, repository.ErrGatewayTimeout).Once()
+
+		var results []domain.SourceResult
+		err := searchSrv.StreamProductsList(context.Background(), query, func(res domain.SourceResult) {
+			results = append(results, res)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.SourceResult{
+			{Marketplace: domain.MarketplaceWildberries, Products: prods[:1]},
+			{Marketplace: domain.MarketplaceOzon, TimedOut: true},
+		}, results)
+
+		wbRepo.AssertExpectations(t)
+		ozonRepo.AssertExpectations(t)
+	})
+
+	t.Run("failed source doesn't stop others", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, nil)
+
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		wbRepo.On("GetAllProducts", mock.Anything, query).Return(nil, &repository.BlockedError{URL: "url", Marker: "captcha"}).Once()
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+
+		results := make(map[domain.Marketplace]domain.SourceResult)
+		err := searchSrv.StreamProductsList(context.Background(), query, func(res domain.SourceResult) {
+			results[res.Marketplace] = res
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, results[domain.MarketplaceWildberries].Err, domain.ErrSourceBlocked)
+		assert.Equal(t, prods[:1], results[domain.MarketplaceOzon].Products)
+
+		wbRepo.AssertExpectations(t)
+		ozonRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		searchSrv := usecase.NewSearchService(nil, nil)
+
+		err := searchSrv.StreamProductsList(context.Background(), domain.SearchQuery{}, func(domain.SourceResult) {
+			t.Fail()
+		})
+		assert.ErrorIs(t, err, domain.ErrEmptyProductName)
+	})
 }
 
 func TestParserService_ValidateSearchArgs(t *testing.T) {
@@ -162,9 +383,16 @@ func TestParserService_ValidateSearchArgs(t *testing.T) {
 			name:      "price from above price to",
 			prodName:  "prod",
 			priceFrom: 250.0,
-			priceTo:   0.0,
+			priceTo:   200.0,
 			expErr:    true,
 		},
+		{
+			name:      "price from without price to",
+			prodName:  "prod",
+			priceFrom: 250.0,
+			priceTo:   0.0,
+			expErr:    false,
+		},
 		{
 			name:      "price to below zero",
 			prodName:  "prod",
@@ -186,3 +414,216 @@ func TestParserService_ValidateSearchArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestParserService_GetCategoryProducts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    domain.CategoryQuery
+		products []domain.Product
+		repoErr  error
+		expErr   error
+		// expProducts are the filtered products, they are the same as products if nil
+		expProducts []domain.Product
+	}{
+		{
+			name:  "valid",
+			query: domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika", PriceTo: 500.0},
+			products: []domain.Product{
+				{
+					Name:         "prod",
+					Link:         "link1",
+					Price:        100.0,
+					Rating:       5.0,
+					ReviewsCount: 10,
+				},
+			},
+		},
+		{
+			name:  "price range",
+			query: domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika", PriceFrom: 200.0, PriceTo: 500.0},
+			products: []domain.Product{
+				{Name: "cheap", Link: "link1", Price: 100.0},
+				{Name: "lower limit", Link: "link2", Price: 200.0},
+				{Name: "upper limit", Link: "link3", Price: 500.0},
+				{Name: "expensive", Link: "link4", Price: 700.0},
+			},
+			expProducts: []domain.Product{
+				{Name: "lower limit", Link: "link2", Price: 200.0},
+				{Name: "upper limit", Link: "link3", Price: 500.0},
+			},
+		},
+		{
+			name:   "empty category",
+			query:  domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: " "},
+			expErr: domain.ErrEmptyCategory,
+		},
+		{
+			name:  "price from without price to",
+			query: domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika", PriceFrom: 250.0},
+			products: []domain.Product{
+				{Name: "cheap", Link: "link1", Price: 100.0},
+				{Name: "expensive", Link: "link2", Price: 700.0},
+			},
+			expProducts: []domain.Product{
+				{Name: "expensive", Link: "link2", Price: 700.0},
+			},
+		},
+		{
+			name:   "price from above price to",
+			query:  domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika", PriceFrom: 250.0, PriceTo: 200.0},
+			expErr: domain.ErrPriceFromAbovePriceTo,
+		},
+		{
+			name:   "unknown marketplace",
+			query:  domain.CategoryQuery{Marketplace: domain.Marketplace("unknown"), Category: "catalog/elektronika"},
+			expErr: domain.ErrUnknownMarketplace,
+		},
+		{
+			name:    "invalid category",
+			query:   domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "https://example.com"},
+			repoErr: repository.ErrInvalidCategory,
+			expErr:  domain.ErrInvalidCategory,
+		},
+		{
+			name:    "gateway timeout",
+			query:   domain.CategoryQuery{Marketplace: domain.MarketplaceWildberries, Category: "catalog/elektronika"},
+			repoErr: repository.ErrGatewayTimeout,
+			expErr:  domain.ErrGatewayTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			searchRepo := &mocks.SearchRepositoryMock{}
+			searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+			searchRepo.On("Marketplace").Return(domain.MarketplaceWildberries).Maybe()
+			if tc.products != nil || tc.repoErr != nil {
+				searchRepo.On("GetCategoryProducts", mock.Anything, tc.query).Return(tc.products, tc.repoErr).Once()
+			}
+
+			res, err := searchSrv.GetCategoryProducts(context.Background(), tc.query)
+			if tc.expErr != nil {
+				assert.ErrorIs(t, err, tc.expErr)
+			} else {
+				assert.NoError(t, err)
+				exp := tc.products
+				if tc.expProducts != nil {
+					exp = tc.expProducts
+				}
+				assert.ElementsMatch(t, exp, res)
+			}
+
+			searchRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParserService_GetProduct(t *testing.T) {
+	product := domain.Product{ID: "123", Name: "prod", Link: "link", Price: 100.0, InStock: true}
+
+	testCases := []struct {
+		name     string
+		query    domain.ProductQuery
+		expQuery domain.ProductQuery
+		repoErr  error
+		expErr   error
+	}{
+		{
+			name:     "valid",
+			query:    domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: " 123 ", Region: "Moscow"},
+			expQuery: domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123", Region: "Moscow"},
+		},
+		{
+			name:   "empty product id",
+			query:  domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: " "},
+			expErr: domain.ErrEmptyProductID,
+		},
+		{
+			name:   "unknown marketplace",
+			query:  domain.ProductQuery{Marketplace: domain.Marketplace("unknown"), ID: "123"},
+			expErr: domain.ErrUnknownMarketplace,
+		},
+		{
+			name:     "product not found",
+			query:    domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"},
+			expQuery: domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"},
+			repoErr:  repository.ErrProductNotFound,
+			expErr:   domain.ErrProductNotFound,
+		},
+		{
+			name:     "gateway timeout",
+			query:    domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"},
+			expQuery: domain.ProductQuery{Marketplace: domain.MarketplaceWildberries, ID: "123"},
+			repoErr:  repository.ErrGatewayTimeout,
+			expErr:   domain.ErrGatewayTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			searchRepo := &mocks.SearchRepositoryMock{}
+			searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+			searchRepo.On("Marketplace").Return(domain.MarketplaceWildberries).Maybe()
+			if tc.expQuery.ID != "" {
+				searchRepo.On("GetProduct", mock.Anything, tc.expQuery).Return(product, tc.repoErr).Once()
+			}
+
+			res, err := searchSrv.GetProduct(context.Background(), tc.query)
+			if tc.expErr != nil {
+				assert.ErrorIs(t, err, tc.expErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, product, res)
+			}
+
+			searchRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParserService_GetSuggestions(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		wbRepo := &mocks.SearchRepositoryMock{}
+		ozonRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{ozonRepo, wbRepo}, nil)
+
+		ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+		ozonRepo.On("GetSuggestions", mock.Anything, "соков").Return([]string{"соковыжималка"}, nil).Once()
+		wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		wbRepo.On("GetSuggestions", mock.Anything, "соков").Return([]string{"соковарка"}, nil).Once()
+
+		res, err := searchSrv.GetSuggestions(context.Background(), " соков ")
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Suggestions{
+			{Marketplace: domain.MarketplaceOzon, Queries: []string{"соковыжималка"}},
+			{Marketplace: domain.MarketplaceWildberries, Queries: []string{"соковарка"}},
+		}, res)
+
+		ozonRepo.AssertExpectations(t)
+		wbRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		_, err := searchSrv.GetSuggestions(context.Background(), " ")
+		assert.ErrorIs(t, err, domain.ErrEmptyPrefix)
+
+		searchRepo.AssertNotCalled(t, "GetSuggestions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("gateway timeout", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		searchSrv := usecase.NewSearchService([]repository.SearchRepository{searchRepo}, nil)
+
+		searchRepo.On("GetSuggestions", mock.Anything, "соков").Return(nil, repository.ErrGatewayTimeout).Once()
+
+		_, err := searchSrv.GetSuggestions(context.Background(), "соков")
+		assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+		searchRepo.AssertExpectations(t)
+	})
+}
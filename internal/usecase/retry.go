@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// RetryPolicy describes how a source is retried after a transient failure.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one, the source isn't retried if it's less than 2.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, it doubles with every next one up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// delay returns the backoff before the retry after the given failed attempt, a random half of it is the jitter,
+// so the retries of parallel requests don't hit the marketplace at the same time.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+// retrySource retries the failed calls of the source with the exponential backoff.
+type retrySource struct {
+	repository.SearchRepository
+	policy RetryPolicy
+	logger logger.Logger
+}
+
+// WithRetry wraps every source into the retry of its marketplace policy, the sources without a policy are returned as is.
+func WithRetry(sources []repository.SearchRepository, policies map[domain.Marketplace]RetryPolicy, logger logger.Logger) []repository.SearchRepository {
+	res := make([]repository.SearchRepository, 0, len(sources))
+	for _, source := range sources {
+		policy, ok := policies[source.Marketplace()]
+		if !ok || policy.MaxAttempts < 2 {
+			res = append(res, source)
+			continue
+		}
+		res = append(res, NewRetrySource(source, policy, logger))
+	}
+
+	return res
+}
+
+// NewRetrySource wraps the source into the retry of the policy.
+func NewRetrySource(source repository.SearchRepository, policy RetryPolicy, logger logger.Logger) *retrySource {
+	return &retrySource{SearchRepository: source, policy: policy, logger: logger}
+}
+
+func (s *retrySource) GetAllProducts(ctx context.Context, query domain.SearchQuery) ([]domain.Product, error) {
+	var res []domain.Product
+	err := s.retry(ctx, "search", func() (err error) {
+		res, err = s.SearchRepository.GetAllProducts(ctx, query)
+		return err
+	})
+
+	return res, err
+}
+
+func (s *retrySource) GetCategoryProducts(ctx context.Context, query domain.CategoryQuery) ([]domain.Product, error) {
+	var res []domain.Product
+	err := s.retry(ctx, "category", func() (err error) {
+		res, err = s.SearchRepository.GetCategoryProducts(ctx, query)
+		return err
+	})
+
+	return res, err
+}
+
+func (s *retrySource) GetProduct(ctx context.Context, query domain.ProductQuery) (domain.Product, error) {
+	var res domain.Product
+	err := s.retry(ctx, "product", func() (err error) {
+		res, err = s.SearchRepository.GetProduct(ctx, query)
+		return err
+	})
+
+	return res, err
+}
+
+func (s *retrySource) GetSuggestions(ctx context.Context, prefix string) ([]string, error) {
+	var res []string
+	err := s.retry(ctx, "suggestions", func() (err error) {
+		res, err = s.SearchRepository.GetSuggestions(ctx, prefix)
+		return err
+	})
+
+	return res, err
+}
+
+// retry calls fn until it succeeds, fails with a non-retryable error or the attempts are over.
+// It doesn't wait for the next attempt if the backoff ends after the ctx deadline, the last error is returned then.
+func (s *retrySource) retry(ctx context.Context, operation string, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= s.policy.MaxAttempts || !retryable(ctx, err) {
+			return err
+		}
+
+		delay := s.policy.delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return err
+		}
+
+		s.logger.Warn("retry source",
+			"marketplace", s.Marketplace(),
+			"operation", operation,
+			"attempt", attempt,
+			"delay", delay,
+			"err", err,
+		)
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+// retryable reports whether the failure is classified as transient by the browser adapter: a navigation error,
+// a DOM stability timeout or a dropped CDP connection. The other failures like the blocked pages, the missing
+// elements and the failures after the ctx is done aren't retried.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrNavigation),
+		errors.Is(err, repository.ErrDOMUnstable),
+		errors.Is(err, repository.ErrConnectionLost):
+		return true
+	default:
+		return false
+	}
+}
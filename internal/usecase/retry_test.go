@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestRetrySource_GetAllProducts(t *testing.T) {
+	query := domain.SearchQuery{Name: "prod", PriceTo: 500.0}
+	prods := []domain.Product{{Name: "prod", Link: "link1", Price: 100.0}}
+	policy := usecase.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	errNavigate := fmt.Errorf("navigate page with referer: %w", repository.ErrNavigation)
+
+	t.Run("transient failure", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, policy, loggerMock)
+
+		searchRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, errNavigate).Twice()
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		loggerMock.On("Warn", "retry source", mock.Anything).Twice()
+
+		res, err := source.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("attempts are over", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, policy, loggerMock)
+
+		searchRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, errNavigate).Times(3)
+		loggerMock.On("Warn", "retry source", mock.Anything).Twice()
+
+		_, err := source.GetAllProducts(context.Background(), query)
+		assert.ErrorIs(t, err, errNavigate)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("blocked source", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, policy, loggerMock)
+
+		repoErr := &repository.BlockedError{URL: "url", Marker: "captcha", RetryAfter: time.Minute}
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, repoErr).Once()
+
+		_, err := source.GetAllProducts(context.Background(), query)
+		assert.ErrorIs(t, err, repository.ErrSourceBlocked)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertNotCalled(t, "Warn", mock.Anything, mock.Anything)
+	})
+
+	t.Run("selector failure", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, policy, loggerMock)
+
+		// The missing element is a changed page layout, not a transient failure
+		errSelector := fmt.Errorf("element items: %w", errors.New("cannot find element"))
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, errSelector).Once()
+
+		_, err := source.GetAllProducts(context.Background(), query)
+		assert.ErrorIs(t, err, errSelector)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertNotCalled(t, "Warn", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dropped connection", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, policy, loggerMock)
+
+		errConnection := fmt.Errorf("wait dom stable: %w", repository.ErrConnectionLost)
+		searchRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, errConnection).Once()
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(prods, nil).Once()
+		loggerMock.On("Warn", "retry source", mock.Anything).Once()
+
+		res, err := source.GetAllProducts(context.Background(), query)
+		assert.NoError(t, err)
+		assert.Equal(t, prods, res)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+	})
+
+	t.Run("backoff after deadline", func(t *testing.T) {
+		searchRepo := &mocks.SearchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		source := usecase.NewRetrySource(searchRepo, usecase.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute}, loggerMock)
+
+		searchRepo.On("GetAllProducts", mock.Anything, query).Return(nil, errNavigate).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := source.GetAllProducts(ctx, query)
+		assert.ErrorIs(t, err, errNavigate)
+
+		searchRepo.AssertExpectations(t)
+		loggerMock.AssertNotCalled(t, "Warn", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRetrySource_WithRetry(t *testing.T) {
+	wbRepo := &mocks.SearchRepositoryMock{}
+	ozonRepo := &mocks.SearchRepositoryMock{}
+	loggerMock := &mocks.LoggerMock{}
+
+	wbRepo.On("Marketplace").Return(domain.MarketplaceWildberries)
+	ozonRepo.On("Marketplace").Return(domain.MarketplaceOzon)
+
+	sources := usecase.WithRetry([]repository.SearchRepository{wbRepo, ozonRepo}, map[domain.Marketplace]usecase.RetryPolicy{
+		domain.MarketplaceWildberries: {MaxAttempts: 3},
+		domain.MarketplaceOzon:        {MaxAttempts: 1},
+	}, loggerMock)
+	assert.Len(t, sources, 2)
+	assert.NotSame(t, wbRepo, sources[0])
+	assert.Equal(t, domain.MarketplaceWildberries, sources[0].Marketplace())
+	// A single attempt needs no retry
+	assert.Same(t, ozonRepo, sources[1])
+}
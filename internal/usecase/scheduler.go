@@ -0,0 +1,380 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+)
+
+// SchedulerService runs the recurring searches on their cron schedules and passes the results to the result sink.
+type SchedulerService interface {
+	// CreateSchedule validates and saves the schedule, its id is generated.
+	CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error)
+	// ListSchedules returns the schedules with their last runs and the planned next runs.
+	ListSchedules(ctx context.Context) ([]domain.Schedule, error)
+	// DeleteSchedule removes the schedule created via the API, its running search isn't canceled.
+	DeleteSchedule(ctx context.Context, id string) error
+}
+
+// SchedulerConfig describes the runs of the schedules.
+type SchedulerConfig struct {
+	// Tick is how often the due schedules are looked for.
+	Tick time.Duration
+	// MaxConcurrent is the number of the schedules run at the same time.
+	MaxConcurrent int
+	// Jitter is the maximum random delay of a run after its scheduled time.
+	Jitter time.Duration
+	// Timeout is the deadline of the search of a run.
+	Timeout time.Duration
+	// MinInterval is the shortest time allowed between the runs of a schedule.
+	MinInterval time.Duration
+	// Schedules are the schedules defined in the config.
+	Schedules []domain.Schedule
+}
+
+type schedulerService struct {
+	parser    ParserService
+	schedules repository.ScheduleRepository
+	sink      repository.ResultSink
+	cfg       *SchedulerConfig
+	logger    logger.Logger
+	slots     chan struct{}
+	wg        sync.WaitGroup
+
+	// mu guards the planned runs and the running schedules.
+	mu      sync.Mutex
+	next    map[string]time.Time
+	running map[string]bool
+}
+
+func NewSchedulerService(parser ParserService, schedules repository.ScheduleRepository, sink repository.ResultSink, cfg *SchedulerConfig, logger logger.Logger) *schedulerService {
+	return &schedulerService{
+		parser:    parser,
+		schedules: schedules,
+		sink:      sink,
+		cfg:       cfg,
+		logger:    logger,
+		slots:     make(chan struct{}, max(cfg.MaxConcurrent, 1)),
+		next:      make(map[string]time.Time),
+		running:   make(map[string]bool),
+	}
+}
+
+// LoadSchedules saves the schedules of the config and removes the ones that were removed from the config.
+// The last runs of the kept schedules are preserved.
+func (s *schedulerService) LoadSchedules(ctx context.Context) error {
+	ids := make(map[string]bool, len(s.cfg.Schedules))
+	for _, schedule := range s.cfg.Schedules {
+		if strings.TrimSpace(schedule.ID) == "" {
+			return fmt.Errorf("schedule %q: empty id", schedule.Cron)
+		}
+		if ids[schedule.ID] {
+			return fmt.Errorf("schedule %s: duplicate id", schedule.ID)
+		}
+		ids[schedule.ID] = true
+
+		if err := s.validateSchedule(&schedule); err != nil {
+			return fmt.Errorf("schedule %s: %w", schedule.ID, err)
+		}
+
+		schedule.FromConfig = true
+		schedule.CreatedAt = time.Now()
+		current, err := s.schedules.GetSchedule(ctx, schedule.ID)
+		switch {
+		case err == nil && !current.FromConfig:
+			return fmt.Errorf("schedule %s: id is taken by a schedule created via the API", schedule.ID)
+		case err == nil:
+			schedule.CreatedAt = current.CreatedAt
+			schedule.LastRun = current.LastRun
+		case !errors.Is(err, repository.ErrScheduleNotFound):
+			return fmt.Errorf("get schedule: %w", err)
+		}
+
+		if err := s.schedules.SaveSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("save schedule: %w", err)
+		}
+	}
+
+	schedules, err := s.schedules.ListSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("list schedules: %w", err)
+	}
+	for _, schedule := range schedules {
+		if schedule.FromConfig && !ids[schedule.ID] {
+			if err := s.schedules.DeleteSchedule(ctx, schedule.ID); err != nil {
+				return fmt.Errorf("delete schedule: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run starts the due schedules every tick until ctx is done, the started runs are waited for then.
+func (s *schedulerService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Tick)
+	defer ticker.Stop()
+
+	for {
+		s.RunDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue starts the schedules whose planned run has come, as long as there are free slots. A due schedule
+// without a slot stays due until the next call. The runs are planned after the last run or the creation of
+// the schedule, so a run missed while the service was down is started once.
+func (s *schedulerService) RunDue(ctx context.Context) {
+	schedules, err := s.schedules.ListSchedules(ctx)
+	if err != nil {
+		s.logger.Error("list schedules", "err", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	ids := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		ids[schedule.ID] = true
+		if s.running[schedule.ID] {
+			continue
+		}
+
+		next, ok := s.next[schedule.ID]
+		if !ok {
+			from := schedule.CreatedAt
+			if schedule.LastRun.StartedAt.After(from) {
+				from = schedule.LastRun.StartedAt
+			}
+			next, err = s.plan(schedule, from)
+			if err != nil {
+				s.logger.Error("plan schedule", "id", schedule.ID, "err", err)
+				continue
+			}
+			s.next[schedule.ID] = next
+		}
+		if now.Before(next) {
+			continue
+		}
+
+		select {
+		case s.slots <- struct{}{}:
+		default:
+			continue
+		}
+
+		if next, err = s.plan(schedule, now); err == nil {
+			s.next[schedule.ID] = next
+		}
+		s.running[schedule.ID] = true
+		s.wg.Add(1)
+		go s.run(ctx, schedule)
+	}
+
+	// Forget the deleted schedules
+	for id := range s.next {
+		if !ids[id] {
+			delete(s.next, id)
+		}
+	}
+}
+
+// Wait waits for the runs started by RunDue.
+func (s *schedulerService) Wait() {
+	s.wg.Wait()
+}
+
+// plan returns the time of the first run of the schedule after the time with a random jitter.
+func (s *schedulerService) plan(schedule domain.Schedule, after time.Time) (time.Time, error) {
+	sched, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", domain.ErrInvalidCron, err)
+	}
+
+	next := sched.Next(after)
+	if s.cfg.Jitter > 0 {
+		next = next.Add(rand.N(s.cfg.Jitter))
+	}
+
+	return next, nil
+}
+
+// run searches the query of the schedule and stores the result, the status of the run is saved
+// before and after the search.
+func (s *schedulerService) run(ctx context.Context, schedule domain.Schedule) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, schedule.ID)
+		s.mu.Unlock()
+		<-s.slots
+	}()
+
+	run := domain.ScheduleRun{Status: domain.JobRunning, StartedAt: time.Now()}
+	s.saveRun(ctx, schedule.ID, run)
+
+	runCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	query := schedule.Query
+	query.NoCache = true
+	res, err := s.parser.GetProductsList(runCtx, query)
+	if err == nil {
+		run.Products = len(res.Products)
+		run.TimedOut = res.TimedOut
+		err = s.sink.Store(ctx, domain.ScheduledResult{
+			ScheduleID: schedule.ID,
+			Query:      schedule.Query,
+			StartedAt:  run.StartedAt,
+			Products:   res.Products,
+			TimedOut:   res.TimedOut,
+		})
+		if err != nil {
+			err = fmt.Errorf("store result: %w", err)
+		}
+	}
+
+	run.FinishedAt = time.Now()
+	switch {
+	case err == nil:
+		run.Status = domain.JobSucceeded
+	case ctx.Err() != nil:
+		run.Status = domain.JobCanceled
+	default:
+		s.logger.Warn("scheduled search failed", "id", schedule.ID, "duration", run.Duration(), "err", err)
+		run.Status = domain.JobFailed
+		run.Error = err.Error()
+	}
+
+	// The canceled run is saved on shutdown too
+	s.saveRun(context.WithoutCancel(ctx), schedule.ID, run)
+}
+
+func (s *schedulerService) saveRun(ctx context.Context, id string, run domain.ScheduleRun) {
+	if err := s.schedules.SaveScheduleRun(ctx, id, run); err != nil && !errors.Is(err, repository.ErrScheduleNotFound) {
+		s.logger.Error("save schedule run", "id", id, "err", err)
+	}
+}
+
+func (s *schedulerService) CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error) {
+	if err := s.validateSchedule(&schedule); err != nil {
+		return domain.Schedule{}, err
+	}
+
+	id, err := randomHex(16)
+	if err != nil {
+		return domain.Schedule{}, fmt.Errorf("new schedule id: %w", err)
+	}
+	schedule.ID = id
+	schedule.FromConfig = false
+	schedule.CreatedAt = time.Now()
+	schedule.LastRun = domain.ScheduleRun{}
+	schedule.NextRunAt = time.Time{}
+
+	if err := s.schedules.SaveSchedule(ctx, schedule); err != nil {
+		return domain.Schedule{}, fmt.Errorf("save schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *schedulerService) ListSchedules(ctx context.Context) ([]domain.Schedule, error) {
+	schedules, err := s.schedules.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range schedules {
+		schedules[i].NextRunAt = s.next[schedules[i].ID]
+	}
+
+	return schedules, nil
+}
+
+func (s *schedulerService) DeleteSchedule(ctx context.Context, id string) error {
+	schedule, err := s.schedules.GetSchedule(ctx, id)
+	if err != nil {
+		return mapScheduleError(err)
+	}
+	if schedule.FromConfig {
+		return domain.ErrScheduleFromConfig
+	}
+
+	return mapScheduleError(s.schedules.DeleteSchedule(ctx, id))
+}
+
+// validateSchedule checks the cron expression, the interval between its runs and the search of the schedule.
+func (s *schedulerService) validateSchedule(schedule *domain.Schedule) error {
+	schedule.Cron = strings.TrimSpace(schedule.Cron)
+	sched, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidCron, err)
+	}
+	if !runsApart(sched, s.cfg.MinInterval) {
+		return fmt.Errorf("%w: runs are less than %s apart", domain.ErrInvalidCron, s.cfg.MinInterval)
+	}
+
+	schedule.Query.Name = strings.TrimSpace(schedule.Query.Name)
+	if err := ValidateSearchArgs(schedule.Query.Name, schedule.Query.PriceFrom, schedule.Query.PriceTo); err != nil {
+		return err
+	}
+	for _, m := range schedule.Query.Marketplaces {
+		if !m.Valid() {
+			return domain.ErrUnknownMarketplace
+		}
+	}
+
+	return nil
+}
+
+// runsApart reports whether the runs of the schedule within a year are at least the interval apart. A year covers
+// the ends of the months, so the shortest interval of an expression with the days of the month is found too.
+func runsApart(sched cron.Schedule, interval time.Duration) bool {
+	if interval <= 0 {
+		return true
+	}
+
+	prev := sched.Next(time.Now())
+	end := prev.AddDate(1, 0, 0)
+	for !prev.IsZero() && prev.Before(end) {
+		next := sched.Next(prev)
+		// The zero time is returned if there is no run within the next years
+		if next.IsZero() {
+			return true
+		}
+		if next.Sub(prev) < interval {
+			return false
+		}
+		prev = next
+	}
+
+	return true
+}
+
+func mapScheduleError(err error) error {
+	if errors.Is(err, repository.ErrScheduleNotFound) {
+		return domain.ErrScheduleNotFound
+	}
+
+	return err
+}
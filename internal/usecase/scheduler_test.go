@@ -0,0 +1,314 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+func TestSchedulerService_LoadSchedules(t *testing.T) {
+	lastRun := domain.ScheduleRun{Status: domain.JobSucceeded, StartedAt: time.Now().Add(-time.Hour), FinishedAt: time.Now().Add(-time.Hour), Products: 5}
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		cfg := &usecase.SchedulerConfig{Schedules: []domain.Schedule{
+			{ID: "phones", Cron: " 0 */6 * * * ", Query: domain.SearchQuery{Name: "iphone", Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}}},
+			{ID: "laptops", Cron: "@daily", Query: domain.SearchQuery{Name: "laptop", PriceTo: 100000.0}},
+		}}
+		svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, cfg, &mocks.LoggerMock{})
+
+		scheduleRepo.On("GetSchedule", mock.Anything, "phones").
+			Return(domain.Schedule{ID: "phones", Cron: "@hourly", FromConfig: true, CreatedAt: createdAt, LastRun: lastRun}, nil).Once()
+		scheduleRepo.On("GetSchedule", mock.Anything, "laptops").Return(domain.Schedule{}, repository.ErrScheduleNotFound).Once()
+		scheduleRepo.On("SaveSchedule", mock.Anything, mock.Anything).Return(nil).Twice()
+		scheduleRepo.On("ListSchedules", mock.Anything).Return([]domain.Schedule{
+			{ID: "phones", FromConfig: true},
+			{ID: "removed", FromConfig: true},
+			{ID: "api"},
+			{ID: "laptops", FromConfig: true},
+		}, nil).Once()
+		scheduleRepo.On("DeleteSchedule", mock.Anything, "removed").Return(nil).Once()
+
+		assert.NoError(t, svc.LoadSchedules(context.Background()))
+
+		scheduleRepo.AssertExpectations(t)
+
+		phones := scheduleRepo.Calls[1].Arguments.Get(1).(domain.Schedule)
+		assert.Equal(t, "0 */6 * * *", phones.Cron)
+		assert.True(t, phones.FromConfig)
+		assert.Equal(t, createdAt, phones.CreatedAt)
+		assert.Equal(t, lastRun, phones.LastRun)
+
+		laptops := scheduleRepo.Calls[3].Arguments.Get(1).(domain.Schedule)
+		assert.Equal(t, "laptops", laptops.ID)
+		assert.True(t, laptops.FromConfig)
+		assert.False(t, laptops.CreatedAt.IsZero())
+		assert.Equal(t, domain.ScheduleRun{}, laptops.LastRun)
+	})
+
+	testCases := []struct {
+		name      string
+		schedules []domain.Schedule
+		err       error
+	}{
+		{
+			name:      "invalid cron",
+			schedules: []domain.Schedule{{ID: "phones", Cron: "every hour", Query: domain.SearchQuery{Name: "iphone"}}},
+			err:       domain.ErrInvalidCron,
+		},
+		{
+			name:      "empty query",
+			schedules: []domain.Schedule{{ID: "phones", Cron: "@hourly"}},
+			err:       domain.ErrEmptyProductName,
+		},
+		{
+			name:      "unknown marketplace",
+			schedules: []domain.Schedule{{ID: "phones", Cron: "@hourly", Query: domain.SearchQuery{Name: "iphone", Marketplaces: []domain.Marketplace{"amazon"}}}},
+			err:       domain.ErrUnknownMarketplace,
+		}, {
+			name:      "runs too often",
+			schedules: []domain.Schedule{{ID: "phones", Cron: "0,30 * * * *", Query: domain.SearchQuery{Name: "iphone"}}},
+			err:       domain.ErrInvalidCron,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheduleRepo := &mocks.ScheduleRepositoryMock{}
+			svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, &usecase.SchedulerConfig{MinInterval: time.Hour, Schedules: tc.schedules}, &mocks.LoggerMock{})
+
+			assert.ErrorIs(t, svc.LoadSchedules(context.Background()), tc.err)
+
+			scheduleRepo.AssertNotCalled(t, "SaveSchedule", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("duplicate id", func(t *testing.T) {
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		cfg := &usecase.SchedulerConfig{Schedules: []domain.Schedule{
+			{ID: "phones", Cron: "@hourly", Query: domain.SearchQuery{Name: "iphone"}},
+			{ID: "phones", Cron: "@daily", Query: domain.SearchQuery{Name: "iphone"}},
+		}}
+		svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, cfg, &mocks.LoggerMock{})
+
+		scheduleRepo.On("GetSchedule", mock.Anything, "phones").Return(domain.Schedule{}, repository.ErrScheduleNotFound).Once()
+		scheduleRepo.On("SaveSchedule", mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.ErrorContains(t, svc.LoadSchedules(context.Background()), "duplicate id")
+	})
+
+	t.Run("id of api schedule", func(t *testing.T) {
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		cfg := &usecase.SchedulerConfig{Schedules: []domain.Schedule{{ID: "phones", Cron: "@hourly", Query: domain.SearchQuery{Name: "iphone"}}}}
+		svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, cfg, &mocks.LoggerMock{})
+
+		scheduleRepo.On("GetSchedule", mock.Anything, "phones").Return(domain.Schedule{ID: "phones"}, nil).Once()
+
+		assert.Error(t, svc.LoadSchedules(context.Background()))
+
+		scheduleRepo.AssertNotCalled(t, "SaveSchedule", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSchedulerService_RunDue(t *testing.T) {
+	cfg := &usecase.SchedulerConfig{MaxConcurrent: 1, Timeout: time.Second}
+	query := domain.SearchQuery{Name: "iphone", Marketplaces: []domain.Marketplace{domain.MarketplaceOzon}}
+	searchQuery := query
+	searchQuery.NoCache = true
+	// The run at the start of the last hour was missed
+	due := domain.Schedule{ID: "due", Cron: "@hourly", Query: query, CreatedAt: time.Now().Add(-2 * time.Hour)}
+	notDue := domain.Schedule{ID: "not due", Cron: "@yearly", Query: query, CreatedAt: time.Now()}
+
+	t.Run("success", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		sink := &mocks.ResultSinkMock{}
+		svc := usecase.NewSchedulerService(parserSvc, scheduleRepo, sink, cfg, &mocks.LoggerMock{})
+
+		products := []domain.Product{{ID: "1", Name: "iphone", Price: 90000.0}}
+		scheduleRepo.On("ListSchedules", mock.Anything).Return([]domain.Schedule{notDue, due}, nil)
+		scheduleRepo.On("SaveScheduleRun", mock.Anything, "due", mock.Anything).Return(nil).Twice()
+		parserSvc.On("GetProductsList", mock.Anything, searchQuery).
+			Return(domain.SearchResult{Products: products, TimedOut: []domain.Marketplace{domain.MarketplaceWildberries}}, nil).Once()
+		sink.On("Store", mock.Anything, mock.Anything).Return(nil).Once()
+
+		start := time.Now()
+		svc.RunDue(context.Background())
+		svc.Wait()
+
+		parserSvc.AssertExpectations(t)
+		scheduleRepo.AssertExpectations(t)
+		sink.AssertExpectations(t)
+
+		result := sink.Calls[0].Arguments.Get(1).(domain.ScheduledResult)
+		assert.Equal(t, "due", result.ScheduleID)
+		assert.Equal(t, query, result.Query)
+		assert.Equal(t, products, result.Products)
+
+		running := scheduleRepo.Calls[1].Arguments.Get(2).(domain.ScheduleRun)
+		assert.Equal(t, domain.JobRunning, running.Status)
+		run := scheduleRepo.Calls[2].Arguments.Get(2).(domain.ScheduleRun)
+		assert.Equal(t, domain.JobSucceeded, run.Status)
+		assert.Equal(t, running.StartedAt, run.StartedAt)
+		assert.Equal(t, result.StartedAt, run.StartedAt)
+		assert.Equal(t, 1, run.Products)
+		assert.Equal(t, []domain.Marketplace{domain.MarketplaceWildberries}, run.TimedOut)
+		assert.GreaterOrEqual(t, run.Duration(), time.Duration(0))
+
+		// The next runs are planned without the jitter
+		list, err := svc.ListSchedules(context.Background())
+		assert.NoError(t, err)
+		assert.WithinRange(t, list[1].NextRunAt, start, start.Add(time.Hour))
+		assert.Zero(t, list[1].NextRunAt.Minute())
+		assert.True(t, list[0].NextRunAt.After(start))
+
+		// Nothing is due until the next hour
+		svc.RunDue(context.Background())
+		svc.Wait()
+		parserSvc.AssertNumberOfCalls(t, "GetProductsList", 1)
+	})
+
+	t.Run("search failed", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		sink := &mocks.ResultSinkMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewSchedulerService(parserSvc, scheduleRepo, sink, cfg, loggerMock)
+
+		errSearch := errors.New("search failed")
+		scheduleRepo.On("ListSchedules", mock.Anything).Return([]domain.Schedule{due}, nil).Once()
+		scheduleRepo.On("SaveScheduleRun", mock.Anything, "due", mock.Anything).Return(nil).Twice()
+		parserSvc.On("GetProductsList", mock.Anything, searchQuery).Return(domain.SearchResult{}, errSearch).Once()
+		loggerMock.On("Warn", "scheduled search failed", mock.Anything).Once()
+
+		svc.RunDue(context.Background())
+		svc.Wait()
+
+		scheduleRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+		sink.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+
+		run := scheduleRepo.Calls[2].Arguments.Get(2).(domain.ScheduleRun)
+		assert.Equal(t, domain.JobFailed, run.Status)
+		assert.Equal(t, errSearch.Error(), run.Error)
+		assert.False(t, run.FinishedAt.IsZero())
+	})
+
+	t.Run("concurrency cap", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		sink := &mocks.ResultSinkMock{}
+		svc := usecase.NewSchedulerService(parserSvc, scheduleRepo, sink, cfg, &mocks.LoggerMock{})
+
+		other := due
+		other.ID = "other"
+		release := make(chan struct{})
+		scheduleRepo.On("ListSchedules", mock.Anything).Return([]domain.Schedule{due, other}, nil)
+		scheduleRepo.On("SaveScheduleRun", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		parserSvc.On("GetProductsList", mock.Anything, searchQuery).
+			Run(func(mock.Arguments) { <-release }).
+			Return(domain.SearchResult{}, nil)
+		sink.On("Store", mock.Anything, mock.Anything).Return(nil)
+
+		// The second schedule waits for the slot of the running first one
+		svc.RunDue(context.Background())
+		svc.RunDue(context.Background())
+		close(release)
+		svc.Wait()
+		parserSvc.AssertNumberOfCalls(t, "GetProductsList", 1)
+		scheduleRepo.AssertCalled(t, "SaveScheduleRun", mock.Anything, "due", mock.Anything)
+
+		svc.RunDue(context.Background())
+		svc.Wait()
+		parserSvc.AssertNumberOfCalls(t, "GetProductsList", 2)
+		scheduleRepo.AssertCalled(t, "SaveScheduleRun", mock.Anything, "other", mock.Anything)
+	})
+}
+
+func TestSchedulerService_CreateSchedule(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, &usecase.SchedulerConfig{MinInterval: time.Hour}, &mocks.LoggerMock{})
+
+		scheduleRepo.On("SaveSchedule", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := svc.CreateSchedule(context.Background(), domain.Schedule{
+			ID:         "custom",
+			Cron:       "CRON_TZ=Europe/Moscow 30 9 * * 1-5",
+			Query:      domain.SearchQuery{Name: " iphone ", PriceTo: 90000.0},
+			FromConfig: true,
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.ID)
+		assert.NotEqual(t, "custom", res.ID)
+		assert.False(t, res.FromConfig)
+		assert.False(t, res.CreatedAt.IsZero())
+		assert.Equal(t, "iphone", res.Query.Name)
+
+		scheduleRepo.AssertCalled(t, "SaveSchedule", mock.Anything, res)
+	})
+
+	t.Run("invalid cron", func(t *testing.T) {
+		scheduleRepo := &mocks.ScheduleRepositoryMock{}
+		svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, &usecase.SchedulerConfig{}, &mocks.LoggerMock{})
+
+		_, err := svc.CreateSchedule(context.Background(), domain.Schedule{Cron: "* * *", Query: domain.SearchQuery{Name: "iphone"}})
+		assert.ErrorIs(t, err, domain.ErrInvalidCron)
+
+		scheduleRepo.AssertNotCalled(t, "SaveSchedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("runs too often", func(t *testing.T) {
+		// The runs on the last and the first day of the month are a day apart
+		for _, expr := range []string{"@every 1s", "0 12 1,31 * *"} {
+			scheduleRepo := &mocks.ScheduleRepositoryMock{}
+			svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, &usecase.SchedulerConfig{MinInterval: 48 * time.Hour}, &mocks.LoggerMock{})
+
+			_, err := svc.CreateSchedule(context.Background(), domain.Schedule{Cron: expr, Query: domain.SearchQuery{Name: "iphone"}})
+			assert.ErrorIs(t, err, domain.ErrInvalidCron, expr)
+
+			scheduleRepo.AssertNotCalled(t, "SaveSchedule", mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestSchedulerService_DeleteSchedule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule domain.Schedule
+		getErr   error
+		err      error
+	}{
+		{name: "success", schedule: domain.Schedule{ID: "id"}},
+		{name: "from config", schedule: domain.Schedule{ID: "id", FromConfig: true}, err: domain.ErrScheduleFromConfig},
+		{name: "not found", getErr: repository.ErrScheduleNotFound, err: domain.ErrScheduleNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheduleRepo := &mocks.ScheduleRepositoryMock{}
+			svc := usecase.NewSchedulerService(&mocks.ParserServiceMock{}, scheduleRepo, &mocks.ResultSinkMock{}, &usecase.SchedulerConfig{}, &mocks.LoggerMock{})
+
+			scheduleRepo.On("GetSchedule", mock.Anything, "id").Return(tc.schedule, tc.getErr).Once()
+			scheduleRepo.On("DeleteSchedule", mock.Anything, "id").Return(nil).Maybe()
+
+			err := svc.DeleteSchedule(context.Background(), "id")
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				scheduleRepo.AssertNotCalled(t, "DeleteSchedule", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			scheduleRepo.AssertCalled(t, "DeleteSchedule", mock.Anything, "id")
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/logger"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+// WatchService re-scrapes the watched products and queries periodically and notifies their webhooks about the changes.
+type WatchService interface {
+	// CreateWatch validates and saves the watch, its id and webhook secret are generated.
+	CreateWatch(ctx context.Context, watch domain.Watch) (domain.Watch, error)
+	GetWatch(ctx context.Context, id string) (domain.Watch, error)
+	ListWatches(ctx context.Context) ([]domain.Watch, error)
+	// UpdateWatch replaces the watch except its id, secret and creation time. The state is reset if the target is changed.
+	UpdateWatch(ctx context.Context, watch domain.Watch) (domain.Watch, error)
+	DeleteWatch(ctx context.Context, id string) error
+	// ListDeliveries returns the last webhook deliveries of the watch, the newest first.
+	ListDeliveries(ctx context.Context, watchID string) ([]domain.Delivery, error)
+}
+
+// WatchConfig describes the checks of the watches and the delivery of their webhooks.
+type WatchConfig struct {
+	// Tick is how often the due watches and deliveries are looked for.
+	Tick time.Duration
+	// Workers is the number of the watches checked and the webhooks delivered at the same time.
+	Workers         int
+	DefaultInterval time.Duration
+	MinInterval     time.Duration
+	// CheckTimeout is the deadline of the search of a check.
+	CheckTimeout time.Duration
+	// Delivery is the retry policy of the failed webhook deliveries.
+	Delivery RetryPolicy
+	// LogSize is the number of the deliveries returned by ListDeliveries.
+	LogSize int
+	// AllowPrivateNetworks allows the webhook urls with the loopback, private and link-local hosts.
+	AllowPrivateNetworks bool
+}
+
+// pendingDeliveriesLimit is the number of the pending deliveries attempted within a tick.
+const pendingDeliveriesLimit = 100
+
+type watchService struct {
+	parser  ParserService
+	watches repository.WatchRepository
+	sender  repository.WebhookSender
+	cfg     *WatchConfig
+	logger  logger.Logger
+}
+
+func NewWatchService(parser ParserService, watches repository.WatchRepository, sender repository.WebhookSender, cfg *WatchConfig, logger logger.Logger) *watchService {
+	return &watchService{
+		parser:  parser,
+		watches: watches,
+		sender:  sender,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+// Run checks the due watches and delivers the pending webhooks every tick until ctx is done.
+func (s *watchService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Tick)
+	defer ticker.Stop()
+
+	for {
+		s.CheckWatches(ctx)
+		s.DeliverWebhooks(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *watchService) CreateWatch(ctx context.Context, watch domain.Watch) (domain.Watch, error) {
+	if err := s.validateWatch(&watch); err != nil {
+		return domain.Watch{}, err
+	}
+
+	id, err := randomHex(16)
+	if err != nil {
+		return domain.Watch{}, fmt.Errorf("new watch id: %w", err)
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return domain.Watch{}, fmt.Errorf("new watch secret: %w", err)
+	}
+	watch.ID = id
+	watch.Secret = secret
+	watch.CreatedAt = time.Now()
+	watch.State = domain.WatchState{}
+
+	if err := s.watches.SaveWatch(ctx, watch); err != nil {
+		return domain.Watch{}, fmt.Errorf("save watch: %w", err)
+	}
+
+	return watch, nil
+}
+
+func (s *watchService) GetWatch(ctx context.Context, id string) (domain.Watch, error) {
+	watch, err := s.watches.GetWatch(ctx, id)
+	if err != nil {
+		return domain.Watch{}, mapWatchError(err)
+	}
+
+	return watch, nil
+}
+
+func (s *watchService) ListWatches(ctx context.Context) ([]domain.Watch, error) {
+	return s.watches.ListWatches(ctx)
+}
+
+func (s *watchService) UpdateWatch(ctx context.Context, watch domain.Watch) (domain.Watch, error) {
+	current, err := s.watches.GetWatch(ctx, watch.ID)
+	if err != nil {
+		return domain.Watch{}, mapWatchError(err)
+	}
+	if err := s.validateWatch(&watch); err != nil {
+		return domain.Watch{}, err
+	}
+
+	watch.Secret = current.Secret
+	watch.CreatedAt = current.CreatedAt
+	watch.State = current.State
+	if !sameTarget(watch, current) {
+		watch.State = domain.WatchState{}
+	}
+
+	if err := s.watches.SaveWatch(ctx, watch); err != nil {
+		return domain.Watch{}, fmt.Errorf("save watch: %w", err)
+	}
+
+	return watch, nil
+}
+
+func (s *watchService) DeleteWatch(ctx context.Context, id string) error {
+	return mapWatchError(s.watches.DeleteWatch(ctx, id))
+}
+
+func (s *watchService) ListDeliveries(ctx context.Context, watchID string) ([]domain.Delivery, error) {
+	if _, err := s.watches.GetWatch(ctx, watchID); err != nil {
+		return nil, mapWatchError(err)
+	}
+
+	return s.watches.ListDeliveries(ctx, watchID, s.cfg.LogSize)
+}
+
+// validateWatch checks the watch and fills the default interval.
+func (s *watchService) validateWatch(watch *domain.Watch) error {
+	if watch.ProductWatch() {
+		watch.ProductID = strings.TrimSpace(watch.ProductID)
+		if watch.ProductID == "" {
+			return domain.ErrInvalidWatchTarget
+		}
+		// The product is parsed from its page, the name only labels the watch
+		watch.Query = domain.SearchQuery{Name: strings.TrimSpace(watch.Query.Name), Region: watch.Query.Region}
+	} else if err := ValidateSearchArgs(watch.Query.Name, watch.Query.PriceFrom, watch.Query.PriceTo); err != nil {
+		return err
+	}
+
+	if watch.TargetPrice < 0 || (watch.TargetPrice == 0 && !(watch.NotifyStock && watch.ProductWatch())) {
+		return domain.ErrInvalidTargetPrice
+	}
+
+	u, err := url.Parse(watch.WebhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domain.ErrInvalidWebhookURL
+	}
+	// The resolved addresses are checked by the sender on every delivery
+	if !s.cfg.AllowPrivateNetworks && !utils.PublicHost(u.Hostname()) {
+		return domain.ErrInvalidWebhookURL
+	}
+
+	if watch.Interval == 0 {
+		watch.Interval = s.cfg.DefaultInterval
+	}
+	if watch.Interval < s.cfg.MinInterval {
+		return domain.ErrInvalidInterval
+	}
+
+	return nil
+}
+
+// CheckWatches checks the watches whose interval has passed since their last check, at most Workers at the same time.
+func (s *watchService) CheckWatches(ctx context.Context) {
+	watches, err := s.watches.ListWatches(ctx)
+	if err != nil {
+		s.logger.Error("list watches", "err", err)
+		return
+	}
+
+	now := time.Now()
+	due := make([]domain.Watch, 0, len(watches))
+	for _, w := range watches {
+		if w.State.CheckedAt.IsZero() || now.Sub(w.State.CheckedAt) >= w.Interval {
+			due = append(due, w)
+		}
+	}
+
+	runLimited(ctx, s.cfg.Workers, due, s.checkWatch)
+}
+
+// checkWatch gets the watched product and queues the notifications of its changes since the previous check.
+func (s *watchService) checkWatch(ctx context.Context, watch domain.Watch) {
+	checkCtx, cancel := context.WithTimeout(ctx, s.cfg.CheckTimeout)
+	defer cancel()
+
+	product, ok, err := s.watchedProduct(checkCtx, watch)
+	if ctx.Err() != nil {
+		return
+	}
+
+	prev := watch.State
+	state := domain.WatchState{CheckedAt: time.Now(), Found: prev.Found, Product: prev.Product, BelowTarget: prev.BelowTarget}
+	var notifications []domain.WatchNotification
+	if err != nil {
+		s.logger.Warn("check watch", "id", watch.ID, "err", err)
+		state.Error = err.Error()
+	} else if ok {
+		state.Found = true
+		state.Product = product
+
+		notification := domain.WatchNotification{
+			WatchID:     watch.ID,
+			Marketplace: watch.Marketplace,
+			Product:     product,
+			TargetPrice: watch.TargetPrice,
+			OccurredAt:  state.CheckedAt,
+		}
+		seen := prev.Found || (watch.ProductWatch() && prev.Product.ID != "")
+		if seen {
+			notification.PreviousPrice = prev.Product.Price
+			notification.PreviousInStock = prev.Product.InStock
+		}
+
+		// The price of the product that can't be ordered may be missing, the price is compared once it's known
+		if watch.TargetPrice > 0 && product.Price > 0 {
+			state.BelowTarget = product.Price < watch.TargetPrice
+			if state.BelowTarget && !prev.BelowTarget {
+				notification.Event = domain.WatchPriceDrop
+				notifications = append(notifications, notification)
+			}
+		}
+		if watch.NotifyStock && watch.ProductWatch() && seen && prev.Product.InStock != product.InStock {
+			notification.Event = domain.WatchStockChange
+			notifications = append(notifications, notification)
+		}
+	} else {
+		// The product watch keeps the last seen product, so the changes are compared to it once the product is back
+		state.Found = false
+		if !watch.ProductWatch() {
+			state.Product = domain.Product{}
+		}
+	}
+
+	for _, n := range notifications {
+		if err := s.queueDelivery(ctx, n); err != nil {
+			s.logger.Error("queue webhook delivery", "id", watch.ID, "err", err)
+		}
+	}
+
+	if err := s.watches.SaveWatchState(ctx, watch.ID, state); err != nil && !errors.Is(err, repository.ErrWatchNotFound) {
+		s.logger.Error("save watch state", "id", watch.ID, "err", err)
+	}
+}
+
+// watchedProduct returns the product of a product watch parsed from its page or the cheapest product found
+// by the search of a query watch. It returns false if the product is missing or nothing is found.
+func (s *watchService) watchedProduct(ctx context.Context, watch domain.Watch) (domain.Product, bool, error) {
+	if watch.ProductWatch() {
+		product, err := s.parser.GetProduct(ctx, domain.ProductQuery{
+			Marketplace: watch.Marketplace,
+			ID:          watch.ProductID,
+			Region:      watch.Query.Region,
+		})
+		if errors.Is(err, domain.ErrProductNotFound) {
+			return domain.Product{}, false, nil
+		}
+		if err != nil {
+			return domain.Product{}, false, err
+		}
+
+		return product, true, nil
+	}
+
+	query := watch.Query
+	query.NoCache = true
+	res, err := s.parser.GetProductsList(ctx, query)
+	if err != nil {
+		return domain.Product{}, false, err
+	}
+
+	product, ok := cheapestProduct(res.Products)
+
+	return product, ok, nil
+}
+
+// cheapestProduct returns the cheapest of the products, the products without a price are skipped,
+// their price couldn't be parsed.
+func cheapestProduct(products []domain.Product) (domain.Product, bool) {
+	var res domain.Product
+	found := false
+	for _, p := range products {
+		if p.Price > 0 && (!found || p.Price < res.Price) {
+			res, found = p, true
+		}
+	}
+
+	return res, found
+}
+
+func (s *watchService) queueDelivery(ctx context.Context, notification domain.WatchNotification) error {
+	id, err := randomHex(16)
+	if err != nil {
+		return fmt.Errorf("new delivery id: %w", err)
+	}
+
+	return s.watches.SaveDelivery(ctx, domain.Delivery{
+		ID:            id,
+		WatchID:       notification.WatchID,
+		Notification:  notification,
+		Status:        domain.DeliveryPending,
+		CreatedAt:     notification.OccurredAt,
+		NextAttemptAt: notification.OccurredAt,
+	})
+}
+
+// DeliverWebhooks attempts the pending deliveries that are due, at most Workers at the same time.
+// A failed delivery is retried with the exponential backoff until its attempts are over.
+func (s *watchService) DeliverWebhooks(ctx context.Context) {
+	deliveries, err := s.watches.PendingDeliveries(ctx, time.Now(), pendingDeliveriesLimit)
+	if err != nil {
+		s.logger.Error("list pending deliveries", "err", err)
+		return
+	}
+
+	runLimited(ctx, s.cfg.Workers, deliveries, s.deliver)
+}
+
+func (s *watchService) deliver(ctx context.Context, delivery domain.Delivery) {
+	watch, err := s.watches.GetWatch(ctx, delivery.WatchID)
+	if errors.Is(err, repository.ErrWatchNotFound) {
+		return
+	}
+	if err != nil {
+		s.logger.Error("get watch", "id", delivery.WatchID, "err", err)
+		return
+	}
+
+	delivery.Attempts++
+	status, err := s.sender.Send(ctx, watch.WebhookURL, watch.Secret, delivery.ID, delivery.Notification)
+	if ctx.Err() != nil {
+		return
+	}
+	delivery.ResponseStatus = status
+
+	switch {
+	case err == nil:
+		delivery.Status = domain.DeliveryDelivered
+		delivery.Error = ""
+		delivery.DeliveredAt = time.Now()
+		delivery.NextAttemptAt = time.Time{}
+	case delivery.Attempts >= s.cfg.Delivery.MaxAttempts:
+		s.logger.Warn("webhook delivery failed", "id", delivery.ID, "watch_id", watch.ID, "attempts", delivery.Attempts, "err", err)
+		delivery.Status = domain.DeliveryFailed
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = time.Time{}
+	default:
+		s.logger.Warn("retry webhook delivery", "id", delivery.ID, "watch_id", watch.ID, "attempt", delivery.Attempts, "err", err)
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = time.Now().Add(s.cfg.Delivery.delay(delivery.Attempts))
+	}
+
+	if err := s.watches.SaveDelivery(ctx, delivery); err != nil {
+		s.logger.Error("save webhook delivery", "id", delivery.ID, "err", err)
+	}
+}
+
+// runLimited calls fn for every item, at most n at the same time, and waits for all of them.
+// The items not started before ctx is done are skipped.
+func runLimited[T any](ctx context.Context, n int, items []T, fn func(context.Context, T)) {
+	slots := make(chan struct{}, max(n, 1))
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	for _, item := range items {
+		select {
+		case <-ctx.Done():
+			return
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			fn(ctx, item)
+		}()
+	}
+}
+
+// sameTarget reports whether the watches follow the same product or query with the same target price.
+func sameTarget(a, b domain.Watch) bool {
+	return a.Marketplace == b.Marketplace &&
+		a.ProductID == b.ProductID &&
+		a.Query.Name == b.Query.Name &&
+		a.Query.PriceFrom == b.Query.PriceFrom &&
+		a.Query.PriceTo == b.Query.PriceTo &&
+		a.Query.InStockOnly == b.Query.InStockOnly &&
+		a.Query.Region == b.Query.Region &&
+		slices.Equal(a.Query.Marketplaces, b.Query.Marketplaces) &&
+		a.TargetPrice == b.TargetPrice
+}
+
+func mapWatchError(err error) error {
+	if errors.Is(err, repository.ErrWatchNotFound) {
+		return domain.ErrWatchNotFound
+	}
+
+	return err
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,451 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/domain"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/repository"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/test/mocks"
+	"github.com/vo1dFl0w/marketplace-parser-service/internal/usecase"
+)
+
+var watchConfig = &usecase.WatchConfig{
+	Tick:            time.Minute,
+	Workers:         2,
+	DefaultInterval: time.Hour,
+	MinInterval:     5 * time.Minute,
+	CheckTimeout:    time.Second,
+	Delivery:        usecase.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour},
+	LogSize:         10,
+}
+
+func TestWatchService_CreateWatch(t *testing.T) {
+	t.Run("product watch", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("SaveWatch", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := svc.CreateWatch(context.Background(), domain.Watch{
+			Marketplace: domain.MarketplaceOzon,
+			ProductID:   " 123 ",
+			TargetPrice: 100.0,
+			WebhookURL:  "https://example.com/hook",
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.ID)
+		assert.NotEmpty(t, res.Secret)
+		assert.False(t, res.CreatedAt.IsZero())
+		assert.Equal(t, "123", res.ProductID)
+		assert.Equal(t, domain.SearchQuery{}, res.Query)
+		assert.Equal(t, watchConfig.DefaultInterval, res.Interval)
+
+		watchRepo.AssertCalled(t, "SaveWatch", mock.Anything, res)
+	})
+
+	t.Run("stock watch", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("SaveWatch", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := svc.CreateWatch(context.Background(), domain.Watch{
+			Marketplace: domain.MarketplaceWildberries,
+			ProductID:   "123",
+			Query:       domain.SearchQuery{Name: "prod", PriceTo: 500.0},
+			NotifyStock: true,
+			WebhookURL:  "http://example.com/hook",
+			Interval:    10 * time.Minute,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SearchQuery{Name: "prod"}, res.Query)
+		assert.Equal(t, 10*time.Minute, res.Interval)
+
+		watchRepo.AssertExpectations(t)
+	})
+
+	testCases := []struct {
+		name  string
+		watch domain.Watch
+		err   error
+	}{
+		{
+			name:  "empty product id",
+			watch: domain.Watch{Marketplace: domain.MarketplaceOzon, Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://example.com"},
+			err:   domain.ErrInvalidWatchTarget,
+		},
+		{
+			name:  "empty query",
+			watch: domain.Watch{TargetPrice: 100.0, WebhookURL: "https://example.com"},
+			err:   domain.ErrEmptyProductName,
+		},
+		{
+			name:  "no target price",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, NotifyStock: true, WebhookURL: "https://example.com"},
+			err:   domain.ErrInvalidTargetPrice,
+		},
+		{
+			name:  "negative target price",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: -1.0, WebhookURL: "https://example.com"},
+			err:   domain.ErrInvalidTargetPrice,
+		},
+		{
+			name:  "invalid webhook url",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "ftp://example.com"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "webhook url without host",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "loopback webhook url",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "http://127.0.0.1:8080/hook"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "localhost webhook url",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "http://localhost/hook"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "metadata webhook url",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "http://169.254.169.254/latest/meta-data"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "private webhook url",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://[fd00::1]/hook"},
+			err:   domain.ErrInvalidWebhookURL,
+		},
+		{
+			name:  "short interval",
+			watch: domain.Watch{Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://example.com", Interval: time.Minute},
+			err:   domain.ErrInvalidInterval,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			watchRepo := &mocks.WatchRepositoryMock{}
+			svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+			_, err := svc.CreateWatch(context.Background(), tc.watch)
+			assert.ErrorIs(t, err, tc.err)
+
+			watchRepo.AssertNotCalled(t, "SaveWatch", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestWatchService_UpdateWatch(t *testing.T) {
+	current := domain.Watch{
+		ID:          "id",
+		Query:       domain.SearchQuery{Name: "prod"},
+		TargetPrice: 100.0,
+		WebhookURL:  "https://example.com",
+		Secret:      "secret",
+		Interval:    time.Hour,
+		CreatedAt:   time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+		State:       domain.WatchState{CheckedAt: time.Now(), Found: true, BelowTarget: true},
+	}
+
+	t.Run("same target", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("GetWatch", mock.Anything, "id").Return(current, nil).Once()
+		watchRepo.On("SaveWatch", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := svc.UpdateWatch(context.Background(), domain.Watch{ID: "id", Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://example.com/new"})
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", res.Secret)
+		assert.Equal(t, current.CreatedAt, res.CreatedAt)
+		assert.Equal(t, current.State, res.State)
+		assert.Equal(t, "https://example.com/new", res.WebhookURL)
+
+		watchRepo.AssertExpectations(t)
+	})
+
+	t.Run("new target", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("GetWatch", mock.Anything, "id").Return(current, nil).Once()
+		watchRepo.On("SaveWatch", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := svc.UpdateWatch(context.Background(), domain.Watch{ID: "id", Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 80.0, WebhookURL: "https://example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", res.Secret)
+		assert.Equal(t, domain.WatchState{}, res.State)
+
+		watchRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("GetWatch", mock.Anything, "id").Return(domain.Watch{}, repository.ErrWatchNotFound).Once()
+
+		_, err := svc.UpdateWatch(context.Background(), domain.Watch{ID: "id", Query: domain.SearchQuery{Name: "prod"}, TargetPrice: 100.0, WebhookURL: "https://example.com"})
+		assert.ErrorIs(t, err, domain.ErrWatchNotFound)
+
+		watchRepo.AssertNotCalled(t, "SaveWatch", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWatchService_CheckWatches(t *testing.T) {
+	productWatch := domain.Watch{
+		ID:          "product",
+		Marketplace: domain.MarketplaceOzon,
+		ProductID:   "1",
+		Query:       domain.SearchQuery{Name: "prod", Region: "Moscow"},
+		TargetPrice: 100.0,
+		NotifyStock: true,
+		Interval:    time.Hour,
+		State: domain.WatchState{
+			CheckedAt: time.Now().Add(-2 * time.Hour),
+			Found:     true,
+			Product:   domain.Product{ID: "1", Name: "prod", Price: 120.0, InStock: false},
+		},
+	}
+	productQuery := domain.ProductQuery{Marketplace: domain.MarketplaceOzon, ID: "1", Region: "Moscow"}
+	queryWatch := domain.Watch{ID: "query", Query: domain.SearchQuery{Name: "cheap"}, TargetPrice: 50.0, Interval: time.Hour}
+	notDue := domain.Watch{ID: "not due", Query: domain.SearchQuery{Name: "other"}, TargetPrice: 50.0, Interval: time.Hour, State: domain.WatchState{CheckedAt: time.Now()}}
+
+	t.Run("price drop and stock change", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(parserSvc, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		product := domain.Product{ID: "1", Name: "prod", Price: 90.0, InStock: true}
+		queryQuery := queryWatch.Query
+		queryQuery.NoCache = true
+
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{productWatch, queryWatch, notDue}, nil).Once()
+		parserSvc.On("GetProduct", mock.Anything, productQuery).Return(product, nil).Once()
+		parserSvc.On("GetProductsList", mock.Anything, queryQuery).
+			Return(domain.SearchResult{Products: []domain.Product{{ID: "3", Price: 70.0}, {ID: "4", Price: 0}, {ID: "5", Price: 60.0}}}, nil).Once()
+		watchRepo.On("SaveDelivery", mock.Anything, mock.Anything).Return(nil).Twice()
+		watchRepo.On("SaveWatchState", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+		svc.CheckWatches(context.Background())
+
+		parserSvc.AssertExpectations(t)
+		watchRepo.AssertExpectations(t)
+
+		var events []domain.WatchEvent
+		for _, call := range watchRepo.Calls {
+			switch call.Method {
+			case "SaveDelivery":
+				delivery := call.Arguments.Get(1).(domain.Delivery)
+				assert.Equal(t, "product", delivery.WatchID)
+				assert.Equal(t, domain.DeliveryPending, delivery.Status)
+				assert.Equal(t, product, delivery.Notification.Product)
+				assert.Equal(t, 120.0, delivery.Notification.PreviousPrice)
+				assert.Equal(t, delivery.CreatedAt, delivery.NextAttemptAt)
+				events = append(events, delivery.Notification.Event)
+			case "SaveWatchState":
+				state := call.Arguments.Get(2).(domain.WatchState)
+				assert.True(t, state.Found)
+				switch call.Arguments.Get(1) {
+				case "product":
+					assert.Equal(t, product, state.Product)
+					assert.True(t, state.BelowTarget)
+				case "query":
+					assert.Equal(t, "5", state.Product.ID)
+					assert.False(t, state.BelowTarget)
+				}
+			}
+		}
+		assert.ElementsMatch(t, []domain.WatchEvent{domain.WatchPriceDrop, domain.WatchStockChange}, events)
+	})
+
+	t.Run("still below target", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(parserSvc, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watch := queryWatch
+		watch.State = domain.WatchState{CheckedAt: time.Now().Add(-2 * time.Hour), Found: true, Product: domain.Product{ID: "5", Price: 40.0}, BelowTarget: true}
+
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{watch}, nil).Once()
+		parserSvc.On("GetProductsList", mock.Anything, mock.Anything).
+			Return(domain.SearchResult{Products: []domain.Product{{ID: "5", Price: 30.0}}}, nil).Once()
+		watchRepo.On("SaveWatchState", mock.Anything, "query", mock.Anything).Return(nil).Once()
+
+		svc.CheckWatches(context.Background())
+
+		watchRepo.AssertExpectations(t)
+		watchRepo.AssertNotCalled(t, "SaveDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("search failed", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		watchRepo := &mocks.WatchRepositoryMock{}
+		loggerMock := &mocks.LoggerMock{}
+		svc := usecase.NewWatchService(parserSvc, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, loggerMock)
+
+		errSearch := errors.New("search failed")
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{productWatch}, nil).Once()
+		parserSvc.On("GetProduct", mock.Anything, productQuery).Return(domain.Product{}, errSearch).Once()
+		loggerMock.On("Warn", "check watch", mock.Anything).Once()
+		watchRepo.On("SaveWatchState", mock.Anything, "product", mock.Anything).Return(nil).Once()
+
+		svc.CheckWatches(context.Background())
+
+		watchRepo.AssertExpectations(t)
+		loggerMock.AssertExpectations(t)
+		watchRepo.AssertNotCalled(t, "SaveDelivery", mock.Anything, mock.Anything)
+
+		state := watchRepo.Calls[1].Arguments.Get(2).(domain.WatchState)
+		assert.Equal(t, errSearch.Error(), state.Error)
+		assert.Equal(t, productWatch.State.Product, state.Product)
+		assert.True(t, state.Found)
+	})
+
+	t.Run("product missing", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(parserSvc, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{productWatch}, nil).Once()
+		parserSvc.On("GetProduct", mock.Anything, productQuery).Return(domain.Product{}, domain.ErrProductNotFound).Once()
+		watchRepo.On("SaveWatchState", mock.Anything, "product", mock.Anything).Return(nil).Once()
+
+		svc.CheckWatches(context.Background())
+
+		watchRepo.AssertExpectations(t)
+		watchRepo.AssertNotCalled(t, "SaveDelivery", mock.Anything, mock.Anything)
+
+		state := watchRepo.Calls[1].Arguments.Get(2).(domain.WatchState)
+		assert.False(t, state.Found)
+		assert.Equal(t, productWatch.State.Product, state.Product)
+
+		// The stock change is reported against the last seen product once the product is back
+		watch := productWatch
+		watch.State = state
+		watch.State.CheckedAt = time.Now().Add(-2 * time.Hour)
+		product := domain.Product{ID: "1", Name: "prod", Price: 120.0, InStock: true}
+
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{watch}, nil).Once()
+		parserSvc.On("GetProduct", mock.Anything, productQuery).Return(product, nil).Once()
+		watchRepo.On("SaveDelivery", mock.Anything, mock.Anything).Return(nil).Once()
+		watchRepo.On("SaveWatchState", mock.Anything, "product", mock.Anything).Return(nil).Once()
+
+		svc.CheckWatches(context.Background())
+
+		watchRepo.AssertExpectations(t)
+
+		delivery := watchRepo.Calls[3].Arguments.Get(1).(domain.Delivery)
+		assert.Equal(t, domain.WatchStockChange, delivery.Notification.Event)
+		assert.Equal(t, 120.0, delivery.Notification.PreviousPrice)
+		assert.False(t, delivery.Notification.PreviousInStock)
+	})
+
+	t.Run("product without price", func(t *testing.T) {
+		parserSvc := &mocks.ParserServiceMock{}
+		watchRepo := &mocks.WatchRepositoryMock{}
+		svc := usecase.NewWatchService(parserSvc, watchRepo, &mocks.WebhookSenderMock{}, watchConfig, &mocks.LoggerMock{})
+
+		watch := productWatch
+		watch.State.BelowTarget = true
+		watch.State.Product.InStock = true
+		product := domain.Product{ID: "1", Name: "prod", InStock: false}
+
+		watchRepo.On("ListWatches", mock.Anything).Return([]domain.Watch{watch}, nil).Once()
+		parserSvc.On("GetProduct", mock.Anything, productQuery).Return(product, nil).Once()
+		watchRepo.On("SaveDelivery", mock.Anything, mock.Anything).Return(nil).Once()
+		watchRepo.On("SaveWatchState", mock.Anything, "product", mock.Anything).Return(nil).Once()
+
+		svc.CheckWatches(context.Background())
+
+		watchRepo.AssertExpectations(t)
+
+		delivery := watchRepo.Calls[1].Arguments.Get(1).(domain.Delivery)
+		assert.Equal(t, domain.WatchStockChange, delivery.Notification.Event)
+
+		state := watchRepo.Calls[2].Arguments.Get(2).(domain.WatchState)
+		assert.True(t, state.Found)
+		assert.Equal(t, product, state.Product)
+		assert.True(t, state.BelowTarget)
+	})
+}
+
+func TestWatchService_DeliverWebhooks(t *testing.T) {
+	watch := domain.Watch{ID: "watch", WebhookURL: "https://example.com/hook", Secret: "secret"}
+	notification := domain.WatchNotification{WatchID: "watch", Event: domain.WatchPriceDrop, Product: domain.Product{ID: "1", Price: 90.0}}
+	errSend := errors.New("webhook responded with status 500")
+
+	testCases := []struct {
+		name     string
+		attempts int
+		status   int
+		err      error
+		expected domain.DeliveryStatus
+	}{
+		{name: "delivered", attempts: 0, status: 200, expected: domain.DeliveryDelivered},
+		{name: "retry", attempts: 0, status: 500, err: errSend, expected: domain.DeliveryPending},
+		{name: "attempts are over", attempts: 2, status: 500, err: errSend, expected: domain.DeliveryFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			watchRepo := &mocks.WatchRepositoryMock{}
+			sender := &mocks.WebhookSenderMock{}
+			loggerMock := &mocks.LoggerMock{}
+			svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, sender, watchConfig, loggerMock)
+
+			delivery := domain.Delivery{ID: "delivery", WatchID: "watch", Notification: notification, Status: domain.DeliveryPending, Attempts: tc.attempts}
+			watchRepo.On("PendingDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]domain.Delivery{delivery}, nil).Once()
+			watchRepo.On("GetWatch", mock.Anything, "watch").Return(watch, nil).Once()
+			sender.On("Send", mock.Anything, watch.WebhookURL, watch.Secret, "delivery", notification).Return(tc.status, tc.err).Once()
+			watchRepo.On("SaveDelivery", mock.Anything, mock.Anything).Return(nil).Once()
+			loggerMock.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			start := time.Now()
+			svc.DeliverWebhooks(context.Background())
+
+			watchRepo.AssertExpectations(t)
+			sender.AssertExpectations(t)
+
+			res := watchRepo.Calls[2].Arguments.Get(1).(domain.Delivery)
+			assert.Equal(t, tc.expected, res.Status)
+			assert.Equal(t, tc.attempts+1, res.Attempts)
+			assert.Equal(t, tc.status, res.ResponseStatus)
+			switch tc.expected {
+			case domain.DeliveryDelivered:
+				assert.Empty(t, res.Error)
+				assert.False(t, res.DeliveredAt.IsZero())
+			case domain.DeliveryPending:
+				assert.Equal(t, errSend.Error(), res.Error)
+				// Half of the delay is the jitter
+				assert.WithinRange(t, res.NextAttemptAt, start.Add(watchConfig.Delivery.BaseDelay/2), time.Now().Add(watchConfig.Delivery.BaseDelay))
+			case domain.DeliveryFailed:
+				assert.Equal(t, errSend.Error(), res.Error)
+				assert.True(t, res.NextAttemptAt.IsZero())
+			}
+		})
+	}
+
+	t.Run("deleted watch", func(t *testing.T) {
+		watchRepo := &mocks.WatchRepositoryMock{}
+		sender := &mocks.WebhookSenderMock{}
+		svc := usecase.NewWatchService(&mocks.ParserServiceMock{}, watchRepo, sender, watchConfig, &mocks.LoggerMock{})
+
+		watchRepo.On("PendingDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]domain.Delivery{{ID: "delivery", WatchID: "watch"}}, nil).Once()
+		watchRepo.On("GetWatch", mock.Anything, "watch").Return(domain.Watch{}, repository.ErrWatchNotFound).Once()
+
+		svc.DeliverWebhooks(context.Background())
+
+		watchRepo.AssertExpectations(t)
+		sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// sharedAddrSpace is the carrier-grade NAT range, some clouds serve their metadata in it.
+var sharedAddrSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddr reports whether the address is reachable from the internet. The loopback, private, link-local,
+// shared, unspecified and multicast addresses like 127.0.0.1, 10.0.0.1 or 169.254.169.254 aren't.
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddrSpace.Contains(addr)
+}
+
+// PublicHost reports whether the host of a URL may be public. The IP literals are checked by PublicAddr
+// and the "localhost" names are rejected, the other names have to be checked once they are resolved.
+func PublicHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	if err != nil {
+		return true
+	}
+
+	return PublicAddr(addr)
+}
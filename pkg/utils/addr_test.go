@@ -0,0 +1,41 @@
+package utils_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/marketplace-parser-service/pkg/utils"
+)
+
+func TestUtils_PublicHost(t *testing.T) {
+	testCases := []struct {
+		host   string
+		public bool
+	}{
+		{host: "example.com", public: true},
+		{host: "93.184.216.34", public: true},
+		{host: "2606:2800:220:1:248:1893:25c8:1946", public: true},
+		{host: "localhost", public: false},
+		{host: "api.localhost.", public: false},
+		{host: "127.0.0.1", public: false},
+		{host: "::1", public: false},
+		{host: "::ffff:127.0.0.1", public: false},
+		{host: "10.0.0.1", public: false},
+		{host: "172.16.0.1", public: false},
+		{host: "192.168.1.1", public: false},
+		{host: "169.254.169.254", public: false},
+		{host: "100.100.100.200", public: false},
+		{host: "fe80::1", public: false},
+		{host: "fd00::1", public: false},
+		{host: "0.0.0.0", public: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.host, func(t *testing.T) {
+			assert.Equal(t, tc.public, utils.PublicHost(tc.host))
+		})
+	}
+
+	assert.False(t, utils.PublicAddr(netip.Addr{}))
+}